@@ -0,0 +1,161 @@
+/*
+Package alarm runs callbacks at typed instants and at recurring wall-clock
+times in a timezone.
+
+A Schedule computes when an alarm next fires; Run waits for each time it
+returns and calls a function, until the context is done:
+
+	// Send the report at 9 a.m. Eastern Time every weekday morning,
+	// including across daylight saving time transitions.
+	err := alarm.Run(ctx, alarm.Daily[et.Timezone](9, 0, 0), func(at et.Time) {
+		if at.Weekday() != time.Saturday && at.Weekday() != time.Sunday {
+			sendReport(at)
+		}
+	})
+
+Recurring schedules are computed from the wall clock of the timezone with
+meridian.Time.NextAt, so "9 a.m." stays 9 a.m. local time when the offset
+changes, rather than drifting by an hour as a fixed 24-hour interval would.
+
+Tests substitute a fake Clock through Alarm.Clock to control the passage of
+time.
+*/
+package alarm
+
+import (
+	"context"
+	"time"
+
+	"github.com/matthalp/go-meridian/v2"
+)
+
+// Clock is the source of time for an Alarm. The zero Alarm uses the system
+// clock; tests provide a fake to control the passage of time.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+
+	// NewTimer returns a Timer that sends the current time on its channel
+	// after at least duration d.
+	NewTimer(d time.Duration) Timer
+}
+
+// Timer is a single pending event of a Clock, like time.Timer.
+type Timer interface {
+	// C returns the channel on which the time is delivered.
+	C() <-chan time.Time
+
+	// Stop prevents the Timer from firing, reporting whether it stopped
+	// the timer rather than finding it already fired or stopped.
+	Stop() bool
+}
+
+// systemClock is the Clock backed by the time package.
+type systemClock struct{}
+
+func (systemClock) Now() time.Time { return time.Now() }
+
+func (systemClock) NewTimer(d time.Duration) Timer { return systemTimer{time.NewTimer(d)} }
+
+type systemTimer struct{ t *time.Timer }
+
+func (t systemTimer) C() <-chan time.Time { return t.t.C }
+
+func (t systemTimer) Stop() bool { return t.t.Stop() }
+
+// A Schedule returns the first time strictly after after at which an alarm
+// fires, or false if it never fires again.
+type Schedule[TZ meridian.Timezone] func(after meridian.Time[TZ]) (next meridian.Time[TZ], ok bool)
+
+// Once returns a Schedule that fires once, at at. If at has already passed
+// when the alarm starts, it never fires.
+func Once[TZ meridian.Timezone](at meridian.Time[TZ]) Schedule[TZ] {
+	return func(after meridian.Time[TZ]) (meridian.Time[TZ], bool) {
+		return at, at.After(after)
+	}
+}
+
+// Daily returns a Schedule that fires every day when the clock in TZ's
+// location reads hour:minute:sec, as computed by meridian.Time.NextAt.
+func Daily[TZ meridian.Timezone](hour, minute, sec int) Schedule[TZ] {
+	return func(after meridian.Time[TZ]) (meridian.Time[TZ], bool) {
+		next := after.NextAt(hour, minute, sec)
+		return next, !next.IsZero()
+	}
+}
+
+// Weekly returns a Schedule that fires every week on weekday when the clock
+// in TZ's location reads hour:minute:sec, as computed by
+// meridian.Time.NextAtOn.
+func Weekly[TZ meridian.Timezone](weekday time.Weekday, hour, minute, sec int) Schedule[TZ] {
+	return func(after meridian.Time[TZ]) (meridian.Time[TZ], bool) {
+		next := after.NextAtOn(weekday, hour, minute, sec)
+		return next, !next.IsZero()
+	}
+}
+
+// Alarm calls a function at the times of a Schedule.
+type Alarm[TZ meridian.Timezone] struct {
+	// Schedule computes the times at which the alarm fires.
+	Schedule Schedule[TZ]
+
+	// Clock is the source of time, or nil for the system clock.
+	Clock Clock
+}
+
+// Run calls f with each time of a's schedule, once that time has arrived,
+// until the schedule ends or ctx is done. Calls are made sequentially on the
+// calling goroutine; times that pass while f is running are skipped rather
+// than delivered late. Run returns ctx.Err() if ctx is done, and nil when the
+// schedule ends.
+func (a Alarm[TZ]) Run(ctx context.Context, f func(meridian.Time[TZ])) error {
+	clock := a.Clock
+	if clock == nil {
+		clock = systemClock{}
+	}
+
+	after := meridian.FromMoment[TZ](clock.Now())
+	for {
+		next, ok := a.Schedule(after)
+		if !ok {
+			return nil
+		}
+		if err := wait(ctx, clock, next); err != nil {
+			return err
+		}
+		f(next)
+
+		// Skip the times that passed while f ran.
+		after = next
+		if now := meridian.FromMoment[TZ](clock.Now()); now.After(after) {
+			after = now
+		}
+	}
+}
+
+// wait blocks until clock reaches at or ctx is done. It waits again if the
+// timer fires early, as it may when the wall clock is adjusted.
+func wait(ctx context.Context, clock Clock, at meridian.Moment) error {
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		d := at.UTC().Sub(clock.Now())
+		if d <= 0 {
+			return nil
+		}
+		timer := clock.NewTimer(d)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C():
+		}
+	}
+}
+
+// Run calls f with each time of schedule, using the system clock. See
+// Alarm.Run.
+func Run[TZ meridian.Timezone](ctx context.Context, schedule Schedule[TZ], f func(meridian.Time[TZ])) error {
+	return Alarm[TZ]{Schedule: schedule}.Run(ctx, f)
+}
@@ -0,0 +1,226 @@
+package alarm
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/matthalp/go-meridian/v2"
+	"github.com/matthalp/go-meridian/v2/timezones/et"
+)
+
+// fakeClock is a Clock whose time only moves when the test advances it.
+type fakeClock struct {
+	mu      sync.Mutex
+	now     time.Time
+	timers  []*fakeTimer
+	created chan *fakeTimer
+}
+
+func newFakeClock(now time.Time) *fakeClock {
+	return &fakeClock{now: now, created: make(chan *fakeTimer, 1)}
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) NewTimer(d time.Duration) Timer {
+	c.mu.Lock()
+	t := &fakeTimer{clock: c, when: c.now.Add(d), c: make(chan time.Time, 1)}
+	c.timers = append(c.timers, t)
+	c.mu.Unlock()
+	c.created <- t
+	return t
+}
+
+// advanceTo sets the time to now and fires the timers that are due.
+func (c *fakeClock) advanceTo(now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = now
+	pending := c.timers[:0]
+	for _, t := range c.timers {
+		if !t.when.After(now) {
+			t.c <- now
+		} else {
+			pending = append(pending, t)
+		}
+	}
+	c.timers = pending
+}
+
+type fakeTimer struct {
+	clock *fakeClock
+	when  time.Time
+	c     chan time.Time
+}
+
+func (t *fakeTimer) C() <-chan time.Time { return t.c }
+
+func (t *fakeTimer) Stop() bool {
+	t.clock.mu.Lock()
+	defer t.clock.mu.Unlock()
+	for i, pending := range t.clock.timers {
+		if pending == t {
+			t.clock.timers = append(t.clock.timers[:i], t.clock.timers[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// runAlarm runs a on a goroutine, firing each timer it creates, and returns
+// the first n times it delivers to its function.
+func runAlarm(t *testing.T, a Alarm[et.Timezone], clock *fakeClock, n int) []et.Time {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	fired := make(chan et.Time)
+	done := make(chan error, 1)
+	go func() {
+		done <- a.Run(ctx, func(at et.Time) { fired <- at })
+	}()
+
+	var got []et.Time
+	for len(got) < n {
+		select {
+		case timer := <-clock.created:
+			clock.advanceTo(timer.when)
+		case at := <-fired:
+			got = append(got, at)
+		case err := <-done:
+			t.Fatalf("Run() returned %v after %d of %d times", err, len(got), n)
+		}
+	}
+
+	cancel()
+	for {
+		select {
+		case <-clock.created:
+		case err := <-done:
+			if !errors.Is(err, context.Canceled) {
+				t.Errorf("Run() after cancel = %v, want context.Canceled", err)
+			}
+			return got
+		}
+	}
+}
+
+func TestDailyAcrossDST(t *testing.T) {
+	start := et.Date(2024, time.March, 8, 12, 0, 0, 0)
+	clock := newFakeClock(start.UTC())
+
+	got := runAlarm(t, Alarm[et.Timezone]{Schedule: Daily[et.Timezone](9, 0, 0), Clock: clock}, clock, 3)
+
+	want := []et.Time{
+		et.Date(2024, time.March, 9, 9, 0, 0, 0),
+		et.Date(2024, time.March, 10, 9, 0, 0, 0), // EDT begins at 2 a.m.
+		et.Date(2024, time.March, 11, 9, 0, 0, 0),
+	}
+	for i := range want {
+		if !got[i].Equal(want[i]) {
+			t.Errorf("firing %d = %v, want %v", i, got[i], want[i])
+		}
+	}
+	if d := got[2].Sub(got[1]); d != 24*time.Hour {
+		t.Errorf("interval after the transition = %v, want 24h", d)
+	}
+	if d := got[1].Sub(got[0]); d != 23*time.Hour {
+		t.Errorf("interval across the transition = %v, want 23h", d)
+	}
+}
+
+func TestWeekly(t *testing.T) {
+	clock := newFakeClock(et.Date(2024, time.March, 6, 12, 0, 0, 0).UTC())
+
+	got := runAlarm(t, Alarm[et.Timezone]{Schedule: Weekly[et.Timezone](time.Monday, 8, 30, 0), Clock: clock}, clock, 2)
+
+	for i, want := range []et.Time{
+		et.Date(2024, time.March, 11, 8, 30, 0, 0),
+		et.Date(2024, time.March, 18, 8, 30, 0, 0),
+	} {
+		if !got[i].Equal(want) {
+			t.Errorf("firing %d = %v, want %v", i, got[i], want)
+		}
+	}
+}
+
+func TestOnce(t *testing.T) {
+	start := et.Date(2024, time.March, 6, 12, 0, 0, 0)
+	at := start.Add(90 * time.Minute)
+	clock := newFakeClock(start.UTC())
+
+	done := make(chan error, 1)
+	var got []et.Time
+	go func() {
+		done <- Alarm[et.Timezone]{Schedule: Once(at), Clock: clock}.Run(context.Background(), func(t et.Time) {
+			got = append(got, t)
+		})
+	}()
+
+	clock.advanceTo((<-clock.created).when)
+	if err := <-done; err != nil {
+		t.Fatalf("Run() = %v, want nil when the schedule ends", err)
+	}
+	if len(got) != 1 || !got[0].Equal(at) {
+		t.Errorf("Run() fired at %v, want once at %v", got, at)
+	}
+}
+
+func TestOncePast(t *testing.T) {
+	start := et.Date(2024, time.March, 6, 12, 0, 0, 0)
+	clock := newFakeClock(start.UTC())
+
+	err := Alarm[et.Timezone]{Schedule: Once(start.Add(-time.Minute)), Clock: clock}.Run(context.Background(), func(et.Time) {
+		t.Error("Run() fired for a time that had passed")
+	})
+	if err != nil {
+		t.Errorf("Run() = %v, want nil", err)
+	}
+}
+
+func TestRunSkipsMissedTimes(t *testing.T) {
+	start := et.Date(2024, time.March, 6, 12, 0, 0, 0)
+	clock := newFakeClock(start.UTC())
+
+	var got []et.Time
+	a := Alarm[et.Timezone]{Schedule: Daily[et.Timezone](9, 0, 0), Clock: clock}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- a.Run(ctx, func(at et.Time) {
+			got = append(got, at)
+			// The callback runs for two days, missing March 8.
+			clock.advanceTo(at.Add(48 * time.Hour).UTC())
+		})
+	}()
+
+	clock.advanceTo((<-clock.created).when)
+	next := <-clock.created
+	cancel()
+	if err := <-done; !errors.Is(err, context.Canceled) {
+		t.Errorf("Run() = %v, want context.Canceled", err)
+	}
+
+	if want := et.Date(2024, time.March, 10, 9, 0, 0, 0); !meridian.FromMoment[et.Timezone](next.when).Equal(want) {
+		t.Errorf("next timer after a slow callback = %v, want %v", meridian.FromMoment[et.Timezone](next.when), want)
+	}
+	if len(got) != 1 {
+		t.Errorf("Run() fired %d times, want 1", len(got))
+	}
+}
+
+func TestRunCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := Run(ctx, Daily[et.Timezone](9, 0, 0), func(et.Time) {
+		t.Error("Run() fired after its context was canceled")
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Run() = %v, want context.Canceled", err)
+	}
+}
@@ -0,0 +1,65 @@
+package meridian
+
+import (
+	"fmt"
+	"time"
+)
+
+// AnyTime is the set of methods shared by every instantiation of Time. It is
+// meant to be used as a constraint whose type argument is the constrained
+// type itself, so that one generic function covers every timezone and
+// methods such as Add still return the caller's typed time:
+//
+//	func Latest[T meridian.AnyTime[T]](ts ...T) T {
+//		var latest T
+//		for _, t := range ts {
+//			if t.After(latest) {
+//				latest = t
+//			}
+//		}
+//		return latest
+//	}
+//
+//	Latest(et.Now(), et.Date(2030, time.January, 1, 0, 0, 0, 0)) // an et.Time
+//
+// Time[TZ] satisfies AnyTime[Time[TZ]] for every TZ. Unlike Moment, AnyTime
+// keeps the timezone type through the function without naming it, and
+// exposes the accessors that read the time in its zone.
+type AnyTime[T any] interface {
+	MomentWithLocation
+	fmt.Stringer
+
+	Add(d time.Duration) T
+	AddDate(years, months, days int) T
+	Truncate(d time.Duration) T
+	Round(d time.Duration) T
+	Sub(u Moment) time.Duration
+
+	Before(u Moment) bool
+	After(u Moment) bool
+	Equal(u Moment) bool
+	Compare(u Moment) int
+	IsZero() bool
+
+	Format(layout string) string
+	AppendFormat(b []byte, layout string) []byte
+
+	Date() (year int, month time.Month, day int)
+	Clock() (hour, minute, sec int)
+	Year() int
+	Month() time.Month
+	Day() int
+	Hour() int
+	Minute() int
+	Second() int
+	Nanosecond() int
+	Weekday() time.Weekday
+	YearDay() int
+
+	Unix() int64
+	UnixMilli() int64
+	UnixMicro() int64
+	UnixNano() int64
+}
+
+var _ AnyTime[Time[Timezone]] = Time[Timezone]{}
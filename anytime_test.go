@@ -0,0 +1,39 @@
+package meridian
+
+import (
+	"testing"
+	"time"
+)
+
+// latest is a generic algorithm written once against AnyTime.
+func latest[T AnyTime[T]](ts ...T) T {
+	var max T
+	for _, t := range ts {
+		if max.IsZero() || t.After(max) {
+			max = t
+		}
+	}
+	return max
+}
+
+// startOfHour uses methods of AnyTime that return the typed time.
+func startOfHour[T AnyTime[T]](t T) T {
+	return t.Add(-time.Duration(t.Minute())*time.Minute - time.Duration(t.Second())*time.Second - time.Duration(t.Nanosecond()))
+}
+
+func TestAnyTime(t *testing.T) {
+	a := Date[EST](2024, time.March, 15, 9, 0, 0, 0)
+	b := Date[EST](2024, time.March, 15, 11, 45, 30, 0)
+	var got Time[EST] = latest(a, b)
+	if !got.Equal(b) {
+		t.Errorf("latest() = %v, want %v", got, b)
+	}
+
+	var u Time[UTC] = startOfHour(FromMoment[UTC](b))
+	if want := Date[UTC](2024, time.March, 15, 15, 0, 0, 0); !u.Equal(want) {
+		t.Errorf("startOfHour() = %v, want %v", u, want)
+	}
+	if h := startOfHour(b); !h.Equal(Date[EST](2024, time.March, 15, 11, 0, 0, 0)) {
+		t.Errorf("startOfHour() = %v", h)
+	}
+}
@@ -0,0 +1,139 @@
+package meridian
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"strings"
+)
+
+// Compile-time interface assertions.
+var (
+	_ driver.Valuer = Array[Timezone]{}
+	_ sql.Scanner   = (*Array[Timezone])(nil)
+)
+
+// arrayElementLayout is the layout used for elements of a PostgreSQL array
+// literal. PostgreSQL stores timestamps with microsecond precision.
+const arrayElementLayout = "2006-01-02T15:04:05.999999Z07:00"
+
+// Array is a slice of Time[TZ] that maps to a one-dimensional PostgreSQL
+// timestamptz[] (or timestamp[]) column. It implements the driver.Valuer and
+// sql.Scanner interfaces using the PostgreSQL array text format, so it can be
+// used as a sqlc override type with both database/sql and pgx/v5. As with
+// Null, sqlc cannot name the generic type directly: override timestamptz[]
+// columns with an alias such as the Timestamptzs type shown in the Null
+// documentation.
+//
+// Elements are written as UTC. NULL elements scan into the zero time.
+type Array[TZ Timezone] []Time[TZ]
+
+// Value implements the driver.Valuer interface. A nil Array is stored as NULL.
+func (a Array[TZ]) Value() (driver.Value, error) {
+	if a == nil {
+		return nil, nil
+	}
+
+	var b strings.Builder
+	b.WriteByte('{')
+	for i, t := range a {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteByte('"')
+		b.WriteString(t.utcTime.Format(arrayElementLayout))
+		b.WriteByte('"')
+	}
+	b.WriteByte('}')
+	return b.String(), nil
+}
+
+// Scan implements the sql.Scanner interface. It accepts a PostgreSQL array
+// literal as string or []byte; each element is parsed with Time.Scan.
+func (a *Array[TZ]) Scan(value interface{}) error {
+	var literal string
+	switch v := value.(type) {
+	case nil:
+		*a = nil
+		return nil
+	case string:
+		literal = v
+	case []byte:
+		literal = string(v)
+	default:
+		return fmt.Errorf("cannot scan type %T into meridian.Array", value)
+	}
+
+	elements, err := parseArrayLiteral(literal)
+	if err != nil {
+		return err
+	}
+
+	result := make(Array[TZ], len(elements))
+	for i, elem := range elements {
+		if elem == nil {
+			continue
+		}
+		if err := result[i].Scan(*elem); err != nil {
+			return fmt.Errorf("cannot scan element %d of meridian.Array: %w", i, err)
+		}
+	}
+	*a = result
+	return nil
+}
+
+// parseArrayLiteral splits a one-dimensional PostgreSQL array literal such as
+// {"2024-06-15 14:30:45+00",NULL} into its elements. Unquoted NULL elements are
+// returned as nil.
+func parseArrayLiteral(s string) ([]*string, error) {
+	if len(s) < 2 || s[0] != '{' || s[len(s)-1] != '}' {
+		return nil, fmt.Errorf("cannot scan %q into meridian.Array: not an array literal", s)
+	}
+	body := s[1 : len(s)-1]
+	if body == "" {
+		return []*string{}, nil
+	}
+
+	var (
+		elements []*string
+		current  strings.Builder
+		quoted   bool // the current element was quoted
+		inQuotes bool
+		escaped  bool
+	)
+	flush := func() {
+		elem := current.String()
+		if !quoted && strings.EqualFold(elem, "NULL") {
+			elements = append(elements, nil)
+		} else {
+			elements = append(elements, &elem)
+		}
+		current.Reset()
+		quoted = false
+	}
+
+	for i := 0; i < len(body); i++ {
+		c := body[i]
+		switch {
+		case escaped:
+			current.WriteByte(c)
+			escaped = false
+		case c == '\\':
+			escaped = true
+		case c == '"':
+			inQuotes = !inQuotes
+			quoted = true
+		case c == '{' && !inQuotes:
+			return nil, fmt.Errorf("cannot scan %q into meridian.Array: multidimensional arrays are not supported", s)
+		case c == ',' && !inQuotes:
+			flush()
+		default:
+			current.WriteByte(c)
+		}
+	}
+	if inQuotes || escaped {
+		return nil, fmt.Errorf("cannot scan %q into meridian.Array: unterminated element", s)
+	}
+	flush()
+	return elements, nil
+}
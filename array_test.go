@@ -0,0 +1,157 @@
+package meridian
+
+import (
+	"testing"
+	"time"
+)
+
+func TestArrayValue(t *testing.T) {
+	tests := []struct {
+		name     string
+		array    Array[EST]
+		expected interface{}
+	}{
+		{
+			name:     "nil is NULL",
+			array:    nil,
+			expected: nil,
+		},
+		{
+			name:     "empty",
+			array:    Array[EST]{},
+			expected: "{}",
+		},
+		{
+			name: "elements are written as UTC",
+			array: Array[EST]{
+				Date[EST](2024, time.January, 15, 7, 0, 0, 0),
+				Date[EST](2024, time.June, 15, 10, 30, 45, 123456789),
+			},
+			expected: `{"2024-01-15T12:00:00Z","2024-06-15T14:30:45.123456Z"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, err := tt.array.Value()
+			if err != nil {
+				t.Fatalf("Value() error = %v", err)
+			}
+			if value != tt.expected {
+				t.Errorf("Value() = %v, want %v", value, tt.expected)
+			}
+		})
+	}
+}
+
+func TestArrayScan(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    interface{}
+		expected []time.Time
+	}{
+		{
+			name:     "NULL",
+			value:    nil,
+			expected: nil,
+		},
+		{
+			name:     "empty",
+			value:    "{}",
+			expected: []time.Time{},
+		},
+		{
+			name:  "PostgreSQL output format",
+			value: []byte(`{"2024-06-15 14:30:45+00","2024-06-15 10:00:00.5-04"}`),
+			expected: []time.Time{
+				time.Date(2024, time.June, 15, 14, 30, 45, 0, time.UTC),
+				time.Date(2024, time.June, 15, 14, 0, 0, 500000000, time.UTC),
+			},
+		},
+		{
+			name:  "offset with minutes",
+			value: `{"2024-06-15 20:00:45+05:30"}`,
+			expected: []time.Time{
+				time.Date(2024, time.June, 15, 14, 30, 45, 0, time.UTC),
+			},
+		},
+		{
+			name:  "unquoted elements and NULL",
+			value: `{2024-06-15,NULL}`,
+			expected: []time.Time{
+				time.Date(2024, time.June, 15, 0, 0, 0, 0, time.UTC),
+				{},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var a Array[PST]
+			if err := a.Scan(tt.value); err != nil {
+				t.Fatalf("Scan() error = %v", err)
+			}
+			if (a == nil) != (tt.expected == nil) {
+				t.Fatalf("Scan() = %v, want %v", a, tt.expected)
+			}
+			if len(a) != len(tt.expected) {
+				t.Fatalf("Scan() len = %d, want %d", len(a), len(tt.expected))
+			}
+			for i := range a {
+				if !a[i].UTC().Equal(tt.expected[i]) {
+					t.Errorf("Scan()[%d] = %v, want %v", i, a[i].UTC(), tt.expected[i])
+				}
+			}
+		})
+	}
+}
+
+func TestArrayRoundTrip(t *testing.T) {
+	original := Array[UTC]{
+		Date[UTC](2024, time.March, 10, 6, 59, 59, 999999000),
+		Date[UTC](2024, time.November, 3, 8, 0, 0, 0),
+	}
+
+	value, err := original.Value()
+	if err != nil {
+		t.Fatalf("Value() error = %v", err)
+	}
+
+	var decoded Array[UTC]
+	if err := decoded.Scan(value); err != nil {
+		t.Fatalf("Scan() error = %v", err)
+	}
+	if len(decoded) != len(original) {
+		t.Fatalf("round trip len = %d, want %d", len(decoded), len(original))
+	}
+	for i := range original {
+		if !decoded[i].Equal(original[i]) {
+			t.Errorf("round trip [%d] = %v, want %v", i, decoded[i], original[i])
+		}
+	}
+}
+
+func TestArrayScanInvalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		value interface{}
+	}{
+		{"unsupported type", int64(1)},
+		{"not an array", "2024-06-15"},
+		{"multidimensional", `{{"2024-06-15"}}`},
+		{"unterminated quote", `{"2024-06-15}`},
+		{"invalid element", `{"2024-06-15","yesterday"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := Array[UTC]{Date[UTC](2024, time.June, 15, 0, 0, 0, 0)}
+			if err := a.Scan(tt.value); err == nil {
+				t.Errorf("Scan(%v) expected error, got nil", tt.value)
+			}
+			if len(a) != 1 {
+				t.Errorf("Scan() modified array on error: %v", a)
+			}
+		})
+	}
+}
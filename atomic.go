@@ -0,0 +1,51 @@
+package meridian
+
+import "sync/atomic"
+
+// AtomicTime is a Time[TZ] that can be read and written by several goroutines
+// without a mutex, such as the time of the last heartbeat seen by a monitor.
+// The zero value holds the zero time and is ready to use.
+//
+// An AtomicTime must not be copied after first use.
+type AtomicTime[TZ Timezone] struct {
+	p atomic.Pointer[Time[TZ]]
+}
+
+// Load returns the stored time, or the zero time if none has been stored.
+func (a *AtomicTime[TZ]) Load() Time[TZ] {
+	if p := a.p.Load(); p != nil {
+		return *p
+	}
+	return Time[TZ]{}
+}
+
+// Store sets the stored time to t.
+func (a *AtomicTime[TZ]) Store(t Time[TZ]) {
+	a.p.Store(&t)
+}
+
+// Swap stores t and returns the previously stored time.
+func (a *AtomicTime[TZ]) Swap(t Time[TZ]) Time[TZ] {
+	if p := a.p.Swap(&t); p != nil {
+		return *p
+	}
+	return Time[TZ]{}
+}
+
+// CompareAndSwap stores t if the stored time is the same instant as old, and
+// reports whether it did.
+func (a *AtomicTime[TZ]) CompareAndSwap(old, t Time[TZ]) bool {
+	for {
+		p := a.p.Load()
+		current := Time[TZ]{}
+		if p != nil {
+			current = *p
+		}
+		if !current.utcTime.Equal(old.utcTime) {
+			return false
+		}
+		if a.p.CompareAndSwap(p, &t) {
+			return true
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package meridian
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestAtomicTime(t *testing.T) {
+	var a AtomicTime[EST]
+	if got := a.Load(); !got.IsZero() {
+		t.Errorf("Load() on zero value = %v, want zero time", got)
+	}
+
+	first := Date[EST](2024, time.January, 15, 12, 0, 0, 0)
+	second := first.Add(time.Minute)
+
+	a.Store(first)
+	if got := a.Load(); !got.Equal(first) {
+		t.Errorf("Load() = %v, want %v", got, first)
+	}
+
+	if old := a.Swap(second); !old.Equal(first) {
+		t.Errorf("Swap() = %v, want %v", old, first)
+	}
+	if got := a.Load(); !got.Equal(second) {
+		t.Errorf("Load() after Swap() = %v, want %v", got, second)
+	}
+}
+
+func TestAtomicTimeSwapZero(t *testing.T) {
+	var a AtomicTime[UTC]
+	if old := a.Swap(Date[UTC](2024, time.January, 15, 12, 0, 0, 0)); !old.IsZero() {
+		t.Errorf("Swap() on zero value = %v, want zero time", old)
+	}
+}
+
+func TestAtomicTimeCompareAndSwap(t *testing.T) {
+	var a AtomicTime[EST]
+	first := Date[EST](2024, time.January, 15, 12, 0, 0, 0)
+	second := first.Add(time.Minute)
+
+	if !a.CompareAndSwap(Time[EST]{}, first) {
+		t.Fatal("CompareAndSwap(zero, first) on zero value = false, want true")
+	}
+	if a.CompareAndSwap(second, second) {
+		t.Error("CompareAndSwap() with a stale old value = true, want false")
+	}
+	if got := a.Load(); !got.Equal(first) {
+		t.Errorf("Load() after failed CompareAndSwap() = %v, want %v", got, first)
+	}
+
+	// The same instant converted from another zone matches.
+	if !a.CompareAndSwap(FromMoment[EST](Date[UTC](2024, time.January, 15, 17, 0, 0, 0)), second) {
+		t.Error("CompareAndSwap() with the same instant = false, want true")
+	}
+	if got := a.Load(); !got.Equal(second) {
+		t.Errorf("Load() after CompareAndSwap() = %v, want %v", got, second)
+	}
+}
+
+func TestAtomicTimeConcurrent(t *testing.T) {
+	var a AtomicTime[UTC]
+	start := Date[UTC](2024, time.January, 15, 12, 0, 0, 0)
+	a.Store(start)
+
+	// Each goroutine advances the time by one second with a CAS loop, so no
+	// increment may be lost.
+	const goroutines, increments = 8, 100
+	var wg sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < increments; i++ {
+				for {
+					old := a.Load()
+					if a.CompareAndSwap(old, old.Add(time.Second)) {
+						break
+					}
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got, want := a.Load(), start.Add(goroutines*increments*time.Second); !got.Equal(want) {
+		t.Errorf("Load() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,66 @@
+package meridian
+
+import (
+	"fmt"
+	"time"
+)
+
+// EncodeUnixNanos returns the Unix time in nanoseconds of each element of ts,
+// in a single allocation. As with UnixNano, the result is undefined for times
+// outside the years 1678 to 2262.
+func EncodeUnixNanos[TZ Timezone](ts []Time[TZ]) []int64 {
+	return AppendUnixNanos(make([]int64, 0, len(ts)), ts)
+}
+
+// AppendUnixNanos appends the Unix time in nanoseconds of each element of ts
+// to dst and returns the extended slice, so a buffer can be reused from batch
+// to batch.
+func AppendUnixNanos[TZ Timezone](dst []int64, ts []Time[TZ]) []int64 {
+	for _, t := range ts {
+		dst = append(dst, t.utcTime.UnixNano())
+	}
+	return dst
+}
+
+// DecodeUnixNanos returns the time of each Unix time in nanoseconds in ns, in
+// the specified timezone, in a single allocation.
+func DecodeUnixNanos[TZ Timezone](ns []int64) []Time[TZ] {
+	return AppendDecodedUnixNanos(make([]Time[TZ], 0, len(ns)), ns)
+}
+
+// AppendDecodedUnixNanos appends the time of each Unix time in nanoseconds in
+// ns to dst and returns the extended slice.
+func AppendDecodedUnixNanos[TZ Timezone](dst []Time[TZ], ns []int64) []Time[TZ] {
+	for _, n := range ns {
+		dst = append(dst, Time[TZ]{utcTime: time.Unix(0, n).UTC()})
+	}
+	return dst
+}
+
+// EncodeRFC3339 formats each element of ts as an RFC 3339 string with
+// nanosecond precision in the timezone's location, as MarshalText does.
+func EncodeRFC3339[TZ Timezone](ts []Time[TZ]) []string {
+	loc := getLocation[TZ]()
+	out := make([]string, len(ts))
+	var buf []byte
+	for i, t := range ts {
+		buf = t.utcTime.In(loc).AppendFormat(buf[:0], time.RFC3339Nano)
+		out[i] = string(buf)
+	}
+	return out
+}
+
+// DecodeRFC3339 parses each element of values as an RFC 3339 time and returns
+// the times in the specified timezone. If an element cannot be parsed, the
+// error identifies its index and no times are returned.
+func DecodeRFC3339[TZ Timezone](values []string) ([]Time[TZ], error) {
+	out := make([]Time[TZ], len(values))
+	for i, value := range values {
+		t, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return nil, fmt.Errorf("element %d: %w", i, err)
+		}
+		out[i] = Time[TZ]{utcTime: t.UTC()}
+	}
+	return out, nil
+}
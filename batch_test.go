@@ -0,0 +1,95 @@
+package meridian
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestUnixNanosRoundTrip(t *testing.T) {
+	ts := []Time[EST]{
+		Unix[EST](0, 0),
+		Date[EST](2024, time.January, 15, 12, 0, 0, 123456789),
+		Date[EST](1969, time.July, 20, 16, 17, 40, 0),
+	}
+
+	ns := EncodeUnixNanos(ts)
+	if len(ns) != len(ts) {
+		t.Fatalf("EncodeUnixNanos() returned %d values, want %d", len(ns), len(ts))
+	}
+	for i, n := range ns {
+		if want := ts[i].UnixNano(); n != want {
+			t.Errorf("EncodeUnixNanos()[%d] = %d, want %d", i, n, want)
+		}
+	}
+
+	decoded := DecodeUnixNanos[EST](ns)
+	for i, got := range decoded {
+		if got != ts[i] {
+			t.Errorf("DecodeUnixNanos()[%d] = %v, want %v", i, got, ts[i])
+		}
+	}
+}
+
+func TestAppendUnixNanos(t *testing.T) {
+	ts := []Time[UTC]{Unix[UTC](1, 0), Unix[UTC](2, 0)}
+
+	buf := make([]int64, 0, 4)
+	buf = AppendUnixNanos(append(buf, -1), ts)
+	if want := []int64{-1, 1e9, 2e9}; !equalInt64s(buf, want) {
+		t.Errorf("AppendUnixNanos() = %v, want %v", buf, want)
+	}
+
+	decoded := AppendDecodedUnixNanos([]Time[UTC]{{}}, []int64{1e9})
+	if len(decoded) != 2 || !decoded[0].IsZero() || decoded[1] != ts[0] {
+		t.Errorf("AppendDecodedUnixNanos() = %v, want [zero %v]", decoded, ts[0])
+	}
+}
+
+func TestRFC3339RoundTrip(t *testing.T) {
+	ts := []Time[EST]{
+		Date[EST](2024, time.January, 15, 12, 0, 0, 0),
+		Date[EST](2024, time.July, 15, 12, 0, 0, 123456789),
+	}
+
+	encoded := EncodeRFC3339(ts)
+	want := []string{"2024-01-15T12:00:00-05:00", "2024-07-15T12:00:00.123456789-04:00"}
+	if strings.Join(encoded, ",") != strings.Join(want, ",") {
+		t.Errorf("EncodeRFC3339() = %q, want %q", encoded, want)
+	}
+
+	decoded, err := DecodeRFC3339[EST](encoded)
+	if err != nil {
+		t.Fatalf("DecodeRFC3339() error = %v", err)
+	}
+	for i, got := range decoded {
+		if got != ts[i] {
+			t.Errorf("DecodeRFC3339()[%d] = %v, want %v", i, got, ts[i])
+		}
+	}
+}
+
+func TestDecodeRFC3339Invalid(t *testing.T) {
+	decoded, err := DecodeRFC3339[UTC]([]string{"2024-01-15T12:00:00Z", "yesterday"})
+	if err == nil {
+		t.Fatal("DecodeRFC3339() expected error, got nil")
+	}
+	if !strings.HasPrefix(err.Error(), "element 1: ") {
+		t.Errorf("DecodeRFC3339() error = %q, want it to identify element 1", err)
+	}
+	if decoded != nil {
+		t.Errorf("DecodeRFC3339() = %v, want nil on error", decoded)
+	}
+}
+
+func equalInt64s(a, b []int64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,162 @@
+package benchmarks
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/matthalp/go-meridian/v2/timezones/et"
+)
+
+// eastern is the location of the et package, loaded once as et does.
+var eastern = et.Timezone{}.Location()
+
+// Sinks keep the compiler from optimizing away benchmarked calls.
+var (
+	sinkET     et.Time
+	sinkTime   time.Time
+	sinkString string
+	sinkBytes  []byte
+)
+
+func BenchmarkNow(b *testing.B) {
+	b.Run("meridian", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			sinkET = et.Now()
+		}
+	})
+	b.Run("time", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			sinkTime = time.Now().In(eastern)
+		}
+	})
+}
+
+func BenchmarkDate(b *testing.B) {
+	b.Run("meridian", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			sinkET = et.Date(2024, time.July, 15, 12, 30, 0, 0)
+		}
+	})
+	b.Run("time", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			sinkTime = time.Date(2024, time.July, 15, 12, 30, 0, 0, eastern)
+		}
+	})
+}
+
+func BenchmarkFormat(b *testing.B) {
+	b.Run("meridian", func(b *testing.B) {
+		t := et.Date(2024, time.July, 15, 12, 30, 0, 0)
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			sinkString = t.Format(time.RFC3339)
+		}
+	})
+	b.Run("time", func(b *testing.B) {
+		t := time.Date(2024, time.July, 15, 12, 30, 0, 0, eastern)
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			sinkString = t.Format(time.RFC3339)
+		}
+	})
+}
+
+func BenchmarkAdd(b *testing.B) {
+	b.Run("meridian", func(b *testing.B) {
+		t := et.Date(2024, time.July, 15, 12, 30, 0, 0)
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			sinkET = t.Add(time.Hour)
+		}
+	})
+	b.Run("time", func(b *testing.B) {
+		t := time.Date(2024, time.July, 15, 12, 30, 0, 0, eastern)
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			sinkTime = t.Add(time.Hour)
+		}
+	})
+}
+
+func BenchmarkMarshalJSON(b *testing.B) {
+	b.Run("meridian", func(b *testing.B) {
+		t := et.Date(2024, time.July, 15, 12, 30, 0, 0)
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			sinkBytes, _ = json.Marshal(t)
+		}
+	})
+	b.Run("time", func(b *testing.B) {
+		t := time.Date(2024, time.July, 15, 12, 30, 0, 0, eastern)
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			sinkBytes, _ = json.Marshal(t)
+		}
+	})
+}
+
+func BenchmarkUnmarshalJSON(b *testing.B) {
+	data := []byte(`"2024-07-15T12:30:00-04:00"`)
+	b.Run("meridian", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if err := json.Unmarshal(data, &sinkET); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("time", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if err := json.Unmarshal(data, &sinkTime); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+// BenchmarkScan compares Scan with what a driver hands database/sql for a
+// DATETIME column: a time.Time when the driver parses times, and text
+// otherwise. The time baseline for text is a plain time.Parse.
+func BenchmarkScan(b *testing.B) {
+	value := time.Date(2024, time.July, 15, 16, 30, 0, 0, time.UTC)
+	text := []byte("2024-07-15 16:30:00")
+
+	b.Run("meridian/time.Time", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if err := sinkET.Scan(value); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("time/time.Time", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			sinkTime = value
+		}
+	})
+	b.Run("meridian/text", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if err := sinkET.Scan(text); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("time/text", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			t, err := time.Parse("2006-01-02 15:04:05", string(text))
+			if err != nil {
+				b.Fatal(err)
+			}
+			sinkTime = t
+		}
+	})
+}
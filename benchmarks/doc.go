@@ -0,0 +1,10 @@
+// Package benchmarks measures the overhead of meridian relative to the
+// equivalent time.Time operations.
+//
+// Each benchmark has a "meridian" and a "time" sub-benchmark doing the same
+// work, so results can be read side by side:
+//
+//	go test -run '^$' -bench . -benchmem ./benchmarks
+//
+// The package has no API; it exists only for its benchmarks.
+package benchmarks
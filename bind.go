@@ -0,0 +1,32 @@
+package meridian
+
+// UnmarshalParam parses a query, path, or form parameter using the same
+// default layouts as ParseEnv and stores the result in t. It implements the
+// BindUnmarshaler interface of github.com/labstack/echo/v4 and of the binding
+// package of github.com/gin-gonic/gin (v1.10 and later), so Time[TZ] fields
+// bind directly from requests:
+//
+//	type ReportRequest struct {
+//		Since et.Time `query:"since" form:"since"`
+//	}
+//
+// Values without an offset, such as "2024-12-31", are interpreted in the
+// timezone's location. For other layouts, see the meridianbind package.
+func (t *Time[TZ]) UnmarshalParam(param string) error {
+	return t.Decode(param)
+}
+
+// UnmarshalParam parses a request parameter as Time.UnmarshalParam does. An
+// empty parameter produces an invalid Null.
+func (n *Null[TZ]) UnmarshalParam(param string) error {
+	if param == "" {
+		n.Time, n.Valid = Time[TZ]{}, false
+		return nil
+	}
+	if err := n.Time.UnmarshalParam(param); err != nil {
+		n.Valid = false
+		return err
+	}
+	n.Valid = true
+	return nil
+}
@@ -0,0 +1,38 @@
+package meridian
+
+import (
+	"testing"
+	"time"
+)
+
+func TestUnmarshalParam(t *testing.T) {
+	var since Time[EST]
+	if err := since.UnmarshalParam("2024-07-04"); err != nil {
+		t.Fatalf("UnmarshalParam() error = %v", err)
+	}
+	if want := time.Date(2024, time.July, 4, 4, 0, 0, 0, time.UTC); !since.Equal(want) {
+		t.Errorf("UnmarshalParam() = %v, want %v", since.UTC(), want)
+	}
+	if err := since.UnmarshalParam("2024-07-04T12:00:00Z"); err != nil || since.UTC().Hour() != 12 {
+		t.Errorf("UnmarshalParam(RFC 3339) = %v, %v", since.UTC(), err)
+	}
+	if err := since.UnmarshalParam("yesterday"); err == nil {
+		t.Error("UnmarshalParam(yesterday) succeeded, want error")
+	}
+}
+
+func TestNullUnmarshalParam(t *testing.T) {
+	n := Null[UTC]{Valid: true}
+	if err := n.UnmarshalParam(""); err != nil || n.Valid {
+		t.Errorf("UnmarshalParam(\"\") = %+v, %v, want invalid", n, err)
+	}
+	if err := n.UnmarshalParam("2024-07-04 09:30:00"); err != nil || !n.Valid {
+		t.Fatalf("UnmarshalParam() = %+v, %v, want valid", n, err)
+	}
+	if want := time.Date(2024, time.July, 4, 9, 30, 0, 0, time.UTC); !n.Time.Equal(want) {
+		t.Errorf("UnmarshalParam() Time = %v, want %v", n.Time, want)
+	}
+	if err := n.UnmarshalParam("nope"); err == nil || n.Valid {
+		t.Errorf("UnmarshalParam(nope) = %+v, %v, want error and invalid", n, err)
+	}
+}
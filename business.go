@@ -0,0 +1,195 @@
+package meridian
+
+import "time"
+
+// Calendar describes the working hours of a business in timezone TZ, such as
+// 9 a.m. to 5 p.m. Eastern Time on weekdays, for computing elapsed business
+// time between two moments. The zero Calendar is open all day, Monday
+// through Friday.
+type Calendar[TZ Timezone] struct {
+	// Open and Close are the wall-clock times of day at which the business
+	// opens and closes on working days, as durations since midnight, such as
+	// 9*time.Hour and 17*time.Hour. If Close is zero, working days are open
+	// until midnight. Working hours keep their wall-clock times across
+	// daylight saving time transitions.
+	Open, Close time.Duration
+
+	// Weekend lists the days of the week that are not working days. If nil,
+	// Saturday and Sunday are not working days; use an empty, non-nil slice
+	// for a business that works every day of the week.
+	Weekend []time.Weekday
+
+	// Holidays are days that are not working days, each given by any time on
+	// that day in TZ.
+	Holidays []Time[TZ]
+}
+
+// IsWorkingDay reports whether the day on which t falls, in TZ, is a working
+// day: neither a weekend day nor a holiday.
+func (c Calendar[TZ]) IsWorkingDay(t Time[TZ]) bool {
+	weekend := c.Weekend
+	if weekend == nil {
+		weekend = []time.Weekday{time.Saturday, time.Sunday}
+	}
+	for _, day := range weekend {
+		if t.Weekday() == day {
+			return false
+		}
+	}
+	for _, holiday := range c.Holidays {
+		if t.IsSameDay(holiday) {
+			return false
+		}
+	}
+	return true
+}
+
+// BusinessDurationBetween returns the working hours that elapse between start
+// and end: the time between them that falls within opening hours on working
+// days. SLA clocks, for example, only run while support is open. If end is
+// before start, the result is negative. Both parameters can be any Moment
+// (time.Time or Time[TZ]).
+func (c Calendar[TZ]) BusinessDurationBetween(start, end Moment) time.Duration {
+	s, e := FromMoment[TZ](start), FromMoment[TZ](end)
+	if e.Before(s) {
+		return -c.BusinessDurationBetween(end, start)
+	}
+	return c.OpenIntervals(Interval[TZ]{Start: s, End: e}).Duration()
+}
+
+// OpenIntervals returns the times within window at which the business is open.
+func (c Calendar[TZ]) OpenIntervals(window Interval[TZ]) IntervalSet[TZ] {
+	var open []Interval[TZ]
+	for day := window.Start.startOfDay(); day.Before(window.End); day = day.addWallDays(1).startOfDay() {
+		if hours, ok := c.hours(day); ok {
+			if hours = hours.Intersect(window); !hours.IsEmpty() {
+				open = append(open, hours)
+			}
+		}
+	}
+	return NewIntervalSet(open...)
+}
+
+// WorkingHours is a Calendar in any timezone. It lets calendars in different
+// timezones be combined, as by CommonWorkingHours; Calendar[TZ] implements it
+// for every TZ.
+type WorkingHours interface {
+	openUTC(start, end time.Time) []Interval[utcTimezone]
+}
+
+// utcTimezone is the UTC timezone, for combining calendars in different
+// timezones.
+type utcTimezone struct{}
+
+func (utcTimezone) Location() *time.Location { return time.UTC }
+
+func (c Calendar[TZ]) openUTC(start, end time.Time) []Interval[utcTimezone] {
+	open := c.OpenIntervals(Interval[TZ]{Start: FromMoment[TZ](start), End: FromMoment[TZ](end)}).intervals
+	out := make([]Interval[utcTimezone], len(open))
+	for i, o := range open {
+		out[i] = Interval[utcTimezone]{Start: FromMoment[utcTimezone](o.Start), End: FromMoment[utcTimezone](o.End)}
+	}
+	return out
+}
+
+// CommonWorkingHours returns the intervals within window during which every
+// calendar is open, in chronological order. Given the calendars of offices in
+// different timezones, these are the candidate slots for a meeting that falls
+// within everyone's working hours:
+//
+//	slots := meridian.CommonWorkingHours(
+//		meridian.Interval[utc.Timezone]{Start: monday, End: monday.AddDate(0, 0, 7)},
+//		meridian.Calendar[et.Timezone]{Open: 9 * time.Hour, Close: 17 * time.Hour},
+//		meridian.Calendar[cet.Timezone]{Open: 9 * time.Hour, Close: 17 * time.Hour},
+//	)
+//
+// The result is in the timezone of window. With no calendars, it is window.
+func CommonWorkingHours[TZ Timezone](window Interval[TZ], calendars ...WorkingHours) []Interval[TZ] {
+	common := NewIntervalSet(window)
+	for _, cal := range calendars {
+		open := cal.openUTC(window.Start.utcTime, window.End.utcTime)
+		intervals := make([]Interval[TZ], len(open))
+		for i, o := range open {
+			intervals[i] = Interval[TZ]{Start: FromMoment[TZ](o.Start), End: FromMoment[TZ](o.End)}
+		}
+		common = common.Intersect(IntervalSet[TZ]{intervals: intervals})
+	}
+	return common.Intervals()
+}
+
+// Endpoints selects whether WorkingDaysBetween counts the days on which its
+// start and end fall.
+type Endpoints int
+
+const (
+	// Exclusive counts only the days strictly between start and end.
+	Exclusive Endpoints = 0
+
+	// IncludeStart also counts the day of start.
+	IncludeStart Endpoints = 1
+
+	// IncludeEnd also counts the day of end.
+	IncludeEnd Endpoints = 2
+
+	// Inclusive counts the days of both start and end.
+	Inclusive = IncludeStart | IncludeEnd
+)
+
+// WorkingDaysBetween returns the number of working days of cal from the day
+// of start to the day of end, in TZ, counting the endpoint days as selected
+// by endpoints. For the lead time of an order placed on Monday and delivered
+// on Wednesday, for example, IncludeEnd counts Tuesday and Wednesday. When
+// start and end fall on the same day, that day is counted only if both
+// endpoints are included. If end is before start, the result is negative.
+// Both parameters can be any Moment (time.Time or Time[TZ]).
+func WorkingDaysBetween[TZ Timezone](start, end Moment, cal Calendar[TZ], endpoints Endpoints) int {
+	s, e := FromMoment[TZ](start), FromMoment[TZ](end)
+	if e.Before(s) {
+		swapped := endpoints &^ Inclusive
+		if endpoints&IncludeStart != 0 {
+			swapped |= IncludeEnd
+		}
+		if endpoints&IncludeEnd != 0 {
+			swapped |= IncludeStart
+		}
+		return -WorkingDaysBetween(end, start, cal, swapped)
+	}
+
+	first, last := s.startOfDay(), e.startOfDay()
+	if endpoints&IncludeStart == 0 {
+		first = first.addWallDays(1).startOfDay()
+	}
+	if endpoints&IncludeEnd != 0 {
+		last = last.addWallDays(1).startOfDay()
+	}
+
+	n := 0
+	for day := first; day.Before(last); day = day.addWallDays(1).startOfDay() {
+		if cal.IsWorkingDay(day) {
+			n++
+		}
+	}
+	return n
+}
+
+// hours returns the opening hours on the day of t, or false if it is not a
+// working day.
+func (c Calendar[TZ]) hours(t Time[TZ]) (Interval[TZ], bool) {
+	if !c.IsWorkingDay(t) {
+		return Interval[TZ]{}, false
+	}
+	closeAt := c.Close
+	if closeAt == 0 {
+		closeAt = 24 * time.Hour
+	}
+	return Interval[TZ]{Start: t.atWallClock(c.Open), End: t.atWallClock(closeAt)}, true
+}
+
+// atWallClock returns the time on t's day at which the clock reads d past
+// midnight, resolved as by Date.
+func (t Time[TZ]) atWallClock(d time.Duration) Time[TZ] {
+	year, month, day := t.Date()
+	hour, rest := int(d/time.Hour), d%time.Hour
+	minute, rest := int(rest/time.Minute), rest%time.Minute
+	return Date[TZ](year, month, day, hour, minute, int(rest/time.Second), int(rest%time.Second))
+}
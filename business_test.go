@@ -0,0 +1,178 @@
+package meridian
+
+import (
+	"testing"
+	"time"
+)
+
+// nineToFive is open 9 a.m. to 5 p.m. Eastern Time on weekdays, closed for
+// Independence Day 2024.
+var nineToFive = Calendar[EST]{
+	Open:     9 * time.Hour,
+	Close:    17 * time.Hour,
+	Holidays: []Time[EST]{Date[EST](2024, time.July, 4, 0, 0, 0, 0)},
+}
+
+func TestIsWorkingDay(t *testing.T) {
+	tests := []struct {
+		name string
+		cal  Calendar[EST]
+		t    Time[EST]
+		want bool
+	}{
+		{"weekday", nineToFive, Date[EST](2024, time.July, 3, 12, 0, 0, 0), true},
+		{"holiday", nineToFive, Date[EST](2024, time.July, 4, 23, 0, 0, 0), false},
+		{"Saturday", nineToFive, Date[EST](2024, time.July, 6, 12, 0, 0, 0), false},
+		{"Sunday", nineToFive, Date[EST](2024, time.July, 7, 12, 0, 0, 0), false},
+		{"custom weekend", Calendar[EST]{Weekend: []time.Weekday{time.Friday}}, Date[EST](2024, time.July, 6, 12, 0, 0, 0), true},
+		{"no weekend", Calendar[EST]{Weekend: []time.Weekday{}}, Date[EST](2024, time.July, 7, 12, 0, 0, 0), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.cal.IsWorkingDay(tt.t); got != tt.want {
+				t.Errorf("IsWorkingDay(%v) = %v, want %v", tt.t, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBusinessDurationBetween(t *testing.T) {
+	tests := []struct {
+		name       string
+		start, end Moment
+		want       time.Duration
+	}{
+		{"within a day", Date[EST](2024, time.July, 1, 10, 0, 0, 0), Date[EST](2024, time.July, 1, 12, 30, 0, 0), 150 * time.Minute},
+		{"before opening to after closing", Date[EST](2024, time.July, 1, 6, 0, 0, 0), Date[EST](2024, time.July, 1, 20, 0, 0, 0), 8 * time.Hour},
+		{"overnight", Date[EST](2024, time.July, 1, 16, 0, 0, 0), Date[EST](2024, time.July, 2, 10, 0, 0, 0), 2 * time.Hour},
+		{"skips the holiday", Date[EST](2024, time.July, 3, 16, 0, 0, 0), Date[EST](2024, time.July, 5, 10, 0, 0, 0), 2 * time.Hour},
+		{"skips the weekend", Date[EST](2024, time.July, 5, 16, 0, 0, 0), Date[EST](2024, time.July, 8, 10, 0, 0, 0), 2 * time.Hour},
+		{"full week", Date[EST](2024, time.July, 8, 0, 0, 0, 0), Date[EST](2024, time.July, 15, 0, 0, 0, 0), 40 * time.Hour},
+		{"outside hours", Date[EST](2024, time.July, 1, 18, 0, 0, 0), Date[EST](2024, time.July, 1, 22, 0, 0, 0), 0},
+		{"reversed", Date[EST](2024, time.July, 1, 12, 0, 0, 0), Date[EST](2024, time.July, 1, 10, 0, 0, 0), -2 * time.Hour},
+		{"moments in UTC", Date[UTC](2024, time.July, 1, 14, 0, 0, 0), time.Date(2024, time.July, 1, 16, 0, 0, 0, time.UTC), 2 * time.Hour},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := nineToFive.BusinessDurationBetween(tt.start, tt.end); got != tt.want {
+				t.Errorf("BusinessDurationBetween() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBusinessDurationBetweenAcrossDST(t *testing.T) {
+	// A calendar open around the clock still counts 23 hours on the day
+	// clocks spring forward.
+	allDay := Calendar[EST]{Weekend: []time.Weekday{}}
+	start, end := Date[EST](2024, time.March, 10, 0, 0, 0, 0), Date[EST](2024, time.March, 11, 0, 0, 0, 0)
+	if got := allDay.BusinessDurationBetween(start, end); got != 23*time.Hour {
+		t.Errorf("BusinessDurationBetween() on March 10 = %v, want 23h", got)
+	}
+
+	// Opening hours keep their wall-clock times.
+	hours := Calendar[EST]{Open: 9 * time.Hour, Close: 17 * time.Hour, Weekend: []time.Weekday{}}
+	if got := hours.BusinessDurationBetween(start, end); got != 8*time.Hour {
+		t.Errorf("BusinessDurationBetween() of working hours on March 10 = %v, want 8h", got)
+	}
+}
+
+func TestWorkingDaysBetween(t *testing.T) {
+	// Monday, July 1 to Monday, July 8, 2024 spans the July 4 holiday and a
+	// weekend.
+	monday := Date[EST](2024, time.July, 1, 15, 0, 0, 0)
+	nextMonday := Date[EST](2024, time.July, 8, 10, 0, 0, 0)
+
+	tests := []struct {
+		name       string
+		start, end Moment
+		endpoints  Endpoints
+		want       int
+	}{
+		{"exclusive", monday, nextMonday, Exclusive, 3},
+		{"include start", monday, nextMonday, IncludeStart, 4},
+		{"include end", monday, nextMonday, IncludeEnd, 4},
+		{"inclusive", monday, nextMonday, Inclusive, 5},
+		{"same day inclusive", monday, monday.Add(time.Hour), Inclusive, 1},
+		{"same day include end", monday, monday.Add(time.Hour), IncludeEnd, 0},
+		{"next day include end", monday, Date[EST](2024, time.July, 2, 9, 0, 0, 0), IncludeEnd, 1},
+		{"weekend endpoints", Date[EST](2024, time.July, 6, 12, 0, 0, 0), Date[EST](2024, time.July, 7, 12, 0, 0, 0), Inclusive, 0},
+		{"reversed", nextMonday, monday, IncludeEnd, -4},
+		{"reversed exclusive", nextMonday, monday, Exclusive, -3},
+		{"end day in EST, not UTC", monday, time.Date(2024, time.July, 3, 2, 0, 0, 0, time.UTC), IncludeEnd, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := WorkingDaysBetween(tt.start, tt.end, nineToFive, tt.endpoints); got != tt.want {
+				t.Errorf("WorkingDaysBetween() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+// cet is Central European Time, for combining calendars across timezones.
+type cet struct{}
+
+var cetLocation = func() *time.Location {
+	loc, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		panic(err)
+	}
+	return loc
+}()
+
+func (cet) Location() *time.Location { return cetLocation }
+
+func TestOpenIntervals(t *testing.T) {
+	window := Interval[EST]{Start: Date[EST](2024, time.July, 3, 12, 0, 0, 0), End: Date[EST](2024, time.July, 8, 10, 0, 0, 0)}
+
+	got := nineToFive.OpenIntervals(window).Intervals()
+	want := []Interval[EST]{
+		{Start: Date[EST](2024, time.July, 3, 12, 0, 0, 0), End: Date[EST](2024, time.July, 3, 17, 0, 0, 0)},
+		{Start: Date[EST](2024, time.July, 5, 9, 0, 0, 0), End: Date[EST](2024, time.July, 5, 17, 0, 0, 0)},
+		{Start: Date[EST](2024, time.July, 8, 9, 0, 0, 0), End: Date[EST](2024, time.July, 8, 10, 0, 0, 0)},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("OpenIntervals() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if !got[i].Start.Equal(want[i].Start) || !got[i].End.Equal(want[i].End) {
+			t.Errorf("OpenIntervals()[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestCommonWorkingHours(t *testing.T) {
+	// Monday, July 1 to Saturday, July 6, 2024. New York is six hours behind
+	// Berlin, so 9-17 in both overlaps from 9 a.m. to 11 a.m. in New York,
+	// except on July 4, a holiday in New York.
+	window := Interval[UTC]{Start: Date[UTC](2024, time.July, 1, 0, 0, 0, 0), End: Date[UTC](2024, time.July, 6, 0, 0, 0, 0)}
+	berlin := Calendar[cet]{Open: 9 * time.Hour, Close: 17 * time.Hour}
+
+	got := CommonWorkingHours(window, nineToFive, berlin)
+
+	var want []Interval[UTC]
+	for _, day := range []int{1, 2, 3, 5} {
+		want = append(want, Interval[UTC]{
+			Start: Date[UTC](2024, time.July, day, 13, 0, 0, 0),
+			End:   Date[UTC](2024, time.July, day, 15, 0, 0, 0),
+		})
+	}
+	if len(got) != len(want) {
+		t.Fatalf("CommonWorkingHours() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("CommonWorkingHours()[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+
+	if got := CommonWorkingHours(window); len(got) != 1 || got[0] != window {
+		t.Errorf("CommonWorkingHours() with no calendars = %v, want the window", got)
+	}
+
+	evenings := Calendar[UTC]{Open: 20 * time.Hour, Close: 22 * time.Hour}
+	if got := CommonWorkingHours(window, nineToFive, berlin, evenings); len(got) != 0 {
+		t.Errorf("CommonWorkingHours() with no overlap = %v, want none", got)
+	}
+}
@@ -0,0 +1,337 @@
+package meridian
+
+import (
+	"math/bits"
+	"time"
+)
+
+// Next returns the first time after t, at the same wall-clock time in the
+// timezone's location, that falls on weekday. If t is already on weekday, the
+// result is a week later. Wall-clock times that do not exist or are ambiguous
+// on the resulting day, because of a daylight saving time transition, are
+// resolved as by Date.
+func (t Time[TZ]) Next(weekday time.Weekday) Time[TZ] {
+	days := (int(weekday) - int(t.Weekday()) + 7) % 7
+	if days == 0 {
+		days = 7
+	}
+	return t.addWallDays(days)
+}
+
+// Previous returns the last time before t, at the same wall-clock time in the
+// timezone's location, that falls on weekday. If t is already on weekday, the
+// result is a week earlier. Transitions are resolved as by Next.
+func (t Time[TZ]) Previous(weekday time.Weekday) Time[TZ] {
+	days := (int(t.Weekday()) - int(weekday) + 7) % 7
+	if days == 0 {
+		days = 7
+	}
+	return t.addWallDays(-days)
+}
+
+// StartOfNext is like Next but returns midnight at the start of the day, in
+// the timezone's location, rather than the same wall-clock time as t.
+func (t Time[TZ]) StartOfNext(weekday time.Weekday) Time[TZ] {
+	return t.Next(weekday).startOfDay()
+}
+
+// StartOfPrevious is like Previous but returns midnight at the start of the
+// day, in the timezone's location, rather than the same wall-clock time as t.
+func (t Time[TZ]) StartOfPrevious(weekday time.Weekday) Time[TZ] {
+	return t.Previous(weekday).startOfDay()
+}
+
+// NextAt returns the first time after t at which the clock in the timezone's
+// location reads hour:minute:sec, such as 09:00:00 for a job that runs daily
+// at 9 a.m. Days on which the clock skips that time, because of a daylight
+// saving time transition, are skipped. On days when the clock reads it twice,
+// only the first, earlier instant counts, so a daily job runs once.
+//
+// hour, minute, and sec must be in their usual ranges; otherwise the clock
+// never reads that time and NextAt returns the zero Time.
+func (t Time[TZ]) NextAt(hour, minute, sec int) Time[TZ] {
+	return t.nextAt(-1, hour, minute, sec)
+}
+
+// NextAtOn is like NextAt but only considers days that fall on weekday, such
+// as 10:00 every Monday.
+func (t Time[TZ]) NextAtOn(weekday time.Weekday, hour, minute, sec int) Time[TZ] {
+	return t.nextAt(weekday, hour, minute, sec)
+}
+
+// nextAt implements NextAt and NextAtOn; a negative weekday matches any day.
+func (t Time[TZ]) nextAt(weekday time.Weekday, hour, minute, sec int) Time[TZ] {
+	if hour < 0 || hour > 23 || minute < 0 || minute > 59 || sec < 0 || sec > 59 {
+		return Time[TZ]{}
+	}
+	year, month, day := t.Date()
+	// Two weeks covers a matching weekday whose first occurrence is skipped.
+	for i := 0; i < 15; i++ {
+		date := time.Date(year, month, day+i, 0, 0, 0, 0, time.UTC)
+		if weekday >= 0 && date.Weekday() != weekday {
+			continue
+		}
+		at, ok := wallClockOn[TZ](date, hour, minute, sec)
+		if ok && at.After(t) {
+			return at
+		}
+	}
+	return Time[TZ]{}
+}
+
+// wallClockOn returns the earliest instant on date, a midnight in UTC naming
+// a calendar day, at which the clock in TZ's location reads hour:minute:sec.
+// It reports false if the clock skips that time on that day.
+func wallClockOn[TZ Timezone](date time.Time, hour, minute, sec int) (Time[TZ], bool) {
+	loc := getLocation[TZ]()
+	year, month, day := date.Date()
+	at := time.Date(year, month, day, hour, minute, sec, 0, loc)
+
+	// If the offset just changed backwards, the clock also read this time
+	// before the transition; prefer that earlier instant.
+	if start, _ := at.ZoneBounds(); !start.IsZero() {
+		_, before := start.Add(-time.Nanosecond).Zone()
+		_, after := at.Zone()
+		if before > after {
+			if earlier := at.Add(-time.Duration(before-after) * time.Second); readsClock(earlier, date, hour, minute, sec) {
+				at = earlier
+			}
+		}
+	}
+	if !readsClock(at, date, hour, minute, sec) {
+		return Time[TZ]{}, false
+	}
+	return Time[TZ]{utcTime: at.UTC()}, true
+}
+
+// readsClock reports whether t falls on the calendar day of date at
+// hour:minute:sec.
+func readsClock(t, date time.Time, hour, minute, sec int) bool {
+	y, m, d := t.Date()
+	dy, dm, dd := date.Date()
+	h, mi, s := t.Clock()
+	return y == dy && m == dm && d == dd && h == hour && mi == minute && s == sec
+}
+
+// LastDayOfMonth returns the same wall-clock time as t on the last day of t's
+// month in the timezone's location, such as February 29 for a time in
+// February 2024.
+func (t Time[TZ]) LastDayOfMonth() Time[TZ] {
+	year, month, _, hour, minute, sec, nsec := t.DateTime()
+	return Date[TZ](year, month, daysIn(year, month), hour, minute, sec, nsec)
+}
+
+// AddMonthsClamped returns t with months added to its month, at the same
+// wall-clock time, clamping the day to the last day of the resulting month.
+// Unlike AddDate(0, 1, 0), which normalizes January 31 to March 2 or 3, it
+// returns the last day of February, so a schedule anchored to a month-end
+// stays on month-ends.
+func (t Time[TZ]) AddMonthsClamped(months int) Time[TZ] {
+	year, month, day, hour, minute, sec, nsec := t.DateTime()
+	first := time.Date(year, month+time.Month(months), 1, 0, 0, 0, 0, time.UTC)
+	year, month = first.Year(), first.Month()
+	if days := daysIn(year, month); day > days {
+		day = days
+	}
+	return Date[TZ](year, month, day, hour, minute, sec, nsec)
+}
+
+// CalendarDuration is an amount of calendar time, such as 1 month and 3 days,
+// whose length in hours depends on where it is applied: a month may have 28
+// to 31 days, and a day 23 to 25 hours across a daylight saving time
+// transition. Duration is the elapsed time added after the calendar fields.
+// Negative fields move backwards.
+type CalendarDuration struct {
+	Years, Months, Days int
+	Duration            time.Duration
+}
+
+// AddCalendar returns t advanced by d in the timezone's location. Years and
+// months are added first, keeping the wall-clock time and clamping the day to
+// the end of a shorter month as AddMonthsClamped does, so January 31 plus one
+// month is the last day of February. Days are then added at the same
+// wall-clock time, and finally d.Duration of elapsed time.
+func (t Time[TZ]) AddCalendar(d CalendarDuration) Time[TZ] {
+	return t.AddMonthsClamped(12*d.Years + d.Months).addWallDays(d.Days).Add(d.Duration)
+}
+
+// DiffCalendar returns the calendar time from a to b: the largest number of
+// whole years, then months, then days that can be added to a with
+// AddCalendar without passing b, and the elapsed time that remains, such
+// that a.AddCalendar(DiffCalendar(a, b)) equals b. Both are compared in the
+// timezone's location. If b is before a, every field is zero or negative,
+// counting back from a in the same way. Because months are clamped to their
+// last day, this is not always the negation of DiffCalendar(b, a): from
+// March 31 back to February 28, 2024 is -1 month and -1 day, by way of
+// February 29, whereas from February 28 to March 31 is 1 month and 3 days.
+func DiffCalendar[TZ Timezone](a, b Time[TZ]) CalendarDuration {
+	step := 1
+	passed := b.Before
+	if b.Before(a) {
+		step, passed = -1, b.After
+	}
+
+	ay, am, _ := a.Date()
+	by, bm, _ := b.Date()
+	months := (by-ay)*12 + int(bm-am)
+	if passed(a.AddMonthsClamped(months)) {
+		months -= step
+	}
+	c := a.AddMonthsClamped(months)
+
+	cy, cm, cd := c.Date()
+	by, bm, bd := b.Date()
+	days := int(time.Date(by, bm, bd, 0, 0, 0, 0, time.UTC).Sub(time.Date(cy, cm, cd, 0, 0, 0, 0, time.UTC)) / (24 * time.Hour))
+	if passed(c.addWallDays(days)) {
+		days -= step
+	}
+	c = c.addWallDays(days)
+
+	return CalendarDuration{Years: months / 12, Months: months % 12, Days: days, Duration: b.Sub(c)}
+}
+
+// NthWeekdayOfMonth returns midnight, in the specified timezone's location, of
+// the nth occurrence of weekday in the given month, such as the second Tuesday
+// for n = 2. Negative n counts from the end of the month, so n = -1 is the
+// last occurrence. It reports false if the month has no such occurrence, as
+// for n = 0, or n = 5 in a month with only four of that weekday. Out-of-range
+// months are normalized as by Date.
+func NthWeekdayOfMonth[TZ Timezone](year int, month time.Month, weekday time.Weekday, n int) (Time[TZ], bool) {
+	// Work in UTC, where every day is 24 hours, to find the day of the month.
+	first := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	year, month = first.Year(), first.Month()
+	days := daysIn(year, month)
+
+	var day int
+	switch {
+	case n > 0:
+		day = 1 + (int(weekday)-int(first.Weekday())+7)%7 + (n-1)*7
+	case n < 0:
+		last := time.Date(year, month, days, 0, 0, 0, 0, time.UTC)
+		day = days - (int(last.Weekday())-int(weekday)+7)%7 + (n+1)*7
+	}
+	if day < 1 || day > days {
+		return Time[TZ]{}, false
+	}
+	return Date[TZ](year, month, day, 0, 0, 0, 0), true
+}
+
+// daysIn returns the number of days in the given month.
+func daysIn(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// IsSameDay reports whether t and u fall on the same calendar day in t's
+// timezone. The parameter u can be any Moment (time.Time or Time[TZ]); it is
+// converted to t's timezone first, so 11 p.m. Eastern Time and 4 a.m. UTC the
+// next morning are the same Eastern day.
+func (t Time[TZ]) IsSameDay(u Moment) bool {
+	y1, m1, d1 := t.Date()
+	y2, m2, d2 := FromMoment[TZ](u).Date()
+	return y1 == y2 && m1 == m2 && d1 == d2
+}
+
+// IsSameMonth reports whether t and u fall in the same month of the same year
+// in t's timezone. See IsSameDay.
+func (t Time[TZ]) IsSameMonth(u Moment) bool {
+	y1, m1, _ := t.Date()
+	y2, m2, _ := FromMoment[TZ](u).Date()
+	return y1 == y2 && m1 == m2
+}
+
+// IsSameYear reports whether t and u fall in the same year in t's timezone.
+// See IsSameDay.
+func (t Time[TZ]) IsSameYear(u Moment) bool {
+	return t.Year() == FromMoment[TZ](u).Year()
+}
+
+// LeapDayPolicy controls where NextAnniversary places the anniversary of
+// February 29 in years that have no such day.
+type LeapDayPolicy int
+
+const (
+	// LeapDayFeb28 observes the anniversary on February 28, the last day of
+	// February. This is the zero value.
+	LeapDayFeb28 LeapDayPolicy = iota
+
+	// LeapDayMar1 observes the anniversary on March 1, the day after
+	// February 28.
+	LeapDayMar1
+)
+
+// NextAnniversary returns the first yearly anniversary of origin that is after
+// after, such as the next birthday or subscription renewal. Anniversaries fall
+// on origin's month and day, at origin's wall-clock time, in the specified
+// timezone; if after is before origin, the result is origin itself.
+// Anniversaries of February 29 are placed in other years according to policy.
+// Both parameters can be any Moment (time.Time or Time[TZ]).
+func NextAnniversary[TZ Timezone](origin, after Moment, policy LeapDayPolicy) Time[TZ] {
+	o, a := FromMoment[TZ](origin), FromMoment[TZ](after)
+	_, month, day, hour, minute, sec, nsec := o.DateTime()
+
+	year := a.Year()
+	if oy := o.Year(); year < oy {
+		year = oy
+	}
+	for ; ; year++ {
+		m, d := month, day
+		if m == time.February && d == 29 && daysIn(year, time.February) == 28 {
+			if policy == LeapDayMar1 {
+				m, d = time.March, 1
+			} else {
+				d = 28
+			}
+		}
+		if next := Date[TZ](year, m, d, hour, minute, sec, nsec); next.After(a) {
+			return next
+		}
+	}
+}
+
+// addWallDays returns the time days calendar days after t at the same
+// wall-clock time, unlike Add(days*24*time.Hour), which drifts by an hour
+// across a daylight saving time transition.
+func (t Time[TZ]) addWallDays(days int) Time[TZ] {
+	year, month, day, hour, minute, sec, nsec := t.DateTime()
+	return Date[TZ](year, month, day+days, hour, minute, sec, nsec)
+}
+
+// startOfDay returns midnight at the start of t's day in the timezone's location.
+func (t Time[TZ]) startOfDay() Time[TZ] {
+	year, month, day := t.Date()
+	return Date[TZ](year, month, day, 0, 0, 0, 0)
+}
+
+// Bucket returns the start of the d-long bucket containing t, with buckets
+// counted from midnight of t's day in the timezone's location, so hourly or
+// 5-minute buckets follow the local calendar rather than UTC. Buckets measure
+// elapsed time: on a day with a daylight saving time transition they stay d
+// long but shift against the wall clock after the transition, and the last
+// bucket of any day ends at the next midnight, shorter if d does not divide
+// the day. If d <= 0, Bucket returns t unchanged.
+func (t Time[TZ]) Bucket(d time.Duration) Time[TZ] {
+	if d <= 0 {
+		return t
+	}
+	start := t.startOfDay()
+	elapsed := t.Sub(start)
+	return start.Add(elapsed - elapsed%d)
+}
+
+// BucketOfDay divides t's day in the timezone's location into n equal
+// buckets and returns the index, from 0 to n-1, of the bucket containing t.
+// The buckets divide the day's actual length, which is 23 or 25 hours on a
+// day with a daylight saving time transition. If n <= 0, BucketOfDay
+// returns 0.
+func (t Time[TZ]) BucketOfDay(n int) int {
+	if n <= 0 {
+		return 0
+	}
+	start := t.startOfDay()
+	year, month, day := t.Date()
+	length := Date[TZ](year, month, day+1, 0, 0, 0, 0).Sub(start)
+	// elapsed*n/length, computed in 128 bits since elapsed*n can overflow.
+	hi, lo := bits.Mul64(uint64(t.Sub(start)), uint64(n))
+	index, _ := bits.Div64(hi, lo, uint64(length))
+	return int(index)
+}
@@ -0,0 +1,417 @@
+package meridian
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNext(t *testing.T) {
+	// Wednesday, March 6, 2024, 9:30 a.m. EST.
+	wednesday := Date[EST](2024, time.March, 6, 9, 30, 0, 0)
+
+	tests := []struct {
+		name    string
+		weekday time.Weekday
+		want    Time[EST]
+	}{
+		{"later this week", time.Friday, Date[EST](2024, time.March, 8, 9, 30, 0, 0)},
+		{"next week", time.Monday, Date[EST](2024, time.March, 11, 9, 30, 0, 0)},
+		{"same weekday is a week later", time.Wednesday, Date[EST](2024, time.March, 13, 9, 30, 0, 0)},
+		{"keeps wall clock across DST", time.Sunday, Date[EST](2024, time.March, 10, 9, 30, 0, 0)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := wednesday.Next(tt.weekday)
+			if !got.Equal(tt.want) {
+				t.Errorf("Next(%v) = %v, want %v", tt.weekday, got, tt.want)
+			}
+			if got.Weekday() != tt.weekday {
+				t.Errorf("Next(%v).Weekday() = %v", tt.weekday, got.Weekday())
+			}
+		})
+	}
+
+	// Across the March 10 transition the result is 23 hours closer than a
+	// naive Add would give.
+	if got := wednesday.Next(time.Monday).Sub(wednesday); got != 5*24*time.Hour-time.Hour {
+		t.Errorf("Next(Monday) is %v after t, want %v", got, 5*24*time.Hour-time.Hour)
+	}
+}
+
+func TestPrevious(t *testing.T) {
+	wednesday := Date[EST](2024, time.March, 13, 9, 30, 0, 0)
+
+	tests := []struct {
+		name    string
+		weekday time.Weekday
+		want    Time[EST]
+	}{
+		{"earlier this week", time.Monday, Date[EST](2024, time.March, 11, 9, 30, 0, 0)},
+		{"last week", time.Friday, Date[EST](2024, time.March, 8, 9, 30, 0, 0)},
+		{"same weekday is a week earlier", time.Wednesday, Date[EST](2024, time.March, 6, 9, 30, 0, 0)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := wednesday.Previous(tt.weekday); !got.Equal(tt.want) {
+				t.Errorf("Previous(%v) = %v, want %v", tt.weekday, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStartOfNextAndPrevious(t *testing.T) {
+	wednesday := Date[EST](2024, time.March, 6, 9, 30, 0, 0)
+
+	if got, want := wednesday.StartOfNext(time.Monday), Date[EST](2024, time.March, 11, 0, 0, 0, 0); !got.Equal(want) {
+		t.Errorf("StartOfNext(Monday) = %v, want %v", got, want)
+	}
+	if got, want := wednesday.StartOfPrevious(time.Wednesday), Date[EST](2024, time.February, 28, 0, 0, 0, 0); !got.Equal(want) {
+		t.Errorf("StartOfPrevious(Wednesday) = %v, want %v", got, want)
+	}
+}
+
+func TestNthWeekdayOfMonth(t *testing.T) {
+	tests := []struct {
+		name    string
+		year    int
+		month   time.Month
+		weekday time.Weekday
+		n       int
+		want    int // day of the month, or 0 if there is no such occurrence
+	}{
+		{"second Tuesday", 2024, time.March, time.Tuesday, 2, 12},
+		{"first day is the weekday", 2024, time.March, time.Friday, 1, 1},
+		{"US DST start", 2024, time.March, time.Sunday, 2, 10},
+		{"US DST end", 2024, time.November, time.Sunday, 1, 3},
+		{"last Friday", 2024, time.May, time.Friday, -1, 31},
+		{"last day is not the weekday", 2024, time.May, time.Monday, -1, 27},
+		{"second to last", 2024, time.May, time.Friday, -2, 24},
+		{"fifth occurrence", 2024, time.May, time.Friday, 5, 31},
+		{"no fifth occurrence", 2024, time.May, time.Monday, 5, 0},
+		{"leap day", 2024, time.February, time.Thursday, -1, 29},
+		{"zero", 2024, time.May, time.Friday, 0, 0},
+		{"normalized month", 2023, 15, time.Tuesday, 2, 12},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := NthWeekdayOfMonth[EST](tt.year, tt.month, tt.weekday, tt.n)
+			if tt.want == 0 {
+				if ok {
+					t.Errorf("NthWeekdayOfMonth() = %v, want no occurrence", got)
+				}
+				return
+			}
+			if !ok {
+				t.Fatal("NthWeekdayOfMonth() reported no occurrence")
+			}
+			if got.Day() != tt.want || got.Weekday() != tt.weekday || got.Hour() != 0 {
+				t.Errorf("NthWeekdayOfMonth() = %v, want %v on day %d at midnight", got, tt.weekday, tt.want)
+			}
+		})
+	}
+}
+
+func TestLastDayOfMonth(t *testing.T) {
+	tests := []struct {
+		t    Time[EST]
+		want Time[EST]
+	}{
+		{Date[EST](2024, time.January, 15, 9, 30, 0, 0), Date[EST](2024, time.January, 31, 9, 30, 0, 0)},
+		{Date[EST](2024, time.February, 1, 0, 0, 0, 0), Date[EST](2024, time.February, 29, 0, 0, 0, 0)},
+		{Date[EST](2023, time.February, 28, 12, 0, 0, 0), Date[EST](2023, time.February, 28, 12, 0, 0, 0)},
+		{Date[EST](2024, time.April, 30, 23, 59, 59, 0), Date[EST](2024, time.April, 30, 23, 59, 59, 0)},
+	}
+	for _, tt := range tests {
+		if got := tt.t.LastDayOfMonth(); !got.Equal(tt.want) {
+			t.Errorf("%v.LastDayOfMonth() = %v, want %v", tt.t, got, tt.want)
+		}
+	}
+}
+
+func TestAddMonthsClamped(t *testing.T) {
+	jan31 := Date[EST](2024, time.January, 31, 9, 30, 0, 0)
+
+	tests := []struct {
+		name   string
+		t      Time[EST]
+		months int
+		want   Time[EST]
+	}{
+		{"clamps to leap day", jan31, 1, Date[EST](2024, time.February, 29, 9, 30, 0, 0)},
+		{"clamps to February 28", Date[EST](2023, time.January, 31, 9, 30, 0, 0), 1, Date[EST](2023, time.February, 28, 9, 30, 0, 0)},
+		{"no clamping needed", jan31, 2, Date[EST](2024, time.March, 31, 9, 30, 0, 0)},
+		{"clamps to 30 days", jan31, 3, Date[EST](2024, time.April, 30, 9, 30, 0, 0)},
+		{"across years", jan31, 13, Date[EST](2025, time.February, 28, 9, 30, 0, 0)},
+		{"backwards", Date[EST](2024, time.March, 31, 9, 30, 0, 0), -1, Date[EST](2024, time.February, 29, 9, 30, 0, 0)},
+		{"backwards across years", jan31, -2, Date[EST](2023, time.November, 30, 9, 30, 0, 0)},
+		{"zero", jan31, 0, jan31},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.t.AddMonthsClamped(tt.months); !got.Equal(tt.want) {
+				t.Errorf("AddMonthsClamped(%d) = %v, want %v", tt.months, got, tt.want)
+			}
+		})
+	}
+
+	// AddDate normalizes instead.
+	if got := jan31.AddDate(0, 1, 0); got.Month() != time.March {
+		t.Errorf("AddDate(0, 1, 0) = %v, want a time in March", got)
+	}
+}
+
+func TestNextAt(t *testing.T) {
+	tests := []struct {
+		name string
+		t    Time[EST]
+		hour int
+		want Time[EST]
+	}{
+		{"later today", Date[EST](2024, time.June, 3, 8, 0, 0, 0), 9, Date[EST](2024, time.June, 3, 9, 0, 0, 0)},
+		{"tomorrow", Date[EST](2024, time.June, 3, 10, 0, 0, 0), 9, Date[EST](2024, time.June, 4, 9, 0, 0, 0)},
+		{"exactly now is tomorrow", Date[EST](2024, time.June, 3, 9, 0, 0, 0), 9, Date[EST](2024, time.June, 4, 9, 0, 0, 0)},
+		{"across spring forward", Date[EST](2024, time.March, 9, 10, 0, 0, 0), 9, Date[EST](2024, time.March, 10, 9, 0, 0, 0)},
+		{"skips the missing hour", Date[EST](2024, time.March, 9, 10, 0, 0, 0), 2, Date[EST](2024, time.March, 11, 2, 0, 0, 0)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.t.NextAt(tt.hour, 0, 0)
+			if !got.Equal(tt.want) {
+				t.Errorf("NextAt(%d, 0, 0) = %v, want %v", tt.hour, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNextAtRepeatedHour(t *testing.T) {
+	// On November 3, 2024, 1:30 a.m. EDT is followed an hour later by
+	// 1:30 a.m. EST. Only the first counts.
+	first := time.Date(2024, time.November, 3, 5, 30, 0, 0, time.UTC)
+
+	got := FromMoment[EST](first.Add(-time.Hour)).NextAt(1, 30, 0)
+	if !got.Equal(first) {
+		t.Errorf("NextAt() before the repeated hour = %v, want %v", got.UTC(), first)
+	}
+
+	got = FromMoment[EST](first).NextAt(1, 30, 0)
+	if want := Date[EST](2024, time.November, 4, 1, 30, 0, 0); !got.Equal(want) {
+		t.Errorf("NextAt() between the repeated times = %v, want %v", got, want)
+	}
+}
+
+func TestNextAtOn(t *testing.T) {
+	wednesday := Date[EST](2024, time.March, 6, 9, 30, 0, 0)
+
+	if got, want := wednesday.NextAtOn(time.Wednesday, 10, 0, 0), Date[EST](2024, time.March, 6, 10, 0, 0, 0); !got.Equal(want) {
+		t.Errorf("NextAtOn(Wednesday, 10:00) = %v, want %v", got, want)
+	}
+	if got, want := wednesday.NextAtOn(time.Wednesday, 9, 0, 0), Date[EST](2024, time.March, 13, 9, 0, 0, 0); !got.Equal(want) {
+		t.Errorf("NextAtOn(Wednesday, 09:00) = %v, want %v", got, want)
+	}
+	// 2:30 a.m. is skipped on Sunday, March 10, so the next Sunday follows.
+	if got, want := wednesday.NextAtOn(time.Sunday, 2, 30, 0), Date[EST](2024, time.March, 17, 2, 30, 0, 0); !got.Equal(want) {
+		t.Errorf("NextAtOn(Sunday, 02:30) = %v, want %v", got, want)
+	}
+}
+
+func TestNextAtOutOfRange(t *testing.T) {
+	now := Date[EST](2024, time.June, 3, 8, 0, 0, 0)
+	for _, clock := range [][3]int{{24, 0, 0}, {-1, 0, 0}, {9, 60, 0}, {9, 0, 60}} {
+		if got := now.NextAt(clock[0], clock[1], clock[2]); !got.IsZero() {
+			t.Errorf("NextAt(%v) = %v, want zero Time", clock, got)
+		}
+	}
+}
+
+func TestIsSame(t *testing.T) {
+	// 11 p.m. EST on December 31, 2024 is 4 a.m. UTC on January 1, 2025.
+	evening := Date[EST](2024, time.December, 31, 23, 0, 0, 0)
+	utcMorning := Date[UTC](2025, time.January, 1, 4, 0, 0, 0)
+
+	tests := []struct {
+		name                         string
+		t                            Time[EST]
+		u                            Moment
+		sameDay, sameMonth, sameYear bool
+	}{
+		{"same instant in another zone", evening, utcMorning, true, true, true},
+		{"same day", evening, Date[EST](2024, time.December, 31, 0, 0, 0, 0), true, true, true},
+		{"next day", evening, Date[EST](2025, time.January, 1, 0, 0, 0, 0), false, false, false},
+		{"same month", evening, Date[EST](2024, time.December, 1, 0, 0, 0, 0), false, true, true},
+		{"same year", evening, Date[EST](2024, time.January, 31, 23, 0, 0, 0), false, false, true},
+		{"same day a year apart", evening, Date[EST](2023, time.December, 31, 23, 0, 0, 0), false, false, false},
+		{"time.Time", evening, time.Date(2025, time.January, 1, 4, 59, 0, 0, time.UTC), true, true, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.t.IsSameDay(tt.u); got != tt.sameDay {
+				t.Errorf("IsSameDay() = %v, want %v", got, tt.sameDay)
+			}
+			if got := tt.t.IsSameMonth(tt.u); got != tt.sameMonth {
+				t.Errorf("IsSameMonth() = %v, want %v", got, tt.sameMonth)
+			}
+			if got := tt.t.IsSameYear(tt.u); got != tt.sameYear {
+				t.Errorf("IsSameYear() = %v, want %v", got, tt.sameYear)
+			}
+		})
+	}
+
+	// 6 p.m. and 8 p.m. EST on December 31 straddle midnight UTC.
+	six, eight := Date[EST](2024, time.December, 31, 18, 0, 0, 0), Date[EST](2024, time.December, 31, 20, 0, 0, 0)
+	if !six.IsSameDay(eight) {
+		t.Error("IsSameDay() in EST = false, want true")
+	}
+	if FromMoment[UTC](six).IsSameDay(eight) {
+		t.Error("IsSameDay() in UTC = true, want false")
+	}
+}
+
+func TestNextAnniversary(t *testing.T) {
+	origin := Date[EST](2020, time.June, 15, 9, 0, 0, 0)
+
+	tests := []struct {
+		name  string
+		after Moment
+		want  Time[EST]
+	}{
+		{"later this year", Date[EST](2024, time.March, 1, 0, 0, 0, 0), Date[EST](2024, time.June, 15, 9, 0, 0, 0)},
+		{"earlier this year", Date[EST](2024, time.July, 1, 0, 0, 0, 0), Date[EST](2025, time.June, 15, 9, 0, 0, 0)},
+		{"exactly an anniversary", Date[EST](2024, time.June, 15, 9, 0, 0, 0), Date[EST](2025, time.June, 15, 9, 0, 0, 0)},
+		{"before the origin", Date[EST](2019, time.January, 1, 0, 0, 0, 0), origin},
+		{"after in another zone", Date[UTC](2024, time.June, 15, 12, 59, 0, 0), Date[EST](2024, time.June, 15, 9, 0, 0, 0)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NextAnniversary[EST](origin, tt.after, LeapDayFeb28); !got.Equal(tt.want) {
+				t.Errorf("NextAnniversary() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNextAnniversaryLeapDay(t *testing.T) {
+	origin := Date[UTC](2024, time.February, 29, 0, 0, 0, 0)
+	after := Date[UTC](2024, time.March, 1, 0, 0, 0, 0)
+
+	if got, want := NextAnniversary[UTC](origin, after, LeapDayFeb28), Date[UTC](2025, time.February, 28, 0, 0, 0, 0); !got.Equal(want) {
+		t.Errorf("NextAnniversary(LeapDayFeb28) = %v, want %v", got, want)
+	}
+	if got, want := NextAnniversary[UTC](origin, after, LeapDayMar1), Date[UTC](2025, time.March, 1, 0, 0, 0, 0); !got.Equal(want) {
+		t.Errorf("NextAnniversary(LeapDayMar1) = %v, want %v", got, want)
+	}
+
+	leapYear := Date[UTC](2027, time.December, 31, 0, 0, 0, 0)
+	if got, want := NextAnniversary[UTC](origin, leapYear, LeapDayMar1), Date[UTC](2028, time.February, 29, 0, 0, 0, 0); !got.Equal(want) {
+		t.Errorf("NextAnniversary() in a leap year = %v, want %v", got, want)
+	}
+}
+
+func TestAddCalendar(t *testing.T) {
+	jan31 := Date[EST](2024, time.January, 31, 9, 30, 0, 0)
+
+	tests := []struct {
+		name string
+		t    Time[EST]
+		d    CalendarDuration
+		want Time[EST]
+	}{
+		{"one month clamps", jan31, CalendarDuration{Months: 1}, Date[EST](2024, time.February, 29, 9, 30, 0, 0)},
+		{"one month and a day", jan31, CalendarDuration{Months: 1, Days: 1}, Date[EST](2024, time.March, 1, 9, 30, 0, 0)},
+		{"one year", Date[EST](2024, time.February, 29, 0, 0, 0, 0), CalendarDuration{Years: 1}, Date[EST](2025, time.February, 28, 0, 0, 0, 0)},
+		{"days keep wall clock across DST", Date[EST](2024, time.March, 9, 12, 0, 0, 0), CalendarDuration{Days: 1}, Date[EST](2024, time.March, 10, 12, 0, 0, 0)},
+		{"duration is elapsed time", Date[EST](2024, time.March, 10, 0, 0, 0, 0), CalendarDuration{Duration: 3 * time.Hour}, Date[EST](2024, time.March, 10, 4, 0, 0, 0)},
+		{"negative", jan31, CalendarDuration{Months: -2, Days: -1}, Date[EST](2023, time.November, 29, 9, 30, 0, 0)},
+		{"zero", jan31, CalendarDuration{}, jan31},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.t.AddCalendar(tt.d); !got.Equal(tt.want) {
+				t.Errorf("AddCalendar(%+v) = %v, want %v", tt.d, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDiffCalendar(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b Time[EST]
+		want CalendarDuration
+	}{
+		{"mixed", Date[EST](2022, time.June, 15, 9, 0, 0, 0), Date[EST](2024, time.August, 18, 11, 30, 0, 0), CalendarDuration{Years: 2, Months: 2, Days: 3, Duration: 150 * time.Minute}},
+		{"month not yet complete", Date[EST](2024, time.January, 15, 12, 0, 0, 0), Date[EST](2024, time.February, 15, 11, 0, 0, 0), CalendarDuration{Days: 30, Duration: 23 * time.Hour}},
+		{"clamped month end", Date[EST](2024, time.January, 31, 0, 0, 0, 0), Date[EST](2024, time.February, 29, 0, 0, 0, 0), CalendarDuration{Months: 1}},
+		{"day across DST", Date[EST](2024, time.March, 9, 12, 0, 0, 0), Date[EST](2024, time.March, 10, 12, 0, 0, 0), CalendarDuration{Days: 1}},
+		{"same time", Date[EST](2024, time.March, 9, 12, 0, 0, 0), Date[EST](2024, time.March, 9, 12, 0, 0, 0), CalendarDuration{}},
+		{"reversed", Date[EST](2024, time.March, 10, 12, 0, 0, 0), Date[EST](2024, time.March, 9, 11, 0, 0, 0), CalendarDuration{Days: -1, Duration: -time.Hour}},
+		{"reversed from month end", Date[EST](2024, time.March, 31, 0, 0, 0, 0), Date[EST](2024, time.February, 28, 0, 0, 0, 0), CalendarDuration{Months: -1, Days: -1}},
+		{"reversed years", Date[EST](2024, time.August, 18, 11, 30, 0, 0), Date[EST](2022, time.June, 15, 9, 0, 0, 0), CalendarDuration{Years: -2, Months: -2, Days: -3, Duration: -150 * time.Minute}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := DiffCalendar(tt.a, tt.b)
+			if got != tt.want {
+				t.Errorf("DiffCalendar() = %+v, want %+v", got, tt.want)
+			}
+			if back := tt.a.AddCalendar(got); !back.Equal(tt.b) {
+				t.Errorf("AddCalendar(DiffCalendar()) = %v, want %v", back, tt.b)
+			}
+		})
+	}
+}
+
+func TestBucket(t *testing.T) {
+	tests := []struct {
+		name string
+		t    Time[EST]
+		d    time.Duration
+		want Time[EST]
+	}{
+		{"5 minutes", Date[EST](2024, time.July, 15, 12, 34, 56, 0), 5 * time.Minute, Date[EST](2024, time.July, 15, 12, 30, 0, 0)},
+		{"hourly", Date[EST](2024, time.July, 15, 12, 34, 56, 0), time.Hour, Date[EST](2024, time.July, 15, 12, 0, 0, 0)},
+		{"aligned to local midnight", Date[EST](2024, time.July, 15, 1, 30, 0, 0), 3 * time.Hour, Date[EST](2024, time.July, 15, 0, 0, 0, 0)},
+		{"last bucket is shorter", Date[EST](2024, time.July, 15, 23, 30, 0, 0), 7 * time.Hour, Date[EST](2024, time.July, 15, 21, 0, 0, 0)},
+		{"after spring forward", Date[EST](2024, time.March, 10, 5, 30, 0, 0), 2 * time.Hour, Date[EST](2024, time.March, 10, 5, 0, 0, 0)},
+		{"non-positive", Date[EST](2024, time.July, 15, 12, 34, 56, 0), 0, Date[EST](2024, time.July, 15, 12, 34, 56, 0)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.t.Bucket(tt.d); !got.Equal(tt.want) {
+				t.Errorf("Bucket(%v) = %v, want %v", tt.d, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBucketAlignsToLocalDay(t *testing.T) {
+	// 02:30 in New York is 06:30 UTC; a UTC-aligned 4-hour bucket would start
+	// at 04:00 UTC, which is midnight local time only in winter.
+	tm := Date[EST](2024, time.July, 15, 2, 30, 0, 0)
+	if got, want := tm.Bucket(4*time.Hour), Date[EST](2024, time.July, 15, 0, 0, 0, 0); !got.Equal(want) {
+		t.Errorf("Bucket() = %v, want %v", got, want)
+	}
+}
+
+func TestBucketOfDay(t *testing.T) {
+	tests := []struct {
+		name string
+		t    Time[EST]
+		n    int
+		want int
+	}{
+		{"midnight", Date[EST](2024, time.July, 15, 0, 0, 0, 0), 24, 0},
+		{"hourly", Date[EST](2024, time.July, 15, 13, 59, 59, 0), 24, 13},
+		{"last", Date[EST](2024, time.July, 15, 23, 59, 59, 999999999), 24, 23},
+		{"5 minutes", Date[EST](2024, time.July, 15, 12, 34, 0, 0), 288, 150},
+		{"23 hour day", Date[EST](2024, time.March, 10, 23, 30, 0, 0), 23, 22},
+		{"25 hour day", Date[EST](2024, time.November, 3, 12, 0, 0, 0), 25, 13},
+		{"non-positive", Date[EST](2024, time.July, 15, 12, 0, 0, 0), 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.t.BucketOfDay(tt.n); got != tt.want {
+				t.Errorf("BucketOfDay(%d) = %d, want %d", tt.n, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+/*
+Package civiltime converts between meridian typed times and the civil date
+and time types of cloud.google.com/go/civil, which the Spanner and BigQuery
+clients use for DATE, TIME, and DATETIME columns.
+
+Civil values have no timezone: a civil.DateTime is a wall-clock reading, not
+an instant. The conversions make the zone explicit through the timezone type,
+so a value read from the database is interpreted in a known zone and a typed
+time is written as its wall clock in that zone:
+
+	row := civil.DateTime{Date: civil.Date{Year: 2024, Month: 12, Day: 25}, Time: civil.Time{Hour: 9}}
+	t := civiltime.FromDateTime[et.Timezone](row) // 2024-12-25 09:00 EST
+
+	civiltime.DateOf(pt.FromMoment(t)) // 2024-12-25
+	civiltime.TimeOf(pt.FromMoment(t)) // 06:00:00
+*/
+package civiltime
+
+import (
+	"cloud.google.com/go/civil"
+
+	"github.com/matthalp/go-meridian/v2"
+)
+
+// DateOf returns the date on which t falls in its timezone.
+func DateOf[TZ meridian.Timezone](t meridian.Time[TZ]) civil.Date {
+	return civil.DateOf(t.Time())
+}
+
+// TimeOf returns the wall-clock time of t in its timezone.
+func TimeOf[TZ meridian.Timezone](t meridian.Time[TZ]) civil.Time {
+	return civil.TimeOf(t.Time())
+}
+
+// DateTimeOf returns the date and wall-clock time of t in its timezone.
+func DateTimeOf[TZ meridian.Timezone](t meridian.Time[TZ]) civil.DateTime {
+	return civil.DateTimeOf(t.Time())
+}
+
+// FromDate returns midnight at the start of d in the specified timezone.
+// Where daylight saving time skips midnight, the result is normalized as
+// by meridian.Date.
+func FromDate[TZ meridian.Timezone](d civil.Date) meridian.Time[TZ] {
+	return meridian.Date[TZ](d.Year, d.Month, d.Day, 0, 0, 0, 0)
+}
+
+// FromDateTime returns the instant at which the wall clock of the specified
+// timezone reads dt. Wall-clock times that are skipped or repeated by a
+// daylight saving time transition are resolved as by meridian.Date.
+func FromDateTime[TZ meridian.Timezone](dt civil.DateTime) meridian.Time[TZ] {
+	return At[TZ](dt.Date, dt.Time)
+}
+
+// At returns the instant on date d at wall-clock time tm in the specified
+// timezone. It is FromDateTime for a date and time held separately, such as
+// in a DATE and a TIME column.
+func At[TZ meridian.Timezone](d civil.Date, tm civil.Time) meridian.Time[TZ] {
+	return meridian.Date[TZ](d.Year, d.Month, d.Day, tm.Hour, tm.Minute, tm.Second, tm.Nanosecond)
+}
@@ -0,0 +1,54 @@
+package civiltime
+
+import (
+	"testing"
+	"time"
+
+	"cloud.google.com/go/civil"
+
+	"github.com/matthalp/go-meridian/v2/timezones/et"
+	"github.com/matthalp/go-meridian/v2/timezones/pt"
+)
+
+func TestOf(t *testing.T) {
+	// 01:30 on December 25 in New York is 22:30 on December 24 in Los Angeles.
+	tm := pt.FromMoment(et.Date(2024, time.December, 25, 1, 30, 15, 500))
+
+	if got, want := DateOf(tm), (civil.Date{Year: 2024, Month: time.December, Day: 24}); got != want {
+		t.Errorf("DateOf() = %v, want %v", got, want)
+	}
+	if got, want := TimeOf(tm), (civil.Time{Hour: 22, Minute: 30, Second: 15, Nanosecond: 500}); got != want {
+		t.Errorf("TimeOf() = %v, want %v", got, want)
+	}
+	want := civil.DateTime{
+		Date: civil.Date{Year: 2024, Month: time.December, Day: 24},
+		Time: civil.Time{Hour: 22, Minute: 30, Second: 15, Nanosecond: 500},
+	}
+	if got := DateTimeOf(tm); got != want {
+		t.Errorf("DateTimeOf() = %v, want %v", got, want)
+	}
+}
+
+func TestFromDate(t *testing.T) {
+	got := FromDate[et.Timezone](civil.Date{Year: 2024, Month: time.March, Day: 10})
+	if want := et.Date(2024, time.March, 10, 0, 0, 0, 0); !got.Equal(want) {
+		t.Errorf("FromDate() = %v, want %v", got, want)
+	}
+}
+
+func TestFromDateTime(t *testing.T) {
+	dt := civil.DateTime{
+		Date: civil.Date{Year: 2024, Month: time.July, Day: 4},
+		Time: civil.Time{Hour: 9, Minute: 15, Nanosecond: 7},
+	}
+	got := FromDateTime[et.Timezone](dt)
+	if want := time.Date(2024, time.July, 4, 13, 15, 0, 7, time.UTC); !got.Equal(want) {
+		t.Errorf("FromDateTime() = %v, want %v", got.UTC(), want)
+	}
+	if round := DateTimeOf(got); round != dt {
+		t.Errorf("DateTimeOf(FromDateTime(%v)) = %v", dt, round)
+	}
+	if at := At[et.Timezone](dt.Date, dt.Time); !at.Equal(got) {
+		t.Errorf("At() = %v, want %v", at, got)
+	}
+}
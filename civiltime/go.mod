@@ -0,0 +1,10 @@
+module github.com/matthalp/go-meridian/v2/civiltime
+
+go 1.20
+
+require (
+	cloud.google.com/go v0.112.0
+	github.com/matthalp/go-meridian/v2 v2.0.0
+)
+
+replace github.com/matthalp/go-meridian/v2 => ../
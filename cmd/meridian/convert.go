@@ -0,0 +1,83 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/matthalp/go-meridian/v2/timezones/registry"
+)
+
+// inputLayouts are the layouts convert accepts, tried in order. Values
+// without an offset are read as a clock time in the --from zone.
+var inputLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02 15:04",
+	"2006-01-02",
+}
+
+// displayLayout is the default layout convert prints times in.
+const displayLayout = "Mon 2006-01-02 15:04:05 MST -07:00"
+
+func runConvert(args []string, stdout io.Writer) error {
+	fs := flag.NewFlagSet("convert", flag.ContinueOnError)
+	fs.SetOutput(os.Stderr)
+	from := fs.String("from", "utc", "zone the timestamp is in, unless it has an offset")
+	to := fs.String("to", "", "comma-separated zones to convert to (required)")
+	layout := fs.String("layout", displayLayout, "layout to print times in")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: meridian convert [timestamp] --from zone --to zone[,zone...]")
+		fmt.Fprintln(fs.Output())
+		fmt.Fprintln(fs.Output(), `The timestamp is RFC 3339 or a date and clock time such as "2024-12-25 09:00",
+and defaults to now. The first line printed is the timestamp in the --from zone.`)
+		fmt.Fprintln(fs.Output())
+		fs.PrintDefaults()
+	}
+	positional, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) > 1 || *to == "" {
+		fs.Usage()
+		return errUsage
+	}
+
+	source, err := lookupZone(*from)
+	if err != nil {
+		return err
+	}
+	targets, err := lookupZones(*to)
+	if err != nil {
+		return err
+	}
+
+	var t registry.Time
+	if len(positional) == 0 || positional[0] == "now" {
+		t = source.FromMoment(time.Now())
+	} else if t, err = parseTimestamp(source, positional[0]); err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(stdout, 0, 0, 2, ' ', 0)
+	for _, z := range append([]registry.Zone{source}, targets...) {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", z.Name, z.Location, z.FromMoment(t).Format(*layout))
+	}
+	return w.Flush()
+}
+
+// parseTimestamp parses value in zone using the first of inputLayouts that
+// matches.
+func parseTimestamp(zone registry.Zone, value string) (registry.Time, error) {
+	for _, layout := range inputLayouts {
+		if t, err := zone.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+	return nil, fmt.Errorf(`cannot parse timestamp %q: want RFC 3339 or "2006-01-02 15:04:05"`, value)
+}
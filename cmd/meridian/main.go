@@ -0,0 +1,148 @@
+// Command meridian is a command-line companion to the meridian library for
+// working with the generated timezone packages.
+//
+// Usage:
+//
+//	meridian <command> [arguments]
+//
+// The commands are:
+//
+//	convert      convert a timestamp between zones
+//	transitions  list the offset changes of a zone during a year
+//	plan         find the business hours shared by several zones
+//
+// Zones are named by package name, such as et, or IANA location, such as
+// America/New_York, and must be one of the generated packages listed by the
+// timezones/registry package. Run "meridian <command> -h" for a command's flags.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/matthalp/go-meridian/v2/timezones/registry"
+)
+
+// command is a meridian subcommand.
+type command struct {
+	name    string
+	summary string
+	run     func(args []string, stdout io.Writer) error
+}
+
+// commands lists the subcommands in the order usage shows them.
+var commands = []command{
+	{"convert", "convert a timestamp between zones", runConvert},
+	{"transitions", "list the offset changes of a zone during a year", runTransitions},
+	{"plan", "find the business hours shared by several zones", runPlan},
+}
+
+// errUsage reports a command line that could not be parsed; the usage has
+// already been printed.
+var errUsage = errors.New("usage")
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("meridian: ")
+
+	err := run(os.Args[1:], os.Stdout)
+	switch {
+	case errors.Is(err, flag.ErrHelp):
+	case errors.Is(err, errUsage):
+		os.Exit(2)
+	case err != nil:
+		log.Fatal(err)
+	}
+}
+
+// run runs the subcommand named by args[0] with the remaining arguments.
+func run(args []string, stdout io.Writer) error {
+	if len(args) == 0 || args[0] == "help" || args[0] == "-h" || args[0] == "--help" {
+		usage(os.Stderr)
+		if len(args) == 0 {
+			return errUsage
+		}
+		return nil
+	}
+	for _, cmd := range commands {
+		if cmd.name == args[0] {
+			return cmd.run(args[1:], stdout)
+		}
+	}
+	usage(os.Stderr)
+	return fmt.Errorf("unknown command %q", args[0])
+}
+
+// usage prints the list of commands to w.
+func usage(w io.Writer) {
+	fmt.Fprintln(w, "Usage: meridian <command> [arguments]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Commands:")
+	for _, cmd := range commands {
+		fmt.Fprintf(w, "  %-12s %s\n", cmd.name, cmd.summary)
+	}
+}
+
+// parseFlags parses args with fs and returns the positional arguments. Unlike
+// fs.Parse it accepts flags after positional arguments, as in
+// "meridian convert 2024-12-25T09:00:00 --to pt".
+func parseFlags(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			if errors.Is(err, flag.ErrHelp) {
+				return nil, err
+			}
+			return nil, errUsage
+		}
+		args = fs.Args()
+		if len(args) == 0 {
+			return positional, nil
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+}
+
+// lookupZone returns the registered zone with the given package or location
+// name, or the only zone using it as an abbreviation.
+func lookupZone(name string) (registry.Zone, error) {
+	if z, ok := registry.Lookup(name); ok {
+		return z, nil
+	}
+	if z, ok := registry.Lookup(strings.ToLower(name)); ok {
+		return z, nil
+	}
+	switch matches := registry.LookupAbbrev(strings.ToUpper(name)); len(matches) {
+	case 0:
+		return registry.Zone{}, fmt.Errorf("unknown zone %q", name)
+	case 1:
+		return matches[0], nil
+	default:
+		return registry.Zone{}, fmt.Errorf("ambiguous zone %q: could be %s", name, zoneNames(matches))
+	}
+}
+
+// lookupZones returns the zones named by a comma-separated list.
+func lookupZones(list string) ([]registry.Zone, error) {
+	var zones []registry.Zone
+	for _, name := range strings.Split(list, ",") {
+		if name = strings.TrimSpace(name); name == "" {
+			continue
+		}
+		z, err := lookupZone(name)
+		if err != nil {
+			return nil, err
+		}
+		zones = append(zones, z)
+	}
+	if len(zones) == 0 {
+		return nil, errors.New("no zones given")
+	}
+	return zones, nil
+}
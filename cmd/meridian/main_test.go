@@ -0,0 +1,205 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestConvert(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{
+			name: "clock time in from zone",
+			args: []string{"2024-12-25T09:00:00", "--from", "et", "--to", "pt,utc"},
+			want: "" +
+				"et   America/New_York     Wed 2024-12-25 09:00:00 EST -05:00\n" +
+				"pt   America/Los_Angeles  Wed 2024-12-25 06:00:00 PST -08:00\n" +
+				"utc  UTC                  Wed 2024-12-25 14:00:00 UTC +00:00\n",
+		},
+		{
+			name: "offset overrides from zone",
+			args: []string{"--from", "pt", "--to", "Asia/Kolkata", "2024-07-01T12:00:00Z"},
+			want: "" +
+				"pt   America/Los_Angeles  Mon 2024-07-01 05:00:00 PDT -07:00\n" +
+				"ist  Asia/Kolkata         Mon 2024-07-01 17:30:00 IST +05:30\n",
+		},
+		{
+			name: "layout",
+			args: []string{"2024-03-10 03:30", "--from", "et", "--to", "jst", "--layout", "15:04 MST"},
+			want: "" +
+				"et   America/New_York  03:30 EDT\n" +
+				"jst  Asia/Tokyo        16:30 JST\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			if err := run(append([]string{"convert"}, tt.args...), &out); err != nil {
+				t.Fatalf("convert error = %v", err)
+			}
+			if got := out.String(); got != tt.want {
+				t.Errorf("convert output =\n%s\nwant:\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvertErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{"unknown zone", []string{"2024-12-25", "--to", "mars"}, `unknown zone "mars"`},
+		{"ambiguous abbreviation", []string{"2024-12-25", "--to", "EDT"}, `ambiguous zone "EDT": could be est, et`},
+		{"bad timestamp", []string{"tomorrow", "--to", "pt"}, `cannot parse timestamp "tomorrow"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := run(append([]string{"convert"}, tt.args...), new(bytes.Buffer))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("convert error = %v, want it to contain %q", err, tt.want)
+			}
+		})
+	}
+
+	if err := run([]string{"convert", "2024-12-25"}, new(bytes.Buffer)); !errors.Is(err, errUsage) {
+		t.Errorf("convert without --to error = %v, want errUsage", err)
+	}
+}
+
+func TestTransitions(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{
+			name: "northern hemisphere",
+			args: []string{"et", "2025"},
+			want: "" +
+				"et (America/New_York) transitions in 2025:\n" +
+				"BEFORE                              AFTER                           UTC\n" +
+				"Sun 2025-03-09 02:00:00 EST -05:00  2025-03-09 03:00:00 EDT -04:00  2025-03-09T07:00:00Z\n" +
+				"Sun 2025-11-02 02:00:00 EDT -04:00  2025-11-02 01:00:00 EST -05:00  2025-11-02T06:00:00Z\n",
+		},
+		{
+			name: "southern hemisphere",
+			args: []string{"Australia/Sydney", "2025"},
+			want: "" +
+				"aest (Australia/Sydney) transitions in 2025:\n" +
+				"BEFORE                               AFTER                            UTC\n" +
+				"Sun 2025-04-06 03:00:00 AEDT +11:00  2025-04-06 02:00:00 AEST +10:00  2025-04-05T16:00:00Z\n" +
+				"Sun 2025-10-05 02:00:00 AEST +10:00  2025-10-05 03:00:00 AEDT +11:00  2025-10-04T16:00:00Z\n",
+		},
+		{
+			name: "no daylight saving time",
+			args: []string{"jst", "2025"},
+			want: "jst (Asia/Tokyo) has no transitions in 2025\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			if err := run(append([]string{"transitions"}, tt.args...), &out); err != nil {
+				t.Fatalf("transitions error = %v", err)
+			}
+			if got := out.String(); got != tt.want {
+				t.Errorf("transitions output =\n%s\nwant:\n%s", got, tt.want)
+			}
+		})
+	}
+
+	if err := run([]string{"transitions", "et", "next"}, new(bytes.Buffer)); err == nil {
+		t.Error("transitions with an invalid year error = nil, want an error")
+	}
+}
+
+func TestPlan(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{
+			name: "daylight saving time starts in one zone",
+			args: []string{"--zones", "et,cet", "--from", "2025-03-06", "--days", "6"},
+			want: "" +
+				"ET                              CET                             LENGTH\n" +
+				"Thu 2025-03-06 09:00-11:00 EST  Thu 2025-03-06 15:00-17:00 CET  2h\n" +
+				"Fri 2025-03-07 09:00-11:00 EST  Fri 2025-03-07 15:00-17:00 CET  2h\n" +
+				"Mon 2025-03-10 09:00-12:00 EDT  Mon 2025-03-10 14:00-17:00 CET  3h\n" +
+				"Tue 2025-03-11 09:00-12:00 EDT  Tue 2025-03-11 14:00-17:00 CET  3h\n",
+		},
+		{
+			name: "different local days",
+			args: []string{"--zones", "pt,jst", "--hours", "7-19", "--from", "2025-01-09", "--days", "2"},
+			want: "" +
+				"PT                              JST                             LENGTH\n" +
+				"Thu 2025-01-09 14:00-19:00 PST  Fri 2025-01-10 07:00-12:00 JST  5h\n",
+		},
+		{
+			name: "half hours",
+			args: []string{"--zones", "ist,aest", "--hours", "8:30-17:30", "--from", "2025-01-06", "--days", "1"},
+			want: "" +
+				"IST                             AEST                             LENGTH\n" +
+				"Mon 2025-01-06 08:30-12:00 IST  Mon 2025-01-06 14:00-17:30 AEDT  3h30m\n",
+		},
+		{
+			name: "no overlap",
+			args: []string{"--zones", "et,cet,ist", "--from", "2025-01-06"},
+			want: "no overlapping business hours (9-17) between et, cet, ist\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			if err := run(append([]string{"plan"}, tt.args...), &out); err != nil {
+				t.Fatalf("plan error = %v", err)
+			}
+			if got := out.String(); got != tt.want {
+				t.Errorf("plan output =\n%s\nwant:\n%s", got, tt.want)
+			}
+		})
+	}
+
+	for _, hours := range []string{"17-9", "9", "9-25", "9:60-17", "9-24:30"} {
+		if err := run([]string{"plan", "--zones", "et,pt", "--hours", hours}, new(bytes.Buffer)); err == nil {
+			t.Errorf("plan --hours %s error = nil, want an error", hours)
+		}
+	}
+}
+
+func TestParseClock(t *testing.T) {
+	tests := []struct {
+		value string
+		want  time.Duration
+	}{
+		{"0", 0},
+		{"9", 9 * time.Hour},
+		{"8:30", 8*time.Hour + 30*time.Minute},
+		{"24", 24 * time.Hour},
+		{"24:00", 24 * time.Hour},
+	}
+	for _, tt := range tests {
+		if got, err := parseClock(tt.value); err != nil || got != tt.want {
+			t.Errorf("parseClock(%q) = %v, %v, want %v", tt.value, got, err, tt.want)
+		}
+	}
+
+	for _, value := range []string{"", "-1", "25", "9:60", "24:30", "24:01"} {
+		if got, err := parseClock(value); err == nil {
+			t.Errorf("parseClock(%q) = %v, want an error", value, got)
+		}
+	}
+}
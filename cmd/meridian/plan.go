@@ -0,0 +1,159 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/matthalp/go-meridian/v2"
+	"github.com/matthalp/go-meridian/v2/timezones/registry"
+	"github.com/matthalp/go-meridian/v2/timezones/utc"
+)
+
+func runPlan(args []string, stdout io.Writer) error {
+	fs := flag.NewFlagSet("plan", flag.ContinueOnError)
+	fs.SetOutput(os.Stderr)
+	zoneList := fs.String("zones", "", "comma-separated zones to plan across (required)")
+	hours := fs.String("hours", "9-17", "local business hours in every zone, such as 9-17 or 8:30-16:30")
+	days := fs.Int("days", 7, "number of days to plan")
+	from := fs.String("from", "", "first day to plan, as 2006-01-02 in the first zone (default today)")
+	weekends := fs.Bool("weekends", false, "include Saturdays and Sundays")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: meridian plan --zones zone,zone[,zone...] [--hours 9-17] [--days 7]")
+		fmt.Fprintln(fs.Output())
+		fmt.Fprintln(fs.Output(), `Plan prints the windows in which the business hours of every zone overlap,
+as local times in each zone.`)
+		fmt.Fprintln(fs.Output())
+		fs.PrintDefaults()
+	}
+	positional, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) > 0 || *zoneList == "" || *days < 1 {
+		fs.Usage()
+		return errUsage
+	}
+
+	zones, err := lookupZones(*zoneList)
+	if err != nil {
+		return err
+	}
+	open, closing, err := parseHours(*hours)
+	if err != nil {
+		return err
+	}
+
+	first := zoneLocation(zones[0])
+	start := time.Now().In(first)
+	if *from != "" {
+		if start, err = time.ParseInLocation("2006-01-02", *from, first); err != nil {
+			return fmt.Errorf("invalid --from date %q: want 2006-01-02", *from)
+		}
+	}
+	start = time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, first)
+	end := start.AddDate(0, 0, *days)
+
+	var weekend []time.Weekday
+	if *weekends {
+		weekend = []time.Weekday{}
+	}
+	calendars := make([]meridian.WorkingHours, len(zones))
+	for i, z := range zones {
+		calendars[i] = z.Calendar(open, closing, weekend)
+	}
+
+	// The search extends a day either side of the range, so that windows
+	// starting within it are not cut short, and only those windows are kept.
+	search := meridian.Interval[utc.Timezone]{
+		Start: utc.FromMoment(start.AddDate(0, 0, -1)),
+		End:   utc.FromMoment(end.AddDate(0, 0, 1)),
+	}
+	var planned []meridian.Interval[utc.Timezone]
+	for _, w := range meridian.CommonWorkingHours(search, calendars...) {
+		if !w.Start.Before(start) && w.Start.Before(end) {
+			planned = append(planned, w)
+		}
+	}
+
+	if len(planned) == 0 {
+		fmt.Fprintf(stdout, "no overlapping business hours (%s) between %s\n", *hours, zoneNames(zones))
+		return nil
+	}
+	w := tabwriter.NewWriter(stdout, 0, 0, 2, ' ', 0)
+	for _, z := range zones {
+		fmt.Fprintf(w, "%s\t", strings.ToUpper(z.Name))
+	}
+	fmt.Fprintln(w, "LENGTH")
+	for _, p := range planned {
+		for _, z := range zones {
+			loc := zoneLocation(z)
+			fmt.Fprintf(w, "%s-%s\t", p.Start.Time().In(loc).Format("Mon 2006-01-02 15:04"), p.End.Time().In(loc).Format("15:04 MST"))
+		}
+		fmt.Fprintln(w, formatLength(p.Duration()))
+	}
+	return w.Flush()
+}
+
+// parseHours parses business hours such as "9-17" or "8:30-16:30" into
+// durations since midnight.
+func parseHours(s string) (open, closing time.Duration, err error) {
+	from, to, ok := strings.Cut(s, "-")
+	if ok {
+		open, err = parseClock(from)
+	}
+	if ok && err == nil {
+		closing, err = parseClock(to)
+	}
+	if !ok || err != nil || open >= closing {
+		return 0, 0, fmt.Errorf("invalid --hours %q: want a range within one day, such as 9-17", s)
+	}
+	return open, closing, nil
+}
+
+// parseClock parses an hour, such as "9", or a clock time, such as "9:30",
+// into a duration since midnight. The end of the day is "24" or "24:00".
+func parseClock(s string) (time.Duration, error) {
+	hour, minute, hasMinute := strings.Cut(strings.TrimSpace(s), ":")
+	h, err := strconv.Atoi(hour)
+	if err != nil || h < 0 || h > 24 {
+		return 0, errors.New("invalid hour")
+	}
+	m := 0
+	if hasMinute {
+		if m, err = strconv.Atoi(minute); err != nil || m < 0 || m > 59 || (h == 24 && m != 0) {
+			return 0, errors.New("invalid minute")
+		}
+	}
+	return time.Duration(h)*time.Hour + time.Duration(m)*time.Minute, nil
+}
+
+// formatLength formats d, a whole number of minutes, as "3h", "45m", or
+// "2h30m".
+func formatLength(d time.Duration) string {
+	s := strings.TrimSuffix(d.Truncate(time.Minute).String(), "0s")
+	if strings.HasSuffix(s, "h0m") {
+		s = strings.TrimSuffix(s, "0m")
+	}
+	return s
+}
+
+// zoneLocation returns the location of z.
+func zoneLocation(z registry.Zone) *time.Location {
+	return z.FromMoment(time.Time{}).Location()
+}
+
+// zoneNames returns the names of zones as a comma-separated list.
+func zoneNames(zones []registry.Zone) string {
+	names := make([]string, len(zones))
+	for i, z := range zones {
+		names[i] = z.Name
+	}
+	return strings.Join(names, ", ")
+}
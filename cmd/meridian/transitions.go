@@ -0,0 +1,85 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+)
+
+// transition is a change of a location's offset or abbreviation.
+type transition struct {
+	at     time.Time // the instant of the change, in UTC
+	before time.Time // at, read in the zone in effect just before it
+	after  time.Time // at, read in the zone in effect from it on
+}
+
+func runTransitions(args []string, stdout io.Writer) error {
+	fs := flag.NewFlagSet("transitions", flag.ContinueOnError)
+	fs.SetOutput(os.Stderr)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: meridian transitions zone [year]")
+		fmt.Fprintln(fs.Output())
+		fmt.Fprintln(fs.Output(), "Transitions lists the offset changes of zone during year, by default the current one.")
+	}
+	positional, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) == 0 || len(positional) > 2 {
+		fs.Usage()
+		return errUsage
+	}
+
+	zone, err := lookupZone(positional[0])
+	if err != nil {
+		return err
+	}
+	loc := zoneLocation(zone)
+	year := time.Now().In(loc).Year()
+	if len(positional) == 2 {
+		if year, err = strconv.Atoi(positional[1]); err != nil {
+			return fmt.Errorf("invalid year %q", positional[1])
+		}
+	}
+
+	transitions := yearTransitions(loc, year)
+	if len(transitions) == 0 {
+		fmt.Fprintf(stdout, "%s (%s) has no transitions in %d\n", zone.Name, zone.Location, year)
+		return nil
+	}
+
+	fmt.Fprintf(stdout, "%s (%s) transitions in %d:\n", zone.Name, zone.Location, year)
+	w := tabwriter.NewWriter(stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "BEFORE\tAFTER\tUTC")
+	for _, tr := range transitions {
+		fmt.Fprintf(w, "%s\t%s\t%s\n",
+			tr.before.Format("Mon 2006-01-02 15:04:05 MST -07:00"),
+			tr.after.Format("2006-01-02 15:04:05 MST -07:00"),
+			tr.at.Format(time.RFC3339))
+	}
+	return w.Flush()
+}
+
+// yearTransitions returns the transitions of loc that take effect during
+// year, as read in loc.
+func yearTransitions(loc *time.Location, year int) []transition {
+	var transitions []transition
+	t := time.Date(year, time.January, 1, 0, 0, 0, 0, loc)
+	for {
+		_, end := t.ZoneBounds()
+		if end.IsZero() || end.Year() > year {
+			return transitions
+		}
+		name, offset := end.Add(-time.Nanosecond).Zone()
+		transitions = append(transitions, transition{
+			at:     end.UTC(),
+			before: end.In(time.FixedZone(name, offset)),
+			after:  end,
+		})
+		t = end
+	}
+}
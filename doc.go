@@ -140,3 +140,5 @@ Explicit timezone conversions make timezone handling visible in code review,
 reducing bugs in production.
 */
 package meridian
+
+//go:generate go run ./cmd/generate-timezones
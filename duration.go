@@ -0,0 +1,79 @@
+package meridian
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// ParseDuration parses a duration string as time.ParseDuration does, and
+// additionally accepts the units "d" for days and "w" for weeks, as in "2d"
+// or "1w3d12h". Configuration files commonly use these units for retention
+// periods and timeouts.
+//
+// Days and weeks are fixed spans of 24 and 168 hours, with no knowledge of
+// any calendar: adding "1d" to a time on the day a daylight saving time
+// transition occurs moves the wall clock by 23 or 25 hours. To move by
+// calendar days in a timezone, use Time.AddCalendar with a CalendarDuration.
+func ParseDuration(s string) (time.Duration, error) {
+	orig := s
+	neg := false
+	if s != "" && (s[0] == '-' || s[0] == '+') {
+		neg = s[0] == '-'
+		s = s[1:]
+	}
+	if s == "0" {
+		return 0, nil
+	}
+	if s == "" {
+		return 0, fmt.Errorf("invalid duration %q", orig)
+	}
+
+	var total time.Duration
+	for s != "" {
+		i := 0
+		for i < len(s) && (s[i] == '.' || '0' <= s[i] && s[i] <= '9') {
+			i++
+		}
+		j := i
+		for j < len(s) && s[j] != '.' && (s[j] < '0' || s[j] > '9') {
+			j++
+		}
+		number, unit := s[:i], s[i:j]
+		s = s[j:]
+		if number == "" {
+			return 0, fmt.Errorf("invalid duration %q", orig)
+		}
+		if unit == "" {
+			return 0, fmt.Errorf("invalid duration %q: missing unit", orig)
+		}
+
+		var v time.Duration
+		var err error
+		switch unit {
+		case "d", "w":
+			v, err = time.ParseDuration(number + "h")
+			scale := time.Duration(24)
+			if unit == "w" {
+				scale = 24 * 7
+			}
+			if err == nil && v > math.MaxInt64/scale {
+				return 0, fmt.Errorf("invalid duration %q: overflows time.Duration", orig)
+			}
+			v *= scale
+		default:
+			v, err = time.ParseDuration(number + unit)
+		}
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q: %w", orig, err)
+		}
+		if total > math.MaxInt64-v {
+			return 0, fmt.Errorf("invalid duration %q: overflows time.Duration", orig)
+		}
+		total += v
+	}
+	if neg {
+		return -total, nil
+	}
+	return total, nil
+}
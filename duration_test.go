@@ -0,0 +1,42 @@
+package meridian
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseDuration(t *testing.T) {
+	tests := []struct {
+		in   string
+		want time.Duration
+	}{
+		{"0", 0},
+		{"2d", 48 * time.Hour},
+		{"1w", 7 * 24 * time.Hour},
+		{"1w3d12h", (7+3)*24*time.Hour + 12*time.Hour},
+		{"1.5d", 36 * time.Hour},
+		{"-2d4h", -52 * time.Hour},
+		{"+1d", 24 * time.Hour},
+		{"1h30m", 90 * time.Minute},
+		{"1d250ms", 24*time.Hour + 250*time.Millisecond},
+		{"3µs", 3 * time.Microsecond},
+	}
+	for _, tt := range tests {
+		got, err := ParseDuration(tt.in)
+		if err != nil {
+			t.Errorf("ParseDuration(%q) error = %v", tt.in, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseDuration(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestParseDurationInvalid(t *testing.T) {
+	for _, in := range []string{"", "-", "d", "2", "1d2", "2x", "1y", "1..5d", "100000w", "106751d23h47m16s854775808ns"} {
+		if got, err := ParseDuration(in); err == nil {
+			t.Errorf("ParseDuration(%q) = %v, want error", in, got)
+		}
+	}
+}
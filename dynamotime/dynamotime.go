@@ -0,0 +1,144 @@
+/*
+Package dynamotime stores meridian typed times in Amazon DynamoDB through
+the AWS SDK for Go v2.
+
+Its types implement the Marshaler and Unmarshaler interfaces of
+github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue, so models can
+hold them without a custom converter on every struct:
+
+	type Order struct {
+		ID        string                        `dynamodbav:"id"`
+		PlacedAt  dynamotime.Time[utc.Timezone] `dynamodbav:"placed_at"`
+		ShipsAt   dynamotime.Time[et.Timezone]  `dynamodbav:"ships_at"`
+		ExpiresAt dynamotime.Unix[utc.Timezone] `dynamodbav:"expires_at"`
+	}
+
+	item, err := attributevalue.MarshalMap(order)
+
+Choose the representation with the type. Time stores an RFC 3339 string,
+which reads well in the console and sorts chronologically as a sort key.
+Unix stores epoch seconds as a number, the format DynamoDB requires for a
+Time to Live attribute. Both read either representation, so an attribute
+can move from one to the other without rewriting existing items.
+
+Values are stored as UTC and read back into the field's timezone type. The
+zero time is stored as NULL, and NULL reads back as the zero time.
+*/
+package dynamotime
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+
+	"github.com/matthalp/go-meridian/v2"
+)
+
+// marshaler and unmarshaler are the attributevalue.Marshaler and
+// attributevalue.Unmarshaler interfaces.
+type (
+	marshaler interface {
+		MarshalDynamoDBAttributeValue() (types.AttributeValue, error)
+	}
+	unmarshaler interface {
+		UnmarshalDynamoDBAttributeValue(types.AttributeValue) error
+	}
+)
+
+// Compile-time interface assertions.
+var (
+	_ marshaler   = Time[meridian.Timezone]{}
+	_ unmarshaler = (*Time[meridian.Timezone])(nil)
+	_ marshaler   = Unix[meridian.Timezone]{}
+	_ unmarshaler = (*Unix[meridian.Timezone])(nil)
+)
+
+// Layout is the layout of the strings Time stores: RFC 3339 in UTC with
+// exactly nine fractional digits, so that strings sort in the same order as
+// the instants they represent, as sort key conditions require.
+const Layout = "2006-01-02T15:04:05.000000000Z07:00"
+
+// Time is a meridian.Time[TZ] stored as an RFC 3339 string in Layout. The
+// embedded Time exposes the full meridian.Time[TZ] API.
+type Time[TZ meridian.Timezone] struct {
+	meridian.Time[TZ]
+}
+
+// MarshalDynamoDBAttributeValue returns the time as a string attribute, or
+// NULL for the zero time. It implements attributevalue.Marshaler.
+func (t Time[TZ]) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	if t.IsZero() {
+		return &types.AttributeValueMemberNULL{Value: true}, nil
+	}
+	return &types.AttributeValueMemberS{Value: t.UTC().Format(Layout)}, nil
+}
+
+// UnmarshalDynamoDBAttributeValue reads an RFC 3339 string, epoch seconds,
+// or NULL. It implements attributevalue.Unmarshaler.
+func (t *Time[TZ]) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	return unmarshal(&t.Time, av)
+}
+
+// Unix is a meridian.Time[TZ] stored as a number of seconds since the Unix
+// epoch. Fractions of a second are discarded when storing. The embedded Time
+// exposes the full meridian.Time[TZ] API.
+type Unix[TZ meridian.Timezone] struct {
+	meridian.Time[TZ]
+}
+
+// MarshalDynamoDBAttributeValue returns the time as a number attribute, or
+// NULL for the zero time. It implements attributevalue.Marshaler.
+func (t Unix[TZ]) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	if t.IsZero() {
+		return &types.AttributeValueMemberNULL{Value: true}, nil
+	}
+	return &types.AttributeValueMemberN{Value: strconv.FormatInt(t.Unix(), 10)}, nil
+}
+
+// UnmarshalDynamoDBAttributeValue reads epoch seconds, an RFC 3339 string,
+// or NULL. It implements attributevalue.Unmarshaler.
+func (t *Unix[TZ]) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	return unmarshal(&t.Time, av)
+}
+
+// unmarshal stores the time held by av in t.
+func unmarshal[TZ meridian.Timezone](t *meridian.Time[TZ], av types.AttributeValue) error {
+	switch v := av.(type) {
+	case *types.AttributeValueMemberNULL:
+		*t = meridian.Time[TZ]{}
+		return nil
+	case *types.AttributeValueMemberS:
+		parsed, err := time.Parse(time.RFC3339Nano, v.Value)
+		if err != nil {
+			return fmt.Errorf("cannot unmarshal %q into meridian.Time: %w", v.Value, err)
+		}
+		*t = meridian.FromMoment[TZ](parsed)
+		return nil
+	case *types.AttributeValueMemberN:
+		parsed, err := parseEpoch(v.Value)
+		if err != nil {
+			return err
+		}
+		*t = meridian.FromMoment[TZ](parsed)
+		return nil
+	default:
+		return fmt.Errorf("cannot unmarshal DynamoDB attribute of type %T into meridian.Time", av)
+	}
+}
+
+// parseEpoch parses a number of seconds since the Unix epoch, which may
+// have a fraction.
+func parseEpoch(s string) (time.Time, error) {
+	if sec, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return time.Unix(sec, 0), nil
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil || math.IsNaN(f) || math.IsInf(f, 0) || math.Abs(f) > math.MaxInt64/1e9 {
+		return time.Time{}, fmt.Errorf("cannot unmarshal %q into meridian.Time: not a Unix time", s)
+	}
+	sec, frac := math.Modf(f)
+	return time.Unix(int64(sec), int64(math.Round(frac*1e9))), nil
+}
@@ -0,0 +1,113 @@
+package dynamotime
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+
+	"github.com/matthalp/go-meridian/v2/timezones/et"
+	"github.com/matthalp/go-meridian/v2/timezones/utc"
+)
+
+func TestTime(t *testing.T) {
+	want := Time[et.Timezone]{et.Date(2024, time.March, 10, 9, 30, 0, 500)}
+	av, err := want.MarshalDynamoDBAttributeValue()
+	if err != nil {
+		t.Fatalf("MarshalDynamoDBAttributeValue() error = %v", err)
+	}
+	s, ok := av.(*types.AttributeValueMemberS)
+	if !ok || s.Value != "2024-03-10T13:30:00.000000500Z" {
+		t.Fatalf("MarshalDynamoDBAttributeValue() = %#v, want S 2024-03-10T13:30:00.000000500Z", av)
+	}
+
+	var got Time[et.Timezone]
+	if err := got.UnmarshalDynamoDBAttributeValue(av); err != nil {
+		t.Fatalf("UnmarshalDynamoDBAttributeValue() error = %v", err)
+	}
+	if !got.Equal(want.Time) || got.Hour() != 9 {
+		t.Errorf("round trip = %v, want %v", got.Time, want.Time)
+	}
+}
+
+func TestTimeSortsChronologically(t *testing.T) {
+	base := utc.Date(2024, time.March, 10, 12, 0, 0, 0)
+	instants := []utc.Time{base.Add(500 * time.Millisecond), base, base.Add(time.Nanosecond), base.Add(-time.Hour)}
+	var values []string
+	for _, instant := range instants {
+		av, err := Time[utc.Timezone]{instant}.MarshalDynamoDBAttributeValue()
+		if err != nil {
+			t.Fatal(err)
+		}
+		values = append(values, av.(*types.AttributeValueMemberS).Value)
+	}
+	sort.Strings(values)
+	for i := 1; i < len(values); i++ {
+		a, _ := time.Parse(time.RFC3339Nano, values[i-1])
+		b, _ := time.Parse(time.RFC3339Nano, values[i])
+		if !a.Before(b) {
+			t.Errorf("sorted strings %q and %q are not in chronological order", values[i-1], values[i])
+		}
+	}
+}
+
+func TestUnix(t *testing.T) {
+	want := Unix[utc.Timezone]{utc.Unix(1700000000, 999)}
+	av, err := want.MarshalDynamoDBAttributeValue()
+	if err != nil {
+		t.Fatalf("MarshalDynamoDBAttributeValue() error = %v", err)
+	}
+	if n, ok := av.(*types.AttributeValueMemberN); !ok || n.Value != "1700000000" {
+		t.Fatalf("MarshalDynamoDBAttributeValue() = %#v, want N 1700000000", av)
+	}
+
+	var got Unix[utc.Timezone]
+	if err := got.UnmarshalDynamoDBAttributeValue(av); err != nil || got.Unix() != 1700000000 {
+		t.Errorf("round trip = %v, %v, want 1700000000", got.Time, err)
+	}
+}
+
+func TestUnmarshalEitherRepresentation(t *testing.T) {
+	want := time.Date(2023, time.November, 14, 22, 13, 20, 500000000, time.UTC)
+	for _, av := range []types.AttributeValue{
+		&types.AttributeValueMemberS{Value: "2023-11-14T17:13:20.5-05:00"},
+		&types.AttributeValueMemberN{Value: "1700000000.5"},
+	} {
+		var asTime Time[et.Timezone]
+		if err := asTime.UnmarshalDynamoDBAttributeValue(av); err != nil || !asTime.Equal(want) {
+			t.Errorf("Time.UnmarshalDynamoDBAttributeValue(%#v) = %v, %v, want %v", av, asTime.Time, err, want)
+		}
+		var asUnix Unix[et.Timezone]
+		if err := asUnix.UnmarshalDynamoDBAttributeValue(av); err != nil || !asUnix.Equal(want) {
+			t.Errorf("Unix.UnmarshalDynamoDBAttributeValue(%#v) = %v, %v, want %v", av, asUnix.Time, err, want)
+		}
+	}
+}
+
+func TestNull(t *testing.T) {
+	for _, m := range []marshaler{Time[utc.Timezone]{}, Unix[utc.Timezone]{}} {
+		av, err := m.MarshalDynamoDBAttributeValue()
+		if null, ok := av.(*types.AttributeValueMemberNULL); err != nil || !ok || !null.Value {
+			t.Errorf("%T.MarshalDynamoDBAttributeValue() = %#v, %v, want NULL", m, av, err)
+		}
+	}
+
+	got := Time[utc.Timezone]{utc.Now()}
+	if err := got.UnmarshalDynamoDBAttributeValue(&types.AttributeValueMemberNULL{Value: true}); err != nil || !got.IsZero() {
+		t.Errorf("UnmarshalDynamoDBAttributeValue(NULL) = %v, %v, want zero time", got.Time, err)
+	}
+}
+
+func TestUnmarshalErrors(t *testing.T) {
+	for _, av := range []types.AttributeValue{
+		&types.AttributeValueMemberS{Value: "yesterday"},
+		&types.AttributeValueMemberN{Value: "1e300"},
+		&types.AttributeValueMemberBOOL{Value: true},
+	} {
+		var got Time[utc.Timezone]
+		if err := got.UnmarshalDynamoDBAttributeValue(av); err == nil {
+			t.Errorf("UnmarshalDynamoDBAttributeValue(%#v) succeeded, want error", av)
+		}
+	}
+}
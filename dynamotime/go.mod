@@ -0,0 +1,12 @@
+module github.com/matthalp/go-meridian/v2/dynamotime
+
+go 1.21
+
+require (
+	github.com/aws/aws-sdk-go-v2/service/dynamodb v1.37.1
+	github.com/matthalp/go-meridian/v2 v2.0.0
+)
+
+require github.com/aws/smithy-go v1.22.1 // indirect
+
+replace github.com/matthalp/go-meridian/v2 => ../
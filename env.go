@@ -0,0 +1,56 @@
+package meridian
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+)
+
+// ErrEnvNotSet is returned by ParseEnv when the environment variable is unset or empty.
+// Callers can test for it with errors.Is to fall back to a default value.
+var ErrEnvNotSet = errors.New("environment variable not set")
+
+// envLayouts are the layouts tried by ParseEnv and Decode when none are given.
+// Values without an offset are interpreted in the timezone's location, so a
+// cutoff configured as "2024-12-31" means midnight in TZ.
+var envLayouts = []string{time.RFC3339, time.DateTime, time.DateOnly}
+
+// ParseEnv parses the environment variable named by key as a time in the
+// specified timezone. Each layout is tried in turn and the first that succeeds
+// wins; if no layouts are given, RFC 3339, time.DateTime, and time.DateOnly are
+// tried. Values without an explicit offset are interpreted in the timezone's
+// location. If the variable is unset or empty, the returned error wraps ErrEnvNotSet.
+func ParseEnv[TZ Timezone](key string, layouts ...string) (Time[TZ], error) {
+	value, ok := os.LookupEnv(key)
+	if !ok || value == "" {
+		return Time[TZ]{}, fmt.Errorf("%w: %s", ErrEnvNotSet, key)
+	}
+	if len(layouts) == 0 {
+		layouts = envLayouts
+	}
+	t, err := parseLayouts[TZ](value, layouts)
+	if err != nil {
+		return Time[TZ]{}, fmt.Errorf("environment variable %s: %w", key, err)
+	}
+	return t, nil
+}
+
+// Decode parses value using the same default layouts as ParseEnv and stores the
+// result in t. It implements the Decoder interface used by struct-tag based
+// configuration libraries such as github.com/kelseyhightower/envconfig, so
+// Time[TZ] fields can be populated directly from the environment:
+//
+//	type Config struct {
+//		Cutoff utc.Time `envconfig:"CUTOFF"`
+//	}
+//
+// Libraries that rely on encoding.TextUnmarshaler instead accept only RFC 3339.
+func (t *Time[TZ]) Decode(value string) error {
+	parsed, err := parseLayouts[TZ](value, envLayouts)
+	if err != nil {
+		return err
+	}
+	*t = parsed
+	return nil
+}
@@ -0,0 +1,99 @@
+package meridian
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseEnv(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		layouts  []string
+		expected time.Time
+	}{
+		{
+			name:     "RFC3339 with offset",
+			value:    "2024-06-15T14:30:00Z",
+			expected: time.Date(2024, time.June, 15, 14, 30, 0, 0, time.UTC),
+		},
+		{
+			name:     "date time in timezone",
+			value:    "2024-01-15 09:00:00",
+			expected: time.Date(2024, time.January, 15, 14, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "date only is midnight in timezone",
+			value:    "2024-12-31",
+			expected: time.Date(2024, time.December, 31, 5, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "custom layout",
+			value:    "12/31/2024",
+			layouts:  []string{"01/02/2006"},
+			expected: time.Date(2024, time.December, 31, 5, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("MERIDIAN_TEST_CUTOFF", tt.value)
+
+			got, err := ParseEnv[EST]("MERIDIAN_TEST_CUTOFF", tt.layouts...)
+			if err != nil {
+				t.Fatalf("ParseEnv() error = %v", err)
+			}
+			if !got.Equal(tt.expected) {
+				t.Errorf("ParseEnv() = %v, want %v", got.UTC(), tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseEnvNotSet(t *testing.T) {
+	_, err := ParseEnv[UTC]("MERIDIAN_TEST_UNSET_VARIABLE")
+	if !errors.Is(err, ErrEnvNotSet) {
+		t.Errorf("ParseEnv() error = %v, want ErrEnvNotSet", err)
+	}
+
+	t.Setenv("MERIDIAN_TEST_EMPTY", "")
+	_, err = ParseEnv[UTC]("MERIDIAN_TEST_EMPTY")
+	if !errors.Is(err, ErrEnvNotSet) {
+		t.Errorf("ParseEnv() with empty value error = %v, want ErrEnvNotSet", err)
+	}
+}
+
+func TestParseEnvInvalid(t *testing.T) {
+	t.Setenv("MERIDIAN_TEST_INVALID", "tomorrow")
+
+	_, err := ParseEnv[UTC]("MERIDIAN_TEST_INVALID")
+	if err == nil {
+		t.Fatal("ParseEnv() expected error for invalid value, got nil")
+	}
+	if errors.Is(err, ErrEnvNotSet) {
+		t.Errorf("ParseEnv() error = %v, should not wrap ErrEnvNotSet", err)
+	}
+	if !contains(err.Error(), "MERIDIAN_TEST_INVALID") {
+		t.Errorf("ParseEnv() error = %q, expected it to name the variable", err)
+	}
+}
+
+func TestDecode(t *testing.T) {
+	var cutoff Time[PST]
+	if err := cutoff.Decode("2024-07-04"); err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+
+	expected := time.Date(2024, time.July, 4, 7, 0, 0, 0, time.UTC)
+	if !cutoff.Equal(expected) {
+		t.Errorf("Decode() = %v, want %v", cutoff.UTC(), expected)
+	}
+
+	if err := cutoff.Decode("not a time"); err == nil {
+		t.Error("Decode() expected error for invalid value, got nil")
+	}
+	if !cutoff.Equal(expected) {
+		t.Errorf("Decode() modified value on error: %v", cutoff.UTC())
+	}
+}
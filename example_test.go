@@ -1,6 +1,7 @@
 package meridian_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"time"
 
@@ -231,3 +232,23 @@ func Example_databaseStorage() {
 	// Store in DB: 2024-12-25T14:00:00Z
 	// Retrieved: 2024-12-25T14:00:00Z
 }
+
+// ExampleUnixMillis demonstrates exchanging epoch milliseconds with JSON clients.
+func ExampleUnixMillis() {
+	type Event struct {
+		Name      string                           `json:"name"`
+		CreatedAt meridian.UnixMillis[et.Timezone] `json:"created_at"`
+	}
+
+	var event Event
+	_ = json.Unmarshal([]byte(`{"name":"launch","created_at":1735135200000}`), &event)
+
+	// The embedded Time exposes the full typed API
+	fmt.Println(event.CreatedAt.Format("2006-01-02 15:04 MST"))
+
+	data, _ := json.Marshal(event)
+	fmt.Println(string(data))
+	// Output:
+	// 2024-12-25 09:00 EST
+	// {"name":"launch","created_at":1735135200000}
+}
@@ -0,0 +1,99 @@
+package meridian
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Compile-time interface assertions.
+var (
+	_ flag.Value  = (*Flag[Timezone])(nil)
+	_ flag.Getter = (*Flag[Timezone])(nil)
+)
+
+// Flag adapts a *Time[TZ] to the flag.Value interface so command-line tools can
+// declare flags that parse directly into typed times:
+//
+//	var since utc.Time
+//	flag.Var(meridian.NewFlag(&since, time.RFC3339, time.DateOnly), "since", "start of the report window")
+//
+// Values are parsed in the timezone's location with each layout in turn, and
+// the first layout that succeeds wins. Flag also implements the Type method
+// required by github.com/spf13/pflag, so the same value can be passed to
+// pflag.Var without an adapter.
+type Flag[TZ Timezone] struct {
+	target  *Time[TZ]
+	layouts []string
+}
+
+// NewFlag returns a Flag that stores parsed values in target. If no layouts are
+// given, time.RFC3339 is used. The current value of target is reported as the
+// flag's default.
+func NewFlag[TZ Timezone](target *Time[TZ], layouts ...string) *Flag[TZ] {
+	if len(layouts) == 0 {
+		layouts = []string{time.RFC3339}
+	}
+	return &Flag[TZ]{target: target, layouts: layouts}
+}
+
+// String returns the current value formatted with the first layout, or the empty
+// string if the value is the zero time. It implements the flag.Value interface.
+func (f *Flag[TZ]) String() string {
+	// The flag package calls String on a zero Flag to detect default values.
+	if f == nil || f.target == nil || f.target.IsZero() {
+		return ""
+	}
+	return f.target.Format(f.layouts[0])
+}
+
+// Set parses s using the configured layouts and stores the result.
+// It implements the flag.Value interface.
+func (f *Flag[TZ]) Set(s string) error {
+	t, err := parseLayouts[TZ](s, f.layouts)
+	if err != nil {
+		return err
+	}
+	*f.target = t
+	return nil
+}
+
+// Get returns the current value as a Time[TZ]. It implements the flag.Getter interface.
+func (f *Flag[TZ]) Get() any {
+	return *f.target
+}
+
+// Type returns the name of the flag's value type for use in help output.
+// It is required by the pflag.Value interface.
+func (f *Flag[TZ]) Type() string {
+	return "time"
+}
+
+// parseLayouts parses value in the timezone's location with each layout in turn,
+// returning the first successful result.
+func parseLayouts[TZ Timezone](value string, layouts []string) (Time[TZ], error) {
+	errs := make([]error, 0, len(layouts))
+	for _, layout := range layouts {
+		t, err := Parse[TZ](layout, value)
+		if err == nil {
+			return t, nil
+		}
+		errs = append(errs, err)
+	}
+	if len(errs) == 1 {
+		return Time[TZ]{}, errs[0]
+	}
+	return Time[TZ]{}, fmt.Errorf("cannot parse %q with any of the layouts %s: %w",
+		value, strings.Join(quoteAll(layouts), ", "), errors.Join(errs...))
+}
+
+// quoteAll returns a copy of ss with each element quoted.
+func quoteAll(ss []string) []string {
+	quoted := make([]string, len(ss))
+	for i, s := range ss {
+		quoted[i] = fmt.Sprintf("%q", s)
+	}
+	return quoted
+}
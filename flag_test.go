@@ -0,0 +1,125 @@
+package meridian
+
+import (
+	"flag"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFlagSet(t *testing.T) {
+	tests := []struct {
+		name     string
+		layouts  []string
+		value    string
+		expected time.Time
+	}{
+		{
+			name:     "default RFC3339 layout",
+			value:    "2024-06-15T14:30:00Z",
+			expected: time.Date(2024, time.June, 15, 14, 30, 0, 0, time.UTC),
+		},
+		{
+			name:     "first matching layout",
+			layouts:  []string{time.RFC3339, time.DateOnly},
+			value:    "2024-06-15T14:30:00-04:00",
+			expected: time.Date(2024, time.June, 15, 18, 30, 0, 0, time.UTC),
+		},
+		{
+			name:     "fallback layout parsed in timezone",
+			layouts:  []string{time.RFC3339, time.DateOnly},
+			value:    "2024-01-15",
+			expected: time.Date(2024, time.January, 15, 5, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var since Time[EST]
+			fs := flag.NewFlagSet("test", flag.ContinueOnError)
+			fs.Var(NewFlag(&since, tt.layouts...), "since", "start time")
+
+			if err := fs.Parse([]string{"--since", tt.value}); err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if !since.Equal(tt.expected) {
+				t.Errorf("since = %v, want %v", since.UTC(), tt.expected)
+			}
+		})
+	}
+}
+
+func TestFlagSetInvalid(t *testing.T) {
+	var since Time[UTC]
+	f := NewFlag(&since, time.RFC3339, time.DateOnly)
+
+	err := f.Set("yesterday")
+	if err == nil {
+		t.Fatal("Set() expected error for invalid input, got nil")
+	}
+	if !strings.Contains(err.Error(), `"2006-01-02"`) {
+		t.Errorf("Set() error = %q, expected it to list the layouts", err)
+	}
+	if !since.IsZero() {
+		t.Errorf("Set() modified target on error: %v", since)
+	}
+}
+
+func TestFlagString(t *testing.T) {
+	var zero *Flag[UTC]
+	if got := zero.String(); got != "" {
+		t.Errorf("nil Flag String() = %q, want empty", got)
+	}
+
+	var unset Time[UTC]
+	if got := NewFlag(&unset).String(); got != "" {
+		t.Errorf("zero value String() = %q, want empty", got)
+	}
+
+	value := Date[EST](2024, time.January, 15, 9, 0, 0, 0)
+	f := NewFlag(&value, time.DateTime, time.RFC3339)
+	if got := f.String(); got != "2024-01-15 09:00:00" {
+		t.Errorf("String() = %q, want %q", got, "2024-01-15 09:00:00")
+	}
+}
+
+func TestFlagDefaultInUsage(t *testing.T) {
+	deadline := Date[UTC](2024, time.December, 31, 0, 0, 0, 0)
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.Var(NewFlag(&deadline, time.DateOnly), "deadline", "cutoff date")
+
+	var usage strings.Builder
+	fs.SetOutput(&usage)
+	fs.PrintDefaults()
+
+	if !strings.Contains(usage.String(), "(default 2024-12-31)") {
+		t.Errorf("PrintDefaults() = %q, expected default value", usage.String())
+	}
+
+	var unset Time[UTC]
+	fs = flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.Var(NewFlag(&unset), "since", "start time")
+	usage.Reset()
+	fs.SetOutput(&usage)
+	fs.PrintDefaults()
+
+	if strings.Contains(usage.String(), "default") {
+		t.Errorf("PrintDefaults() = %q, zero value should not be reported as a default", usage.String())
+	}
+}
+
+func TestFlagGetAndType(t *testing.T) {
+	value := Date[UTC](2024, time.June, 15, 0, 0, 0, 0)
+	f := NewFlag(&value)
+
+	got, ok := f.Get().(Time[UTC])
+	if !ok {
+		t.Fatalf("Get() returned %T, want Time[UTC]", f.Get())
+	}
+	if !got.Equal(value) {
+		t.Errorf("Get() = %v, want %v", got, value)
+	}
+	if f.Type() != "time" {
+		t.Errorf("Type() = %q, want %q", f.Type(), "time")
+	}
+}
@@ -0,0 +1,142 @@
+package gen
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/matthalp/go-meridian/v2/tzinfo"
+)
+
+// AbbreviationPackage is the name of the package that resolves time zone
+// abbreviations using the table rendered by RenderAbbreviationTable.
+const AbbreviationPackage = "tzabbrev"
+
+// abbreviationTableData contains the variables for rendering the
+// abbreviation table.
+type abbreviationTableData struct {
+	PackageName string
+	Year        int
+	Abbrevs     []abbreviationEntry
+}
+
+// abbreviationEntry lists the locations that use one abbreviation.
+type abbreviationEntry struct {
+	Abbrev    string
+	Locations []string
+}
+
+// RenderAbbreviationTable renders, without writing, the Go source file path
+// declaring, in package pkg, a map named abbreviations from each time zone
+// abbreviation to the sorted IANA locations that use it, as used by the
+// AbbreviationPackage.
+//
+// The table covers the locations of zone.tab, the zones people choose from,
+// plus UTC, and the abbreviations they use under the rules of
+// abbreviationYear. Numeric abbreviations such as "+03", which tzdata uses
+// where no English abbreviation is established, are left out: they state
+// their offset and need no lookup.
+func (g *Generator) RenderAbbreviationTable(path, pkg string) (File, error) {
+	table, err := abbreviationTable()
+	if err != nil {
+		return File{}, err
+	}
+
+	data := abbreviationTableData{PackageName: pkg, Year: abbreviationYear}
+	for abbrev, locations := range table {
+		sort.Strings(locations)
+		data.Abbrevs = append(data.Abbrevs, abbreviationEntry{Abbrev: abbrev, Locations: locations})
+	}
+	sort.Slice(data.Abbrevs, func(i, j int) bool { return data.Abbrevs[i].Abbrev < data.Abbrevs[j].Abbrev })
+
+	src, err := g.renderFile(path, abbreviationTableTemplate, data)
+	if err != nil {
+		return File{}, fmt.Errorf("failed to generate %s: %w", filepath.Base(path), err)
+	}
+	return File{Path: path, Content: src}, nil
+}
+
+// abbreviationTable maps each alphabetic abbreviation used during
+// abbreviationYear to the locations that use it.
+func abbreviationTable() (map[string][]string, error) {
+	uses, err := abbreviationOffsets()
+	if err != nil {
+		return nil, err
+	}
+
+	table := make(map[string][]string, len(uses))
+	for abbrev, locations := range uses {
+		for name := range locations {
+			table[abbrev] = append(table[abbrev], name)
+		}
+	}
+	return table, nil
+}
+
+// abbreviationOffsets maps each alphabetic abbreviation used during
+// abbreviationYear by the locations of zone.tab, plus UTC, to those
+// locations and their offsets when using it.
+func abbreviationOffsets() (map[string]map[string]int, error) {
+	locations, err := ianaLocations()
+	if err != nil {
+		return nil, err
+	}
+
+	uses := make(map[string]map[string]int)
+	for _, name := range locations {
+		if _, ok := tzinfo.Lookup(name); !ok && name != "UTC" {
+			continue
+		}
+		loc, err := loadLocation(name)
+		if err != nil {
+			return nil, err
+		}
+		for _, use := range yearAbbreviations(loc, abbreviationYear) {
+			if uses[use.Abbrev] == nil {
+				uses[use.Abbrev] = make(map[string]int)
+			}
+			uses[use.Abbrev][name] = use.Offset
+		}
+	}
+	return uses, nil
+}
+
+// abbreviationUse is an abbreviation a location uses and its offset, in
+// seconds east of UTC, when it first uses it.
+type abbreviationUse struct {
+	Abbrev string
+	Offset int
+}
+
+// yearAbbreviations returns the distinct alphabetic abbreviations loc uses
+// during year, in order of first use.
+func yearAbbreviations(loc *time.Location, year int) []abbreviationUse {
+	var uses []abbreviationUse
+	seen := make(map[string]bool)
+	end := time.Date(year+1, time.January, 1, 0, 0, 0, 0, time.UTC)
+	for t := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC); t.Before(end); {
+		local := t.In(loc)
+		name, offset := local.Zone()
+		if !seen[name] && isAlphabetic(name) {
+			seen[name] = true
+			uses = append(uses, abbreviationUse{Abbrev: name, Offset: offset})
+		}
+		_, next := local.ZoneBounds()
+		if next.IsZero() {
+			break
+		}
+		t = next
+	}
+	return uses
+}
+
+// isAlphabetic reports whether s is a non-empty run of ASCII letters.
+func isAlphabetic(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if c := s[i]; (c < 'A' || c > 'Z') && (c < 'a' || c > 'z') {
+			return false
+		}
+	}
+	return s != ""
+}
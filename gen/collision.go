@@ -0,0 +1,138 @@
+package gen
+
+import (
+	"fmt"
+	"go/token"
+	"sort"
+	"strings"
+
+	"github.com/matthalp/go-meridian/v2/tzinfo"
+)
+
+// Reservation claims a package name that is an ambiguous time zone
+// abbreviation, such as "ist" for India, Israel, and Irish Standard Time.
+// Only a definition of Location may use the name, as a name or an alias; with
+// no Location, no definition may. Zones sharing the abbreviation take a
+// region-prefixed name instead, the lowercase ISO 3166 code of their country
+// followed by the abbreviation, such as ilist for Israel Standard Time.
+type Reservation struct {
+	Name     string `yaml:"name"`
+	Location string `yaml:"location,omitempty"`
+	Reason   string `yaml:"reason"`
+}
+
+// CheckCollisions reports definitions whose package names or aliases
+// collide with an ambiguous time zone abbreviation, so that a new zone
+// cannot silently take a name readers associate with another. A name
+// collides if it is reserved for a different location, or if, uppercased,
+// it is an abbreviation that locations with different UTC offsets use (as
+// in the table of package tzabbrev) and it is not reserved at all. Each
+// problem suggests a region-prefixed name. All problems are reported at once.
+func CheckCollisions(defs []TimezoneDef, reserved []Reservation) error {
+	var problems []string
+	reservations := make(map[string]Reservation, len(reserved))
+	for _, r := range reserved {
+		if _, ok := reservations[r.Name]; ok {
+			problems = append(problems, fmt.Sprintf("reserved name %q is listed twice", r.Name))
+			continue
+		}
+		if !token.IsIdentifier(r.Name) || token.IsKeyword(r.Name) || strings.ToLower(r.Name) != r.Name {
+			problems = append(problems, fmt.Sprintf("reserved name %q is not a valid lowercase package name", r.Name))
+		}
+		reservations[r.Name] = r
+	}
+
+	ambiguous, err := ambiguousAbbreviations()
+	if err != nil {
+		return err
+	}
+
+	for _, def := range defs {
+		for _, name := range append([]string{def.Name}, def.Aliases...) {
+			var problem, advice string
+			if r, ok := reservations[name]; ok {
+				if r.Location != "" && r.Location == def.Location {
+					continue
+				}
+				problem = fmt.Sprintf("%s: name %q is reserved", def.Name, name)
+				if r.Location != "" {
+					problem += fmt.Sprintf(" for %s", r.Location)
+				}
+				if r.Reason != "" {
+					problem += fmt.Sprintf(" (%s)", r.Reason)
+				}
+			} else if uses, ok := ambiguous[strings.ToUpper(name)]; ok {
+				problem = fmt.Sprintf("%s: name %q is an ambiguous abbreviation (%s)", def.Name, name, uses)
+				advice = "reserve it for one location"
+			} else {
+				continue
+			}
+			if suggestion := regionPrefixedName(def.Location, name); suggestion != "" {
+				if advice != "" {
+					advice += " or "
+				}
+				advice += fmt.Sprintf("use a region-prefixed name such as %q", suggestion)
+			}
+			if advice != "" {
+				problem += "; " + advice
+			}
+			problems = append(problems, problem)
+		}
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("colliding timezone names:\n  %s", strings.Join(problems, "\n  "))
+	}
+	return nil
+}
+
+// regionPrefixedName returns name prefixed with the lowercase country code
+// of location, or "" if location has no country.
+func regionPrefixedName(location, name string) string {
+	zone, ok := tzinfo.Lookup(location)
+	if !ok || len(zone.Countries) == 0 {
+		return ""
+	}
+	return strings.ToLower(zone.Countries[0]) + name
+}
+
+// ambiguousAbbreviations maps each abbreviation of the abbreviation table
+// that locations use at different UTC offsets to a description of its uses,
+// such as "UTC+02:00 in Asia/Jerusalem, UTC+05:30 in Asia/Kolkata, ...".
+func ambiguousAbbreviations() (map[string]string, error) {
+	uses, err := abbreviationOffsets()
+	if err != nil {
+		return nil, err
+	}
+
+	ambiguous := make(map[string]string)
+	for abbrev, locations := range uses {
+		byOffset := make(map[int][]string)
+		for name, offset := range locations {
+			byOffset[offset] = append(byOffset[offset], name)
+		}
+		if len(byOffset) < 2 {
+			continue
+		}
+		offsets := make([]int, 0, len(byOffset))
+		for offset := range byOffset {
+			offsets = append(offsets, offset)
+		}
+		sort.Ints(offsets)
+		descriptions := make([]string, len(offsets))
+		for i, offset := range offsets {
+			names := byOffset[offset]
+			sort.Strings(names)
+			descriptions[i] = fmt.Sprintf("%s in %s", fixedZoneName(offset), names[0])
+			switch len(names) {
+			case 1:
+			case 2:
+				descriptions[i] += " and 1 other"
+			default:
+				descriptions[i] += fmt.Sprintf(" and %d others", len(names)-1)
+			}
+		}
+		ambiguous[abbrev] = strings.Join(descriptions, ", ")
+	}
+	return ambiguous, nil
+}
@@ -0,0 +1,179 @@
+package gen
+
+import (
+	"fmt"
+	"go/token"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// LoadConfig reads timezone definitions from the YAML file at path, in the
+// format of meridian's timezones.yaml.
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	var config Config
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+
+	return &config, nil
+}
+
+// Validate checks every definition before any files are written,
+// so a typo such as "America/NewYork" is reported up front instead of
+// producing a package that panics at init. All problems are reported at once.
+func Validate(defs []TimezoneDef) error {
+	var problems []string
+	names := make(map[string]bool, len(defs))
+	for i, def := range defs {
+		label := def.Name
+		if label == "" {
+			label = fmt.Sprintf("entry %d", i+1)
+		}
+
+		switch {
+		case def.Name == "":
+			problems = append(problems, fmt.Sprintf("%s: missing name", label))
+		case !token.IsIdentifier(def.Name) || token.IsKeyword(def.Name) || strings.ToLower(def.Name) != def.Name:
+			problems = append(problems, fmt.Sprintf("%s: name is not a valid lowercase package name", label))
+		case names[def.Name]:
+			problems = append(problems, fmt.Sprintf("%s: duplicate name", label))
+		}
+		names[def.Name] = true
+
+		for _, doc := range append([]string{def.Description, def.LongDescription, def.DSTNotes}, def.Examples...) {
+			if strings.Contains(doc, "*/") {
+				problems = append(problems, fmt.Sprintf("%s: documentation must not contain \"*/\"", label))
+				break
+			}
+		}
+
+		for _, alias := range def.Aliases {
+			switch {
+			case !token.IsIdentifier(alias) || token.IsKeyword(alias) || strings.ToLower(alias) != alias:
+				problems = append(problems, fmt.Sprintf("%s: alias %q is not a valid lowercase package name", label, alias))
+			case names[alias]:
+				problems = append(problems, fmt.Sprintf("%s: alias %q duplicates another name", label, alias))
+			}
+			names[alias] = true
+		}
+
+		switch {
+		case def.Location != "" && def.Offset != "":
+			problems = append(problems, fmt.Sprintf("%s: location and offset are mutually exclusive", label))
+		case def.Offset != "":
+			if _, err := parseOffset(def.Offset); err != nil {
+				problems = append(problems, fmt.Sprintf("%s: %v", label, err))
+			}
+		case def.Location == "":
+			problems = append(problems, fmt.Sprintf("%s: missing location or offset", label))
+		default:
+			if _, err := loadLocation(def.Location); err != nil {
+				problem := fmt.Sprintf("%s: unknown IANA location %q", label, def.Location)
+				if suggestion := suggestLocation(def.Location); suggestion != "" {
+					problem += fmt.Sprintf(" (did you mean %q?)", suggestion)
+				}
+				problems = append(problems, problem)
+			}
+		}
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("invalid timezone definitions:\n  %s", strings.Join(problems, "\n  "))
+	}
+	return nil
+}
+
+// suggestLocation returns the IANA location that differs from name only in
+// case, underscores, or hyphens, or "" if there is none.
+func suggestLocation(name string) string {
+	normalize := strings.NewReplacer("_", "", "-", "", " ", "").Replace
+	locations, err := ianaLocations()
+	if err != nil {
+		return ""
+	}
+	want := strings.ToLower(normalize(name))
+	for _, loc := range locations {
+		if strings.ToLower(normalize(loc)) == want {
+			return loc
+		}
+	}
+	return ""
+}
+
+// Select filters defs by package name or location. If only is
+// non-empty, just the listed zones are kept; zones listed in skip are
+// dropped. Entries that match no definition are reported as errors so typos
+// do not silently generate nothing.
+func Select(defs []TimezoneDef, only, skip []string) ([]TimezoneDef, error) {
+	matches := func(def TimezoneDef, names map[string]bool) bool {
+		if names[def.Name] || names[def.Location] {
+			return true
+		}
+		for _, alias := range def.Aliases {
+			if names[alias] {
+				return true
+			}
+		}
+		return false
+	}
+	onlySet, skipSet := toSet(only), toSet(skip)
+
+	var selected []TimezoneDef
+	for _, def := range defs {
+		if len(onlySet) > 0 && !matches(def, onlySet) {
+			continue
+		}
+		if matches(def, skipSet) {
+			continue
+		}
+		selected = append(selected, def)
+	}
+
+	var unknown []string
+	for _, name := range append(append([]string(nil), only...), skip...) {
+		found := false
+		for _, def := range defs {
+			if matches(def, map[string]bool{name: true}) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			unknown = append(unknown, name)
+		}
+	}
+	if len(unknown) > 0 {
+		return nil, fmt.Errorf("unknown timezones in only/skip: %s", strings.Join(unknown, ", "))
+	}
+
+	return selected, nil
+}
+
+// trimAll returns a copy of list with surrounding whitespace trimmed from each
+// element.
+func trimAll(list []string) []string {
+	if len(list) == 0 {
+		return nil
+	}
+	trimmed := make([]string, len(list))
+	for i, item := range list {
+		trimmed[i] = strings.TrimSpace(item)
+	}
+	return trimmed
+}
+
+// toSet returns a set containing the elements of list.
+func toSet(list []string) map[string]bool {
+	set := make(map[string]bool, len(list))
+	for _, item := range list {
+		set[item] = true
+	}
+	return set
+}
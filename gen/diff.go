@@ -0,0 +1,182 @@
+package gen
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// diffContext is the number of unchanged lines shown around each change.
+const diffContext = 3
+
+// Diff returns a unified diff from the files currently on disk to files,
+// without writing anything. Files that do not exist yet are shown as
+// additions. The result is empty if every file is up to date.
+func Diff(files []File) ([]byte, error) {
+	var buf bytes.Buffer
+	for _, f := range files {
+		oldName := "a/" + filepath.ToSlash(f.Path)
+		old, err := os.ReadFile(f.Path)
+		if errors.Is(err, fs.ErrNotExist) {
+			oldName = "/dev/null"
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", f.Path, err)
+		}
+		buf.Write(unifiedDiff(oldName, "b/"+filepath.ToSlash(f.Path), old, f.Content))
+	}
+	return buf.Bytes(), nil
+}
+
+// diffOp is one line of an edit script: kept (' '), deleted ('-'), or
+// inserted ('+').
+type diffOp struct {
+	kind byte
+	text string
+}
+
+// unifiedDiff returns the unified diff of old and new, labelled with the given
+// names, or nil if they are equal.
+func unifiedDiff(oldName, newName string, old, new []byte) []byte {
+	if bytes.Equal(old, new) {
+		return nil
+	}
+	ops := lineOps(splitLines(old), splitLines(new))
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "--- %s\n+++ %s\n", oldName, newName)
+	for start := 0; start < len(ops); {
+		// Find the next change and extend the hunk until the gap to the
+		// following change is too wide to share context.
+		first := start
+		for first < len(ops) && ops[first].kind == ' ' {
+			first++
+		}
+		if first == len(ops) {
+			break
+		}
+		last := first
+		for i := first; i < len(ops) && i-last <= 2*diffContext; i++ {
+			if ops[i].kind != ' ' {
+				last = i
+			}
+		}
+
+		from := first - diffContext
+		if from < start {
+			from = start
+		}
+		to := last + diffContext + 1
+		if to > len(ops) {
+			to = len(ops)
+		}
+		writeHunk(&buf, ops, from, to)
+		start = to
+	}
+	return buf.Bytes()
+}
+
+// writeHunk writes the hunk covering ops[from:to].
+func writeHunk(buf *bytes.Buffer, ops []diffOp, from, to int) {
+	oldLine, newLine := 0, 0
+	for _, op := range ops[:from] {
+		if op.kind != '+' {
+			oldLine++
+		}
+		if op.kind != '-' {
+			newLine++
+		}
+	}
+	oldCount, newCount := 0, 0
+	for _, op := range ops[from:to] {
+		if op.kind != '+' {
+			oldCount++
+		}
+		if op.kind != '-' {
+			newCount++
+		}
+	}
+	// An empty range is numbered by the line before it.
+	if oldCount > 0 {
+		oldLine++
+	}
+	if newCount > 0 {
+		newLine++
+	}
+
+	fmt.Fprintf(buf, "@@ -%d,%d +%d,%d @@\n", oldLine, oldCount, newLine, newCount)
+	for _, op := range ops[from:to] {
+		buf.WriteByte(op.kind)
+		buf.WriteString(op.text)
+		buf.WriteByte('\n')
+	}
+}
+
+// lineOps returns an edit script turning a into b, based on their longest
+// common subsequence of lines.
+func lineOps(a, b []string) []diffOp {
+	// Generated files mostly change in a few places, so trim the common
+	// prefix and suffix before the quadratic search.
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+	ma, mb := a[prefix:len(a)-suffix], b[prefix:len(b)-suffix]
+
+	// lcs[i][j] is the length of the longest common subsequence of ma[i:]
+	// and mb[j:].
+	lcs := make([][]int, len(ma)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(mb)+1)
+	}
+	for i := len(ma) - 1; i >= 0; i-- {
+		for j := len(mb) - 1; j >= 0; j-- {
+			switch {
+			case ma[i] == mb[j]:
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			case lcs[i+1][j] >= lcs[i][j+1]:
+				lcs[i][j] = lcs[i+1][j]
+			default:
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	ops := make([]diffOp, 0, len(a)+len(b))
+	for _, line := range a[:prefix] {
+		ops = append(ops, diffOp{' ', line})
+	}
+	for i, j := 0, 0; i < len(ma) || j < len(mb); {
+		switch {
+		case i < len(ma) && j < len(mb) && ma[i] == mb[j]:
+			ops = append(ops, diffOp{' ', ma[i]})
+			i++
+			j++
+		case i < len(ma) && (j == len(mb) || lcs[i+1][j] >= lcs[i][j+1]):
+			ops = append(ops, diffOp{'-', ma[i]})
+			i++
+		default:
+			ops = append(ops, diffOp{'+', mb[j]})
+			j++
+		}
+	}
+	for _, line := range a[len(a)-suffix:] {
+		ops = append(ops, diffOp{' ', line})
+	}
+	return ops
+}
+
+// splitLines splits data into lines without their terminating newlines.
+func splitLines(data []byte) []string {
+	if len(data) == 0 {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+}
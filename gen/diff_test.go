@@ -0,0 +1,89 @@
+package gen
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestUnifiedDiff(t *testing.T) {
+	tests := []struct {
+		name     string
+		old, new string
+		want     string
+	}{
+		{
+			name: "equal",
+			old:  "a\nb\n",
+			new:  "a\nb\n",
+			want: "",
+		},
+		{
+			name: "change with context",
+			old:  "1\n2\n3\n4\n5\n6\n7\n8\n",
+			new:  "1\n2\n3\n4\nfive\n6\n7\n8\n",
+			want: "--- old\n+++ new\n@@ -2,7 +2,7 @@\n 2\n 3\n 4\n-5\n+five\n 6\n 7\n 8\n",
+		},
+		{
+			name: "separate hunks",
+			old:  "a\n1\n2\n3\n4\n5\n6\n7\n8\nb\n",
+			new:  "A\n1\n2\n3\n4\n5\n6\n7\n8\nB\n",
+			want: "--- old\n+++ new\n@@ -1,4 +1,4 @@\n-a\n+A\n 1\n 2\n 3\n@@ -7,4 +7,4 @@\n 6\n 7\n 8\n-b\n+B\n",
+		},
+		{
+			name: "new file",
+			old:  "",
+			new:  "x\ny\n",
+			want: "--- old\n+++ new\n@@ -0,0 +1,2 @@\n+x\n+y\n",
+		},
+		{
+			name: "insertion",
+			old:  "a\nc\n",
+			new:  "a\nb\nc\n",
+			want: "--- old\n+++ new\n@@ -1,2 +1,3 @@\n a\n+b\n c\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := string(unifiedDiff("old", "new", []byte(tt.old), []byte(tt.new)))
+			if got != tt.want {
+				t.Errorf("unifiedDiff() =\n%s\nwant:\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDiff(t *testing.T) {
+	dir := t.TempDir()
+	existing := filepath.Join(dir, "existing.go")
+	if err := os.WriteFile(existing, []byte("package x\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	unchanged, err := Diff([]File{{Path: existing, Content: []byte("package x\n")}})
+	if err != nil {
+		t.Fatalf("Diff() error = %v", err)
+	}
+	if len(unchanged) != 0 {
+		t.Errorf("Diff() of an up-to-date file = %q, want empty", unchanged)
+	}
+
+	added := filepath.Join(dir, "added.go")
+	got, err := Diff([]File{
+		{Path: existing, Content: []byte("package y\n")},
+		{Path: added, Content: []byte("package x\n")},
+	})
+	if err != nil {
+		t.Fatalf("Diff() error = %v", err)
+	}
+	for _, want := range []string{"-package x\n+package y\n", "--- /dev/null\n", "+package x\n"} {
+		if !strings.Contains(string(got), want) {
+			t.Errorf("Diff() = %q, want it to contain %q", got, want)
+		}
+	}
+	if _, err := os.Stat(added); !os.IsNotExist(err) {
+		t.Errorf("Diff() created %s", added)
+	}
+}
@@ -0,0 +1,626 @@
+/*
+Package gen generates typed timezone packages for meridian.
+
+It is the library behind the generate-timezones command, exposed so that
+other modules can generate their own timezone packages, such as a set of
+in-house "plant floor" zones, from a go:generate directive:
+
+	//go:generate go run ./internal/gentz
+
+where the program reads its definitions and renders them into its module:
+
+	cfg, err := gen.LoadConfig("zones.yaml")
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := gen.Validate(cfg.Timezones); err != nil {
+		log.Fatal(err)
+	}
+	if err := gen.CheckCollisions(cfg.Timezones, cfg.Reserved); err != nil {
+		log.Fatal(err)
+	}
+	g := &gen.Generator{ModulePath: "example.com/plant"}
+	for _, def := range cfg.Timezones {
+		if err := g.Generate("zones", def); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+Each definition produces a package with the Timezone type, a Time alias, and
+constructors (Now, Date, FromMoment, Parse, Unix, ...), along with its tests,
+benchmarks, and examples. Output is formatted and deterministic: rendering an
+unchanged definition yields byte-for-byte identical files.
+*/
+package gen
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/build/constraint"
+	"os"
+	"path"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"golang.org/x/mod/modfile"
+	"golang.org/x/tools/imports"
+)
+
+// Config represents the timezones.yaml structure.
+type Config struct {
+	// BuildTags, if set, is a build constraint expression added to every
+	// generated file; see Generator.BuildTags.
+	BuildTags string `yaml:"build_tags,omitempty"`
+
+	// Reserved lists package names that are ambiguous time zone
+	// abbreviations and the locations allowed to use them; see
+	// CheckCollisions.
+	Reserved []Reservation `yaml:"reserved,omitempty"`
+
+	Timezones []TimezoneDef `yaml:"timezones"`
+}
+
+// TimezoneDef defines a single timezone.
+type TimezoneDef struct {
+	Name        string `yaml:"name"`
+	Location    string `yaml:"location"`
+	Description string `yaml:"description"`
+
+	// LongDescription, if set, replaces the generated overview paragraph of
+	// the package documentation. DSTNotes adds a section describing daylight
+	// saving time observance, and each of Examples is added as a code block.
+	LongDescription string   `yaml:"long_description,omitempty"`
+	DSTNotes        string   `yaml:"dst_notes,omitempty"`
+	Examples        []string `yaml:"examples,omitempty"`
+
+	// Offset, if set instead of Location, defines a fixed UTC offset such as
+	// "+05:30" or "-03". The generated package is backed by time.FixedZone
+	// rather than an IANA location.
+	Offset string `yaml:"offset,omitempty"`
+
+	// Aliases are additional package names that re-export this timezone's
+	// types and functions, for organizations with their own naming
+	// conventions (e.g. eastern for et).
+	Aliases []string `yaml:"aliases,omitempty"`
+}
+
+// TemplateData contains all variables needed for template rendering.
+type TemplateData struct {
+	PackageName string
+	Location    string
+	Description string
+	Abbrev      string
+
+	// Optional documentation from the definition, trimmed of surrounding
+	// whitespace.
+	LongDescription string
+	DSTNotes        string
+	Examples        []string
+
+	// FixedOffset reports whether the package is backed by time.FixedZone,
+	// in which case Location is the zone's name (e.g. "UTC+05:30") and
+	// OffsetSeconds its offset east of UTC.
+	FixedOffset   bool
+	OffsetSeconds int
+
+	// DiffersFromUTC reports whether the location's UTC offset in mid-July is
+	// non-zero. Tests that compare clock times against UTC are only emitted
+	// when it is.
+	DiffersFromUTC bool
+
+	// ImportPath is the import path of the generated package, used by its
+	// external example tests.
+	ImportPath string
+
+	// Expected output of the generated examples, computed from the location
+	// at generation time.
+	ExampleDate       string
+	ExampleDateUTC    string
+	ExampleFromMoment string
+
+	// StandardAbbrev and DaylightAbbrev are the location's time zone
+	// abbreviations, derived from tzdata. DaylightAbbrev is empty if the
+	// location does not observe daylight saving time.
+	StandardAbbrev string
+	DaylightAbbrev string
+
+	// MeridianPath is the import path of the meridian module that the
+	// generated package builds on.
+	MeridianPath string
+
+	// AliasOf and AliasOfImportPath name the canonical package that an alias
+	// package re-exports. They are empty for canonical packages.
+	AliasOf           string
+	AliasOfImportPath string
+}
+
+// Generator renders timezone packages into a module.
+type Generator struct {
+	// ModulePath is the module path of the module the packages are
+	// generated into, such as "example.com/plant". It determines the import
+	// paths used by the generated examples and alias packages.
+	ModulePath string
+
+	// ModuleDir is the root directory of that module. If empty, it is the
+	// current directory. Output directories must be inside it.
+	ModuleDir string
+
+	// MeridianPath is the import path of the meridian module that generated
+	// packages import. If empty, it is DefaultMeridianPath; forks of meridian
+	// set it to their own module path.
+	MeridianPath string
+
+	// BuildTags, if set, is a build constraint expression such as
+	// "meridian_all_zones" added as a //go:build line to every generated
+	// file, so that large sets of zones are only compiled when requested.
+	BuildTags string
+
+	// Parallelism is the maximum number of packages RenderAll renders
+	// concurrently. If it is zero or negative, runtime.GOMAXPROCS(0) is used.
+	Parallelism int
+}
+
+// DefaultMeridianPath is the import path of the meridian module.
+const DefaultMeridianPath = "github.com/matthalp/go-meridian/v2"
+
+// meridianPath returns the import path of the meridian module.
+func (g *Generator) meridianPath() string {
+	if g.MeridianPath == "" {
+		return DefaultMeridianPath
+	}
+	return g.MeridianPath
+}
+
+// File is a rendered file and the path it is written to.
+type File struct {
+	Path    string
+	Content []byte
+}
+
+// fileSpec describes one file generated for each package.
+type fileSpec struct {
+	name string // file name; "%s" is replaced with the package's file base name
+	tmpl *template.Template
+}
+
+// packageFiles are the files generated for each timezone package.
+var packageFiles = []fileSpec{
+	{"%s.go", packageTemplate},
+	{"%s_test.go", testTemplate},
+	{"%s_bench_test.go", benchTemplate},
+	{"example_test.go", exampleTemplate},
+}
+
+// standalonePackageFiles are the files generated for each timezone package
+// outside the meridian module, whose tests cannot use its internal test kit.
+var standalonePackageFiles = []fileSpec{
+	{"%s.go", packageTemplate},
+	{"%s_test.go", standaloneTestTemplate},
+	{"%s_bench_test.go", benchTemplate},
+	{"example_test.go", exampleTemplate},
+}
+
+// aliasFiles are the files generated for each alias package.
+var aliasFiles = []fileSpec{
+	{"%s.go", aliasTemplate},
+	{"%s_test.go", aliasTestTemplate},
+}
+
+// registryFiles are the files generated for the registry package.
+var registryFiles = []fileSpec{
+	{"%s.go", registryTemplate},
+	{"%s_test.go", registryTestTemplate},
+}
+
+// generatedHeader marks files as generated, following the convention
+// recognized by go vet, linters, and code review tools.
+const generatedHeader = "// Code generated by generate-timezones. DO NOT EDIT.\n\n"
+
+// Generate generates the package for def, and any aliases, in baseDir.
+func (g *Generator) Generate(baseDir string, def TimezoneDef) error {
+	files, err := g.Render(baseDir, def)
+	if err != nil {
+		return err
+	}
+	return WriteFiles(files)
+}
+
+// Render renders the files for def and its aliases without writing them. The
+// output depends only on its arguments, the Generator's fields, and the time
+// zone database, so regenerating an unchanged definition is byte-for-byte
+// reproducible.
+func (g *Generator) Render(baseDir string, def TimezoneDef) ([]File, error) {
+	data, err := g.templateData(baseDir, def)
+	if err != nil {
+		return nil, err
+	}
+
+	specs := packageFiles
+	if g.ModulePath != data.MeridianPath {
+		specs = standalonePackageFiles
+	}
+
+	pkgDir := filepath.Join(baseDir, def.Name)
+	files, err := g.renderFiles(pkgDir, def.Name, data, specs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate in %s: %w", pkgDir, err)
+	}
+
+	for _, alias := range def.Aliases {
+		aliasDir := filepath.Join(baseDir, alias)
+		aliasData, err := g.aliasTemplateData(aliasDir, alias, data)
+		if err != nil {
+			return nil, err
+		}
+		aliasFiles, err := g.renderFiles(aliasDir, alias, aliasData, aliasFiles)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate alias %s: %w", alias, err)
+		}
+		files = append(files, aliasFiles...)
+	}
+
+	return files, nil
+}
+
+// RenderAll renders the packages for defs, and any aliases, in baseDir using
+// a bounded pool of workers. Files are returned in definition order regardless
+// of scheduling, so output is deterministic. If any definitions fail, the
+// errors for all of them are joined.
+func (g *Generator) RenderAll(baseDir string, defs []TimezoneDef) ([]File, error) {
+	workers := g.Parallelism
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if workers > len(defs) {
+		workers = len(defs)
+	}
+
+	results := make([][]File, len(defs))
+	errs := make([]error, len(defs))
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				results[i], errs[i] = g.Render(baseDir, defs[i])
+				if errs[i] != nil {
+					errs[i] = fmt.Errorf("failed to generate %s: %w", defs[i].Name, errs[i])
+				}
+			}
+		}()
+	}
+	for i := range defs {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+	var files []File
+	for _, r := range results {
+		files = append(files, r...)
+	}
+	return files, nil
+}
+
+// templateData returns the template data for the package generated for def
+// in baseDir.
+func (g *Generator) templateData(baseDir string, def TimezoneDef) (TemplateData, error) {
+	var (
+		loc           *time.Location
+		offsetSeconds int
+		err           error
+	)
+	if def.Offset != "" {
+		if offsetSeconds, err = parseOffset(def.Offset); err != nil {
+			return TemplateData{}, err
+		}
+		loc = time.FixedZone(fixedZoneName(offsetSeconds), offsetSeconds)
+	} else if loc, err = loadLocation(def.Location); err != nil {
+		return TemplateData{}, fmt.Errorf("failed to load location: %w", err)
+	}
+	_, julyOffset := time.Date(2024, time.July, 15, 12, 0, 0, 0, loc).Zone()
+
+	pkgDir := filepath.Join(baseDir, def.Name)
+	importPath, err := g.packageImportPath(pkgDir)
+	if err != nil {
+		return TemplateData{}, err
+	}
+
+	standardAbbrev, daylightAbbrev := abbreviations(loc)
+
+	// These must match the values used in exampleTemplate.
+	exampleDate := time.Date(2024, time.December, 25, 9, 0, 0, 0, loc)
+	exampleMoment := time.Date(2024, time.June, 15, 12, 0, 0, 0, time.UTC)
+
+	data := TemplateData{
+		PackageName:       def.Name,
+		Location:          loc.String(),
+		Description:       def.Description,
+		Abbrev:            strings.ToUpper(def.Name),
+		LongDescription:   strings.TrimSpace(def.LongDescription),
+		DSTNotes:          strings.TrimSpace(def.DSTNotes),
+		Examples:          trimAll(def.Examples),
+		FixedOffset:       def.Offset != "",
+		OffsetSeconds:     offsetSeconds,
+		DiffersFromUTC:    julyOffset != 0,
+		ImportPath:        importPath,
+		MeridianPath:      g.meridianPath(),
+		ExampleDate:       exampleDate.Format(time.RFC3339),
+		ExampleDateUTC:    exampleDate.UTC().Format(time.RFC3339),
+		ExampleFromMoment: exampleMoment.In(loc).Format("2006-01-02 15:04 MST"),
+		StandardAbbrev:    standardAbbrev,
+		DaylightAbbrev:    daylightAbbrev,
+	}
+
+	return data, nil
+}
+
+// RegistryPackage is the name of the registry package, which maps location
+// names and abbreviations to the generated packages at runtime.
+const RegistryPackage = "registry"
+
+// registryData contains the variables for rendering the registry package.
+type registryData struct {
+	PackageName  string
+	MeridianPath string
+	Zones        []TemplateData
+}
+
+// GenerateRegistry generates the registry package for defs in baseDir.
+func (g *Generator) GenerateRegistry(baseDir string, defs []TimezoneDef) error {
+	files, err := g.RenderRegistry(baseDir, defs)
+	if err != nil {
+		return err
+	}
+	return WriteFiles(files)
+}
+
+// RenderRegistry renders, without writing, the registry package in
+// baseDir/registry. It lists the packages generated for defs in baseDir, in
+// order, so that a zone chosen at runtime (such as a user's "America/Chicago"
+// preference) can be dispatched to its typed helpers.
+func (g *Generator) RenderRegistry(baseDir string, defs []TimezoneDef) ([]File, error) {
+	data := registryData{
+		PackageName:  RegistryPackage,
+		MeridianPath: g.meridianPath(),
+		Zones:        make([]TemplateData, 0, len(defs)),
+	}
+	for _, def := range defs {
+		for _, name := range append([]string{def.Name}, def.Aliases...) {
+			if name == RegistryPackage {
+				return nil, fmt.Errorf("%s: package name %q is reserved for the registry", def.Name, name)
+			}
+		}
+		zone, err := g.templateData(baseDir, def)
+		if err != nil {
+			return nil, fmt.Errorf("failed to register %s: %w", def.Name, err)
+		}
+		data.Zones = append(data.Zones, zone)
+	}
+
+	return g.renderFiles(filepath.Join(baseDir, RegistryPackage), RegistryPackage, data, registryFiles)
+}
+
+// parseOffset parses a UTC offset of the form ±hh, ±hhmm, or ±hh:mm and
+// returns it in seconds east of UTC.
+func parseOffset(s string) (int, error) {
+	invalid := fmt.Errorf("invalid offset %q, want ±hh:mm", s)
+	if len(s) < 3 || (s[0] != '+' && s[0] != '-') {
+		return 0, invalid
+	}
+	digits := strings.Replace(s[1:], ":", "", 1)
+	if len(digits) != 2 && len(digits) != 4 {
+		return 0, invalid
+	}
+	if len(digits) == 2 {
+		digits += "00"
+	}
+	for _, c := range digits {
+		if c < '0' || c > '9' {
+			return 0, invalid
+		}
+	}
+	hours := int(digits[0]-'0')*10 + int(digits[1]-'0')
+	minutes := int(digits[2]-'0')*10 + int(digits[3]-'0')
+	if hours > 14 || minutes > 59 || (hours == 14 && minutes > 0) {
+		return 0, fmt.Errorf("offset %q out of range", s)
+	}
+
+	seconds := hours*3600 + minutes*60
+	if s[0] == '-' {
+		seconds = -seconds
+	}
+	return seconds, nil
+}
+
+// fixedZoneName returns the name of a fixed-offset zone, such as "UTC+05:30".
+func fixedZoneName(seconds int) string {
+	sign := '+'
+	if seconds < 0 {
+		sign, seconds = '-', -seconds
+	}
+	return fmt.Sprintf("UTC%c%02d:%02d", sign, seconds/3600, seconds%3600/60)
+}
+
+// abbreviationYear is the year whose rules determine a location's
+// abbreviations. It is fixed so that generated output is reproducible.
+const abbreviationYear = 2025
+
+// abbreviations returns the standard and daylight saving time abbreviations in
+// effect for loc during abbreviationYear, sampling June and December so both
+// hemispheres' daylight saving periods are covered. daylight is empty if loc
+// does not observe daylight saving time. The months must match the generated
+// TestAbbreviations.
+func abbreviations(loc *time.Location) (standard, daylight string) {
+	for _, month := range []time.Month{time.June, time.December} {
+		t := time.Date(abbreviationYear, month, 1, 12, 0, 0, 0, loc)
+		name, _ := t.Zone()
+		if t.IsDST() {
+			daylight = name
+		} else if standard == "" {
+			standard = name
+		}
+	}
+	return standard, daylight
+}
+
+// aliasTemplateData returns the template data for a package named alias in
+// pkgDir that re-exports the canonical package described by canonical.
+func (g *Generator) aliasTemplateData(pkgDir, alias string, canonical TemplateData) (TemplateData, error) {
+	importPath, err := g.packageImportPath(pkgDir)
+	if err != nil {
+		return TemplateData{}, err
+	}
+
+	data := canonical
+	data.PackageName = alias
+	data.Abbrev = strings.ToUpper(alias)
+	data.ImportPath = importPath
+	data.AliasOf = canonical.PackageName
+	data.AliasOfImportPath = canonical.ImportPath
+	return data, nil
+}
+
+// renderFiles renders each of specs for the package name in pkgDir.
+func (g *Generator) renderFiles(pkgDir, name string, data any, specs []fileSpec) ([]File, error) {
+	base := fileBase(name)
+	files := make([]File, 0, len(specs))
+	for _, spec := range specs {
+		filename := filepath.Join(pkgDir, strings.ReplaceAll(spec.name, "%s", base))
+		src, err := g.renderFile(filename, spec.tmpl, data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate %s: %w", filepath.Base(filename), err)
+		}
+		files = append(files, File{Path: filename, Content: src})
+	}
+	return files, nil
+}
+
+// WriteFiles writes files to disk, creating directories as needed.
+func WriteFiles(files []File) error {
+	for _, f := range files {
+		if err := os.MkdirAll(filepath.Dir(f.Path), 0o750); err != nil {
+			return fmt.Errorf("failed to create directory %s: %w", filepath.Dir(f.Path), err)
+		}
+		if err := os.WriteFile(f.Path, f.Content, 0o600); err != nil {
+			return fmt.Errorf("failed to write file: %w", err)
+		}
+	}
+	return nil
+}
+
+// fileBase returns the base name, without extension, of the files generated
+// for the named package. A trailing _GOOS or _GOARCH element would act as an
+// implicit build constraint (australia_darwin.go only builds on macOS), so such
+// names get a "_zone" suffix.
+func fileBase(name string) string {
+	if i := strings.LastIndexByte(name, '_'); i >= 0 && buildSuffixes[name[i+1:]] {
+		return name + "_zone"
+	}
+	return name
+}
+
+// buildSuffixes are the GOOS and GOARCH values recognized in file names by the
+// go command, as listed in go/build.
+var buildSuffixes = toSet(strings.Fields(`
+	aix android darwin dragonfly freebsd hurd illumos ios js linux nacl netbsd
+	openbsd plan9 solaris wasip1 windows zos
+	386 amd64 amd64p32 arm armbe arm64 arm64be loong64 mips mipsle mips64
+	mips64le mips64p32 mips64p32le ppc ppc64 ppc64le riscv riscv64 s390 s390x
+	sparc sparc64 wasm
+`))
+
+// ReadModulePath returns the module path declared by the go.mod file in dir.
+func ReadModulePath(dir string) (string, error) {
+	gomod := filepath.Join(dir, "go.mod")
+	data, err := os.ReadFile(gomod)
+	if err != nil {
+		return "", fmt.Errorf("failed to read module path: %w", err)
+	}
+	modulePath := modfile.ModulePath(data)
+	if modulePath == "" {
+		return "", fmt.Errorf("no module directive in %s", gomod)
+	}
+	return modulePath, nil
+}
+
+// packageImportPath returns the import path of the package generated in
+// pkgDir, which must be inside the module root.
+func (g *Generator) packageImportPath(pkgDir string) (string, error) {
+	if g.ModulePath == "" {
+		return "", fmt.Errorf("module path is not set")
+	}
+	root, err := filepath.Abs(g.moduleDir())
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve %s: %w", g.moduleDir(), err)
+	}
+	dir, err := filepath.Abs(pkgDir)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve %s: %w", pkgDir, err)
+	}
+	rel, err := filepath.Rel(root, dir)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve %s: %w", pkgDir, err)
+	}
+	if rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("output directory %s is outside the module root %s", pkgDir, root)
+	}
+	return path.Join(g.ModulePath, filepath.ToSlash(rel)), nil
+}
+
+// moduleDir returns the module root directory.
+func (g *Generator) moduleDir() string {
+	if g.ModuleDir == "" {
+		return "."
+	}
+	return g.ModuleDir
+}
+
+// parseBuildTags parses a build constraint expression such as
+// "meridian_all_zones || meridian_americas".
+func parseBuildTags(tags string) (constraint.Expr, error) {
+	if strings.ContainsAny(tags, "\r\n") {
+		return nil, fmt.Errorf("invalid build tags %q", tags)
+	}
+	expr, err := constraint.Parse("//go:build " + tags)
+	if err != nil {
+		return nil, fmt.Errorf("invalid build tags %q: %w", tags, err)
+	}
+	return expr, nil
+}
+
+// renderFile executes tmpl and formats the result as the Go source file
+// filename.
+func (g *Generator) renderFile(filename string, tmpl *template.Template, data any) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(generatedHeader)
+	if g.BuildTags != "" {
+		expr, err := parseBuildTags(g.BuildTags)
+		if err != nil {
+			return nil, err
+		}
+		fmt.Fprintf(&buf, "//go:build %s\n\n", expr)
+	}
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return nil, fmt.Errorf("failed to execute template: %w", err)
+	}
+
+	// Format and fix up imports in-process so generation does not depend on
+	// goimports being installed.
+	src, err := imports.Process(filename, buf.Bytes(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to format generated code: %w", err)
+	}
+
+	return src, nil
+}
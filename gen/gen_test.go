@@ -0,0 +1,461 @@
+package gen
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestValidateDefinitions(t *testing.T) {
+	valid := []TimezoneDef{
+		{Name: "et", Location: "America/New_York", Description: "Eastern Time"},
+		{Name: "utc", Location: "UTC", Description: "Coordinated Universal Time"},
+	}
+	if err := Validate(valid); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+
+	invalid := []TimezoneDef{
+		{Name: "ny", Location: "America/NewYork"},
+		{Name: "Bad-Name", Location: "Asia/Tokyo"},
+		{Name: "ny", Location: "Mars/Olympus"},
+		{Name: "type", Location: "UTC"},
+		{Location: "UTC"},
+		{Name: "nowhere"},
+	}
+	err := Validate(invalid)
+	if err == nil {
+		t.Fatal("Validate() expected error, got nil")
+	}
+
+	for _, want := range []string{
+		`ny: unknown IANA location "America/NewYork" (did you mean "America/New_York"?)`,
+		"Bad-Name: name is not a valid lowercase package name",
+		"ny: duplicate name",
+		`ny: unknown IANA location "Mars/Olympus"`,
+		"type: name is not a valid lowercase package name",
+		"entry 5: missing name",
+		"nowhere: missing location or offset",
+	} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("Validate() error = %q, want it to contain %q", err, want)
+		}
+	}
+}
+
+func TestValidateDefinitionsAliases(t *testing.T) {
+	defs := []TimezoneDef{
+		{Name: "et", Location: "America/New_York", Aliases: []string{"eastern", "pt"}},
+		{Name: "pt", Location: "America/Los_Angeles", Aliases: []string{"Pacific"}},
+	}
+
+	err := Validate(defs)
+	if err == nil {
+		t.Fatal("Validate() expected error, got nil")
+	}
+	for _, want := range []string{
+		`pt: alias "Pacific" is not a valid lowercase package name`,
+		"pt: duplicate name",
+	} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("Validate() error = %q, want it to contain %q", err, want)
+		}
+	}
+	if strings.Contains(err.Error(), "eastern") {
+		t.Errorf("Validate() error = %q, alias eastern is valid", err)
+	}
+}
+
+func TestCheckCollisions(t *testing.T) {
+	reserved := []Reservation{
+		{Name: "ist", Location: "Asia/Kolkata", Reason: "IST also abbreviates Israel and Irish Standard Time"},
+		{Name: "bst", Reason: "British Summer Time or Bangladesh Standard Time"},
+	}
+	valid := []TimezoneDef{
+		{Name: "ist", Location: "Asia/Kolkata"},
+		{Name: "ilist", Location: "Asia/Jerusalem"},
+		{Name: "et", Location: "America/New_York", Aliases: []string{"est"}},
+	}
+	if err := CheckCollisions(valid, reserved); err != nil {
+		t.Errorf("CheckCollisions() error = %v", err)
+	}
+
+	invalid := []TimezoneDef{
+		{Name: "ist", Location: "Asia/Jerusalem"},
+		{Name: "gmt", Location: "Europe/London", Aliases: []string{"bst"}},
+		{Name: "ct", Location: "America/Chicago", Aliases: []string{"cst"}},
+	}
+	err := CheckCollisions(invalid, append(reserved, Reservation{Name: "ist"}, Reservation{Name: "Bad"}))
+	if err == nil {
+		t.Fatal("CheckCollisions() expected error, got nil")
+	}
+	for _, want := range []string{
+		`ist: name "ist" is reserved for Asia/Kolkata (IST also abbreviates Israel and Irish Standard Time); use a region-prefixed name such as "ilist"`,
+		`gmt: name "bst" is reserved (British Summer Time or Bangladesh Standard Time); use a region-prefixed name such as "gbbst"`,
+		`ct: name "cst" is an ambiguous abbreviation (`,
+		`UTC+08:00 in Asia/Macau and 2 others); reserve it for one location or use a region-prefixed name such as "uscst"`,
+		`reserved name "ist" is listed twice`,
+		`reserved name "Bad" is not a valid lowercase package name`,
+	} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("CheckCollisions() error = %q, want it to contain %q", err, want)
+		}
+	}
+}
+
+func TestConfigCollisions(t *testing.T) {
+	config, err := LoadConfig("../timezones.yaml")
+	if err != nil {
+		t.Fatalf("LoadConfig() error = %v", err)
+	}
+	if len(config.Reserved) == 0 {
+		t.Error("timezones.yaml reserves no names")
+	}
+	if err := CheckCollisions(config.Timezones, config.Reserved); err != nil {
+		t.Errorf("CheckCollisions(timezones.yaml) error = %v", err)
+	}
+}
+
+func TestRenderAlias(t *testing.T) {
+	data := TemplateData{
+		PackageName:       "eastern",
+		Location:          "America/New_York",
+		Description:       "Eastern Time",
+		Abbrev:            "EASTERN",
+		ImportPath:        generator.ModulePath + "/timezones/eastern",
+		AliasOf:           "et",
+		AliasOfImportPath: generator.ModulePath + "/timezones/et",
+	}
+
+	src, err := generator.renderFile("eastern.go", aliasTemplate, data)
+	if err != nil {
+		t.Fatalf("renderFile() error = %v", err)
+	}
+	for _, want := range []string{
+		"package eastern",
+		`"github.com/matthalp/go-meridian/v2/timezones/et"`,
+		"type Timezone = et.Timezone",
+		"type Time = et.Time",
+		"return et.FromMoment(m)",
+	} {
+		if !strings.Contains(string(src), want) {
+			t.Errorf("rendered alias package missing %q", want)
+		}
+	}
+}
+
+// generator renders packages as if into the meridian module.
+var generator = &Generator{ModulePath: "github.com/matthalp/go-meridian/v2"}
+
+var update = flag.Bool("update", false, "update golden files in testdata")
+
+// goldenDefinitions cover the template branches: UTC, a northern and a
+// southern hemisphere zone with daylight saving time, an alias, a fixed
+// offset, and the optional documentation fields.
+var goldenDefinitions = []TimezoneDef{
+	{Name: "utc", Location: "UTC", Description: "Coordinated Universal Time"},
+	{Name: "et", Location: "America/New_York", Description: "Eastern Time", Aliases: []string{"eastern"}},
+	{
+		Name:        "aest",
+		Location:    "Australia/Sydney",
+		Description: "Australian Eastern Time",
+		DSTNotes:    "Daylight saving time runs from October to April.\n",
+	},
+	{
+		Name:            "ist",
+		Location:        "Asia/Kolkata",
+		Description:     "India Standard Time",
+		LongDescription: "IST represents the Asia/Kolkata IANA timezone.\n\nIST is also used for Irish Standard Time.\n",
+		Examples:        []string{"noon := ist.Date(2024, time.June, 15, 12, 0, 0, 0)\nfmt.Println(noon.UTC())\n"},
+	},
+	{Name: "nst", Offset: "-03:30", Description: "Newfoundland Standard Time"},
+}
+
+func TestGolden(t *testing.T) {
+	files, err := generator.RenderRegistry("timezones", goldenDefinitions)
+	if err != nil {
+		t.Fatalf("RenderRegistry() error = %v", err)
+	}
+	for _, def := range goldenDefinitions {
+		rendered, err := generator.Render("timezones", def)
+		if err != nil {
+			t.Fatalf("Render(%s) error = %v", def.Name, err)
+		}
+		files = append(files, rendered...)
+	}
+
+	for _, f := range files {
+		golden := filepath.Join("testdata", f.Path+".golden")
+		if *update {
+			if err := os.MkdirAll(filepath.Dir(golden), 0o750); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.WriteFile(golden, f.Content, 0o600); err != nil {
+				t.Fatal(err)
+			}
+			continue
+		}
+
+		want, err := os.ReadFile(golden)
+		if err != nil {
+			t.Fatalf("%v (run go test -update to create golden files)", err)
+		}
+		if !bytes.Equal(f.Content, want) {
+			t.Errorf("%s does not match %s; run go test -update and review the diff", f.Path, golden)
+		}
+	}
+}
+
+func TestRenderDeterministic(t *testing.T) {
+	def := goldenDefinitions[1]
+	first, err := generator.Render("timezones", def)
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	second, err := generator.Render("timezones", def)
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+
+	if len(first) != len(second) {
+		t.Fatalf("Render() returned %d files, then %d", len(first), len(second))
+	}
+	for i := range first {
+		if first[i].Path != second[i].Path || !bytes.Equal(first[i].Content, second[i].Content) {
+			t.Errorf("Render() output for %s differs between runs", first[i].Path)
+		}
+	}
+}
+
+func TestParseOffset(t *testing.T) {
+	valid := map[string]int{
+		"+05:30": 19800,
+		"+0530":  19800,
+		"-03":    -10800,
+		"-03:30": -12600,
+		"+00:00": 0,
+		"+14:00": 50400,
+	}
+	for offset, want := range valid {
+		got, err := parseOffset(offset)
+		if err != nil || got != want {
+			t.Errorf("parseOffset(%q) = %d, %v, want %d", offset, got, err, want)
+		}
+	}
+
+	for _, offset := range []string{"", "05:30", "+5:30", "+05:3", "+05:60", "+15:00", "+14:30", "+ab:cd", "UTC"} {
+		if _, err := parseOffset(offset); err == nil {
+			t.Errorf("parseOffset(%q) expected error, got nil", offset)
+		}
+	}
+
+	if got := fixedZoneName(-12600); got != "UTC-03:30" {
+		t.Errorf("fixedZoneName(-12600) = %q, want %q", got, "UTC-03:30")
+	}
+}
+
+func TestValidateDefinitionsOffset(t *testing.T) {
+	defs := []TimezoneDef{
+		{Name: "ok", Offset: "+05:30"},
+		{Name: "both", Location: "UTC", Offset: "+01:00"},
+		{Name: "bad", Offset: "+25:00"},
+		{Name: "none"},
+	}
+
+	err := Validate(defs)
+	if err == nil {
+		t.Fatal("Validate() expected error, got nil")
+	}
+	for _, want := range []string{
+		"both: location and offset are mutually exclusive",
+		`bad: offset "+25:00" out of range`,
+		"none: missing location or offset",
+	} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("Validate() error = %q, want it to contain %q", err, want)
+		}
+	}
+	if strings.Contains(err.Error(), "ok:") {
+		t.Errorf("Validate() error = %q, ok is valid", err)
+	}
+}
+
+func TestValidateDefinitionsDocumentation(t *testing.T) {
+	defs := []TimezoneDef{
+		{Name: "ok", Location: "UTC", LongDescription: "Valid.", Examples: []string{"x := 1"}},
+		{Name: "long", Location: "UTC", LongDescription: "Ends early */"},
+		{Name: "notes", Location: "UTC", DSTNotes: "*/"},
+		{Name: "example", Location: "UTC", Examples: []string{"a := 1", "/* b */"}},
+	}
+
+	err := Validate(defs)
+	if err == nil {
+		t.Fatal("Validate() expected error, got nil")
+	}
+	for _, want := range []string{"long:", "notes:", "example:"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("Validate() error = %q, want it to contain %q", err, want)
+		}
+	}
+	if strings.Contains(err.Error(), "ok:") {
+		t.Errorf("Validate() error = %q, ok is valid", err)
+	}
+}
+
+func TestGeneratorImportPath(t *testing.T) {
+	g := &Generator{ModulePath: "example.com/plant", ModuleDir: "testdata"}
+
+	files, err := g.Render(filepath.Join("testdata", "zones"), TimezoneDef{Name: "floor", Offset: "+02:00", Description: "Plant Floor Time"})
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	var example []byte
+	for _, f := range files {
+		if filepath.Base(f.Path) == "example_test.go" {
+			example = f.Content
+		}
+		// Packages outside the meridian module cannot import its test kit.
+		if bytes.Contains(f.Content, []byte("internal/tztest")) {
+			t.Errorf("%s imports the internal test kit", f.Path)
+		}
+	}
+	if want := `"example.com/plant/zones/floor"`; !bytes.Contains(example, []byte(want)) {
+		t.Errorf("example_test.go does not import %s:\n%s", want, example)
+	}
+
+	if _, err := g.Render("zones", TimezoneDef{Name: "floor", Offset: "+02:00"}); err == nil {
+		t.Error("Render() outside ModuleDir expected error, got nil")
+	}
+	if _, err := (&Generator{}).Render("zones", TimezoneDef{Name: "floor", Offset: "+02:00"}); err == nil {
+		t.Error("Render() without ModulePath expected error, got nil")
+	}
+}
+
+func TestReadModulePath(t *testing.T) {
+	got, err := ReadModulePath("..")
+	if err != nil {
+		t.Fatalf("ReadModulePath() error = %v", err)
+	}
+	if got != DefaultMeridianPath {
+		t.Errorf("ReadModulePath() = %q, want %q", got, DefaultMeridianPath)
+	}
+
+	if _, err := ReadModulePath("testdata"); err == nil {
+		t.Error("ReadModulePath() without go.mod expected error, got nil")
+	}
+}
+
+func TestGeneratorMeridianPath(t *testing.T) {
+	g := &Generator{ModulePath: "example.com/fork", MeridianPath: "example.com/fork"}
+
+	files, err := g.Render("timezones", goldenDefinitions[1])
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	for _, f := range files {
+		if bytes.Contains(f.Content, []byte(DefaultMeridianPath)) {
+			t.Errorf("%s imports %s, want only example.com/fork", f.Path, DefaultMeridianPath)
+		}
+	}
+}
+
+func TestRenderRegistryReservedName(t *testing.T) {
+	defs := []TimezoneDef{{Name: "et", Location: "America/New_York", Aliases: []string{RegistryPackage}}}
+	if _, err := generator.RenderRegistry("timezones", defs); err == nil {
+		t.Error("RenderRegistry() with a zone named registry expected error, got nil")
+	}
+}
+
+func TestRenderAll(t *testing.T) {
+	var want []File
+	for _, def := range goldenDefinitions {
+		files, err := generator.Render("timezones", def)
+		if err != nil {
+			t.Fatalf("Render(%s) error = %v", def.Name, err)
+		}
+		want = append(want, files...)
+	}
+
+	g := *generator
+	g.Parallelism = 3
+	got, err := g.RenderAll("timezones", goldenDefinitions)
+	if err != nil {
+		t.Fatalf("RenderAll() error = %v", err)
+	}
+	if len(got) != len(want) {
+		t.Fatalf("RenderAll() returned %d files, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i].Path != want[i].Path || !bytes.Equal(got[i].Content, want[i].Content) {
+			t.Errorf("RenderAll() file %d = %s, want %s with the same content", i, got[i].Path, want[i].Path)
+		}
+	}
+}
+
+func TestRenderAllErrors(t *testing.T) {
+	defs := []TimezoneDef{
+		{Name: "utc", Location: "UTC"},
+		{Name: "mars", Location: "Mars/Olympus"},
+		{Name: "bad", Offset: "+99:00"},
+	}
+
+	_, err := generator.RenderAll("timezones", defs)
+	if err == nil {
+		t.Fatal("RenderAll() expected error, got nil")
+	}
+	for _, want := range []string{"failed to generate mars", "failed to generate bad"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("RenderAll() error = %q, want it to contain %q", err, want)
+		}
+	}
+}
+
+func TestBuildTags(t *testing.T) {
+	g := *generator
+	g.BuildTags = "meridian_all_zones || meridian_asia"
+
+	files, err := g.Render("timezones", goldenDefinitions[0])
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	want := generatedHeader + "//go:build meridian_all_zones || meridian_asia\n\n"
+	for _, f := range files {
+		if !bytes.HasPrefix(f.Content, []byte(want)) {
+			t.Errorf("%s does not start with %q", f.Path, want)
+		}
+	}
+
+	for _, tags := range []string{"a &&", "a\npackage b", "!"} {
+		g.BuildTags = tags
+		if _, err := g.Render("timezones", goldenDefinitions[0]); err == nil {
+			t.Errorf("Render() with build tags %q expected error, got nil", tags)
+		}
+	}
+}
+
+func TestRenderAbbreviationTable(t *testing.T) {
+	f, err := generator.RenderAbbreviationTable("tzabbrev/table.go", AbbreviationPackage)
+	if err != nil {
+		t.Fatalf("RenderAbbreviationTable() error = %v", err)
+	}
+	src := string(f.Content)
+	for _, want := range []string{
+		"package tzabbrev",
+		`"UTC":`,
+		`"America/Chicago", `,
+		`"Asia/Kolkata"`,
+	} {
+		if !strings.Contains(src, want) {
+			t.Errorf("rendered table missing %q", want)
+		}
+	}
+	// Numeric abbreviations and backward-compatibility links are left out.
+	for _, unwanted := range []string{`"+03"`, `"US/Central"`} {
+		if strings.Contains(src, unwanted) {
+			t.Errorf("rendered table contains %s", unwanted)
+		}
+	}
+}
@@ -0,0 +1,962 @@
+package gen
+
+import (
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+// templateFuncs are the functions available to the templates.
+var templateFuncs = template.FuncMap{
+	// indent prefixes each line of s with a tab, making it a code block in
+	// a doc comment.
+	"indent": func(s string) string {
+		return "\t" + strings.ReplaceAll(s, "\n", "\n\t")
+	},
+
+	// meridianImport returns the import spec for the meridian module at
+	// path, naming the import unless the path already implies the name
+	// meridian (as a fork's "example.com/fork" does not).
+	"meridianImport": func(path string) string {
+		elems := strings.Split(path, "/")
+		base := elems[len(elems)-1]
+		if len(elems) > 1 && strings.HasPrefix(base, "v") && strings.Trim(base[1:], "0123456789") == "" {
+			base = elems[len(elems)-2]
+		}
+		if strings.TrimPrefix(base, "go-") == "meridian" {
+			return strconv.Quote(path)
+		}
+		return "meridian " + strconv.Quote(path)
+	},
+}
+
+var packageTemplate = template.Must(template.New("package").Funcs(templateFuncs).Parse(`/*
+Package {{.PackageName}} provides {{.Description}} timezone support for meridian.
+{{if .LongDescription}}
+{{.LongDescription}}
+{{else if eq .PackageName "utc"}}
+{{.Abbrev}} ({{.Description}}) is the primary time standard by which the world
+regulates clocks and time. It is timezone-neutral and does not observe daylight
+saving time.
+{{else if .FixedOffset}}
+{{.Abbrev}} represents a fixed offset of {{.Location}} ({{.Description}}). The offset
+never changes; daylight saving time is not observed.
+{{else if .DaylightAbbrev}}
+{{.Abbrev}} represents the {{.Location}} IANA timezone, which observes {{.Description}}, alternating between {{.StandardAbbrev}} and {{.DaylightAbbrev}} with daylight saving time.
+{{else}}
+{{.Abbrev}} represents the {{.Location}} IANA timezone, which observes {{.Description}} year-round, without daylight saving time.
+{{end}}
+{{- if .DSTNotes}}
+# Daylight Saving Time
+
+{{.DSTNotes}}
+{{end}}
+# Usage
+
+Create {{.Abbrev}} times:
+
+	now := {{.PackageName}}.Now()
+{{if eq .PackageName "est"}}	meeting := {{.PackageName}}.Date(2024, time.December, 25, 9, 0, 0, 0)
+{{else if eq .PackageName "pst"}}	event := {{.PackageName}}.Date(2024, time.December, 25, 6, 0, 0, 0)
+{{else}}	specific := {{.PackageName}}.Date(2024, time.December, 25, 10, 30, 0, 0)
+{{end}}	parsed, _ := {{.PackageName}}.Parse(time.RFC3339, "2024-12-25T{{if eq .PackageName "est"}}09:00:00-05:00{{else if eq .PackageName "pst"}}06:00:00-08:00{{else}}10:30:00Z{{end}}")
+{{if eq .PackageName "utc"}}
+Convert to {{.Abbrev}} from other timezones:
+
+	eastern := est.Now()
+	universal := {{.PackageName}}.FromMoment(eastern)
+
+The {{.PackageName}}.Time type is an alias for meridian.Time[{{.PackageName}}.Timezone], providing
+compile-time timezone safety while maintaining compatibility with standard
+time.Time through the Moment interface.
+{{else}}
+Convert to {{.Abbrev}} from other timezones:
+
+{{if eq .PackageName "est"}}	pacific := pst.Now()
+	eastern := {{.PackageName}}.FromMoment(pacific)
+{{else}}	eastern := est.Now()
+	pacific := {{.PackageName}}.FromMoment(eastern)
+{{end}}
+Convert from standard time.Time:
+
+	stdTime := time.Now()
+	typedTime := {{.PackageName}}.FromMoment(stdTime)
+
+The {{.PackageName}}.Time type is an alias for meridian.Time[{{.PackageName}}.Timezone], providing
+compile-time timezone safety. Functions that accept {{.PackageName}}.Time can only receive
+times explicitly typed as {{.Description}}, preventing timezone confusion.
+{{end}}
+{{- if .Examples}}
+# Examples
+{{range .Examples}}
+{{indent .}}
+{{end}}
+{{- end}}*/
+package {{.PackageName}}
+
+import (
+{{- if not .FixedOffset}}
+	"fmt"
+	"sync/atomic"
+{{- end}}
+	"time"
+
+	{{meridianImport .MeridianPath}}
+)
+
+{{if .FixedOffset -}}
+// location is the fixed {{.Location}} offset, which never observes daylight saving time.
+var location = time.FixedZone("{{.Location}}", {{.OffsetSeconds}})
+
+// LoadLocation returns the fixed-offset location. It never fails; it exists so
+// that every timezone package offers the same accessor.
+func LoadLocation() (*time.Location, error) {
+	return location, nil
+}
+{{- else -}}
+// The IANA timezone location is loaded on first use rather than at package
+// initialization, so importing the package costs nothing until it is used.
+// It is loaded again after meridian.Reload installs new timezone data.
+var location atomic.Pointer[loadedLocation]
+
+// loadedLocation is the result of loading the location from the timezone
+// data of one meridian.TZDataGeneration.
+type loadedLocation struct {
+	loc        *time.Location
+	err        error
+	generation uint64
+}
+
+// LoadLocation returns the IANA timezone location, loading it on first use.
+// It fails only if the system's timezone database is missing or lacks the
+// zone; call it at startup to report that as an error rather than a panic.
+func LoadLocation() (*time.Location, error) {
+	generation := meridian.TZDataGeneration()
+	if l := location.Load(); l != nil && l.generation == generation {
+		return l.loc, l.err
+	}
+	l := &loadedLocation{generation: generation}
+	l.loc, l.err = meridian.LoadLocation("{{.Location}}")
+	if l.err != nil {
+		l.err = fmt.Errorf("failed to load timezone {{.Location}}: %w", l.err)
+	}
+	location.Store(l)
+	return l.loc, l.err
+}
+{{- end}}
+
+// Timezone represents the {{.Description}} timezone.
+type Timezone struct{}
+
+{{if .FixedOffset -}}
+// Abbreviations used by the {{.Location}} fixed-offset location, which
+// is named after its offset. Use them instead
+{{- else -}}
+// Abbreviations used by the {{.Location}} location, as recorded in the IANA
+// time zone database. Use them instead
+{{- end}} of hard-coding strings when displaying
+// or parsing zone abbreviations.
+const (
+	// StandardAbbrev is the abbreviation used during standard time.
+	StandardAbbrev = "{{.StandardAbbrev}}"
+
+	// DaylightAbbrev is the abbreviation used during daylight saving time,
+	// or the empty string if the timezone does not observe daylight saving time.
+	DaylightAbbrev = "{{.DaylightAbbrev}}"
+)
+
+{{if .FixedOffset -}}
+// Location returns the fixed-offset location.
+func (Timezone) Location() *time.Location {
+	return location
+}
+{{- else -}}
+// Location returns the IANA timezone location, loading it on first use.
+// It panics if the location cannot be loaded; see LoadLocation.
+func (Timezone) Location() *time.Location {
+	loc, err := LoadLocation()
+	if err != nil {
+		panic(err)
+	}
+	return loc
+}
+{{- end}}
+
+// Time is a convenience alias for meridian.Time[Timezone].
+type Time = meridian.Time[Timezone]
+
+// Now returns the current time in this timezone.
+func Now() Time {
+	return meridian.Now[Timezone]()
+}
+
+// Date creates a new time in this timezone with the specified date and time components.
+func Date(year int, month time.Month, day, hour, minute, sec, nsec int) Time {
+	return meridian.Date[Timezone](year, month, day, hour, minute, sec, nsec)
+}
+
+// FromMoment converts any Moment to {{.Abbrev}} time.
+func FromMoment(m meridian.Moment) Time {
+	return meridian.FromMoment[Timezone](m)
+}
+
+// Parse parses a formatted string and returns the time value it represents in {{.Abbrev}}.
+// The layout defines the format by showing how the reference time would be displayed.
+// The time is parsed in the {{.Location}} location.
+func Parse(layout, value string) (Time, error) {
+	return meridian.Parse[Timezone](layout, value)
+}
+
+// Unix returns the {{.Abbrev}} time corresponding to the given Unix time,
+// sec seconds and nsec nanoseconds since January 1, 1970 UTC.
+func Unix(sec, nsec int64) Time {
+	return meridian.Unix[Timezone](sec, nsec)
+}
+
+// UnixMilli returns the {{.Abbrev}} time corresponding to the given Unix time,
+// msec milliseconds since January 1, 1970 UTC.
+func UnixMilli(msec int64) Time {
+	return meridian.UnixMilli[Timezone](msec)
+}
+
+// UnixMicro returns the {{.Abbrev}} time corresponding to the given Unix time,
+// usec microseconds since January 1, 1970 UTC.
+func UnixMicro(usec int64) Time {
+	return meridian.UnixMicro[Timezone](usec)
+}
+`))
+
+var testTemplate = template.Must(template.New("test").Parse(`package {{.PackageName}}
+
+import (
+	"testing"
+
+	"{{.MeridianPath}}/internal/tztest"
+)
+
+func TestStandardSuite(t *testing.T) {
+	tztest.RunStandardSuite(t, Timezone{}, "{{.Location}}")
+}
+
+func TestAbbreviations(t *testing.T) {
+	tztest.CheckAbbreviations(t, Timezone{}, StandardAbbrev, DaylightAbbrev)
+}
+
+func TestLoadLocation(t *testing.T) {
+	loc, err := LoadLocation()
+	if err != nil {
+		t.Fatalf("LoadLocation() error = %v", err)
+	}
+	if loc != (Timezone{}).Location() {
+		t.Error("LoadLocation() and Timezone.Location() returned different locations")
+	}
+}
+
+func TestConstructors(t *testing.T) {
+	tztest.RunConstructorSuite(t, tztest.Constructors[Timezone]{
+		Now:        Now,
+		Date:       Date,
+		FromMoment: FromMoment,
+		Parse:      Parse,
+		Unix:       Unix,
+		UnixMilli:  UnixMilli,
+		UnixMicro:  UnixMicro,
+	})
+}
+`))
+
+// standaloneTestTemplate is used instead of testTemplate for packages
+// generated outside the meridian module, which cannot import its internal
+// test kit.
+var standaloneTestTemplate = template.Must(template.New("standaloneTest").Parse(`package {{.PackageName}}
+
+import (
+	"strings"
+	"testing"
+	"time"
+{{- if or (ne .PackageName "pt") (ne .PackageName "utc")}}
+
+{{- if ne .PackageName "pt"}}
+	"{{.MeridianPath}}/timezones/pt"
+{{- end}}
+{{- if ne .PackageName "utc"}}
+	"{{.MeridianPath}}/timezones/utc"
+{{- end}}
+{{- end}}
+)
+
+func Test{{.Abbrev}}Location(t *testing.T) {
+	var tz Timezone
+	loc := tz.Location()
+	if loc.String() != "{{.Location}}" {
+		t.Errorf("Timezone.Location() = %v, want {{.Location}}", loc.String())
+	}
+	if loaded, err := LoadLocation(); err != nil || loaded != loc {
+		t.Errorf("LoadLocation() = %v, %v, want %v, nil", loaded, err, loc)
+	}
+}
+
+func TestAbbreviations(t *testing.T) {
+	for _, month := range []time.Month{time.June, time.December} {
+		tzTime := Date(2025, month, 1, 12, 0, 0, 0)
+		name, _ := tzTime.Zone()
+
+		want := StandardAbbrev
+		if tzTime.IsDST() {
+			want = DaylightAbbrev
+		}
+		if name != want {
+			t.Errorf("Zone() in %v = %q, want %q", month, name, want)
+		}
+	}
+}
+
+func TestNow(t *testing.T) {
+	before := time.Now().UTC()
+	tzTime := Now()
+	after := time.Now().UTC()
+
+	// Parse back to verify it's within range
+	parsed, err := time.Parse(time.RFC3339, tzTime.Format(time.RFC3339))
+	if err != nil {
+		t.Fatalf("Failed to parse result: %v", err)
+	}
+
+	if parsed.Before(before.Add(-time.Second)) || parsed.After(after.Add(time.Second)) {
+		t.Errorf("Now() returned time outside expected range: got %v, expected between %v and %v", parsed, before, after)
+	}
+}
+
+func TestDate(t *testing.T) {
+	// Create a time: Jan 15, 2024 at noon {{.Abbrev}}
+	tzTime := Date(2024, time.January, 15, 12, 0, 0, 0)
+
+	// Format should show the time in {{.Abbrev}}
+	result := tzTime.Format("15:04 MST")
+
+	// January 15 is during winter, so should show standard time abbreviation
+	// The IANA database provides timezone-specific abbreviations (EST, PST, etc.)
+	// We just verify it contains the expected hour
+	if !strings.Contains(result, "12:00") {
+		t.Errorf("Format() = %q, expected to contain 12:00", result)
+	}
+}
+
+func TestDateWithOffset(t *testing.T) {
+	// Create a time in {{.Abbrev}} (UTC offset varies by timezone and DST)
+	// Noon {{.Abbrev}} should have corresponding UTC offset
+	tzTime := Date(2024, time.January, 1, 12, 0, 0, 0)
+
+	// Parse the formatted time and convert to UTC to verify
+	parsed, err := time.Parse(time.RFC3339, tzTime.Format(time.RFC3339))
+	if err != nil {
+		t.Fatalf("Failed to parse result: %v", err)
+	}
+	utcTime := parsed.UTC()
+
+	// Verify that the hour in {{.Abbrev}} location is 12
+	locationTime := utcTime.In(Timezone{}.Location())
+	if locationTime.Hour() != 12 {
+		t.Errorf("Date() hour in {{.Abbrev}} = %v, want 12", locationTime.Hour())
+	}
+}
+
+func TestFromMoment(t *testing.T) {
+	t.Run("from time.Time", func(t *testing.T) {
+		// Test converting from standard time.Time in UTC
+		stdTime := time.Date(2024, time.January, 15, 17, 0, 0, 0, time.UTC)
+		{{.PackageName}}Time := FromMoment(stdTime)
+
+		// Verify the conversion - should represent same moment
+		if !{{.PackageName}}Time.UTC().Equal(stdTime) {
+			t.Errorf("FromMoment(time.Time) UTC = %v, want %v", {{.PackageName}}Time.UTC(), stdTime)
+		}
+	})
+
+{{- if ne .PackageName "utc"}}
+
+	t.Run("from UTC", func(t *testing.T) {
+		// Create 17:00 UTC
+		utcTime := utc.Date(2024, time.January, 15, 17, 0, 0, 0)
+
+		// Convert to {{.Abbrev}}
+		{{.PackageName}}Time := FromMoment(utcTime)
+
+		// Verify same moment in time
+		if !{{.PackageName}}Time.UTC().Equal(utcTime.UTC()) {
+			t.Error("Converted time doesn't represent same moment")
+		}
+	})
+{{- end}}
+{{- if ne .PackageName "pt"}}
+
+	t.Run("from PT", func(t *testing.T) {
+		// Create 9:00 PT
+		ptTime := pt.Date(2024, time.January, 15, 9, 0, 0, 0)
+
+		// Convert to {{.Abbrev}}
+		{{.PackageName}}Time := FromMoment(ptTime)
+
+		// Verify same moment in time
+		if !{{.PackageName}}Time.UTC().Equal(ptTime.UTC()) {
+			t.Error("Converted time doesn't represent same moment")
+		}
+	})
+{{- end}}
+
+{{- if ne .PackageName "utc"}}
+
+	t.Run("round trip conversion", func(t *testing.T) {
+		// Create time in {{.Abbrev}}
+		original := Date(2024, time.January, 15, 14, 30, 0, 0)
+
+		// Convert to UTC and back
+		viaUTC := FromMoment(utc.FromMoment(original))
+
+		// Should represent the same moment
+		if !viaUTC.UTC().Equal(original.UTC()) {
+			t.Error("Round trip conversion changed the moment in time")
+		}
+
+		// Should format the same
+		if viaUTC.Format(time.RFC3339) != original.Format(time.RFC3339) {
+			t.Errorf("Round trip format = %q, want %q",
+				viaUTC.Format(time.RFC3339), original.Format(time.RFC3339))
+		}
+	})
+{{- end}}
+}
+
+func TestParse(t *testing.T) {
+	t.Run("RFC3339 format", func(t *testing.T) {
+		// Parse a time string without timezone, should be interpreted as {{.Abbrev}}
+		parsed, err := Parse("2006-01-02 15:04:05", "2024-01-15 12:00:00")
+		if err != nil {
+			t.Fatalf("Parse() error = %v", err)
+		}
+
+		// Should be interpreted as 12:00 {{.Abbrev}}
+		expected := Date(2024, time.January, 15, 12, 0, 0, 0)
+		if parsed.Format(time.RFC3339) != expected.Format(time.RFC3339) {
+			t.Errorf("Parse() = %v, want %v", parsed.Format(time.RFC3339), expected.Format(time.RFC3339))
+		}
+	})
+
+{{- if .DiffersFromUTC}}
+
+	t.Run("timezone specific interpretation", func(t *testing.T) {
+		// Parse same clock time in {{.Abbrev}} during summer (July) to ensure DST offset
+		{{.PackageName}}Parsed, err := Parse("2006-01-02 15:04:05", "2024-07-15 12:00:00")
+		if err != nil {
+			t.Fatalf("Parse() error = %v", err)
+		}
+
+		// Same clock time parsed in UTC
+		utcParsed, err := utc.Parse("2006-01-02 15:04:05", "2024-07-15 12:00:00")
+		if err != nil {
+			t.Fatalf("utc.Parse() error = %v", err)
+		}
+
+		// During summer, most timezones have DST offset from UTC, so they should represent different moments
+		// For timezones without DST (like some Asian/African zones), this may still pass if offset != 0
+		if {{.PackageName}}Parsed.UTC().Equal(utcParsed.UTC()) {
+			t.Error("{{.Abbrev}} and UTC parse of same clock time should be different moments")
+		}
+	})
+{{- end}}
+
+	t.Run("invalid format", func(t *testing.T) {
+		_, err := Parse(time.RFC3339, "invalid-time-string")
+		if err == nil {
+			t.Error("Parse() expected error for invalid input, got nil")
+		}
+	})
+}
+
+func TestUnix(t *testing.T) {
+	t.Run("epoch", func(t *testing.T) {
+		epoch := Unix(0, 0)
+		
+		// But UTC should be epoch
+		if !epoch.UTC().Equal(time.Unix(0, 0)) {
+			t.Error("Unix(0, 0) UTC time should be epoch")
+		}
+	})
+
+	t.Run("known timestamp", func(t *testing.T) {
+		// 2024-01-15 12:00:00 UTC
+		result := Unix(1705320000, 0)
+		
+		// Verify UTC equivalence
+		if !result.UTC().Equal(time.Unix(1705320000, 0)) {
+			t.Error("Unix timestamp doesn't match")
+		}
+	})
+}
+
+func TestUnixMilli(t *testing.T) {
+	t.Run("known millisecond timestamp", func(t *testing.T) {
+		// 2024-01-15 12:00:00.000 UTC
+		msec := int64(1705320000000)
+		result := UnixMilli(msec)
+		
+		// Verify UTC equivalence
+		if !result.UTC().Equal(time.UnixMilli(msec)) {
+			t.Error("UnixMilli UTC time doesn't match")
+		}
+	})
+
+	t.Run("with milliseconds precision", func(t *testing.T) {
+		msec := int64(1705320000123)
+		result := UnixMilli(msec)
+		if !result.UTC().Equal(time.UnixMilli(msec)) {
+			t.Errorf("UnixMilli precision mismatch")
+		}
+	})
+}
+
+func TestUnixMicro(t *testing.T) {
+	t.Run("known microsecond timestamp", func(t *testing.T) {
+		// 2024-01-15 12:00:00.000000 UTC
+		usec := int64(1705320000000000)
+		result := UnixMicro(usec)
+		
+		// Verify UTC equivalence
+		if !result.UTC().Equal(time.UnixMicro(usec)) {
+			t.Error("UnixMicro UTC time doesn't match")
+		}
+	})
+
+	t.Run("with microseconds precision", func(t *testing.T) {
+		usec := int64(1705320000123456)
+		result := UnixMicro(usec)
+		if !result.UTC().Equal(time.UnixMicro(usec)) {
+			t.Errorf("UnixMicro precision mismatch")
+		}
+	})
+}
+`))
+
+var exampleTemplate = template.Must(template.New("example").Parse(`package {{.PackageName}}_test
+
+import (
+	"fmt"
+	"time"
+
+	"{{.ImportPath}}"
+)
+
+func ExampleNow() {
+	now := {{.PackageName}}.Now()
+
+	// The current time varies, but its location is always {{.Location}}.
+	fmt.Println(now.Location())
+	// Output: {{.Location}}
+}
+
+func ExampleDate() {
+	// Date components are interpreted in {{.Location}}.
+	t := {{.PackageName}}.Date(2024, time.December, 25, 9, 0, 0, 0)
+
+	fmt.Println(t.Format(time.RFC3339))
+	fmt.Println(t.UTC().Format(time.RFC3339))
+	// Output:
+	// {{.ExampleDate}}
+	// {{.ExampleDateUTC}}
+}
+
+func ExampleFromMoment() {
+	// Any Moment, such as a standard time.Time, can be converted to {{.Abbrev}}.
+	// The instant is preserved; only the timezone type changes.
+	moment := time.Date(2024, time.June, 15, 12, 0, 0, 0, time.UTC)
+	t := {{.PackageName}}.FromMoment(moment)
+
+	fmt.Println(t.Format("2006-01-02 15:04 MST"))
+	// Output: {{.ExampleFromMoment}}
+}
+`))
+
+var benchTemplate = template.Must(template.New("bench").Parse(`package {{.PackageName}}
+
+import (
+	"testing"
+	"time"
+)
+
+// Sinks keep the compiler from optimizing away benchmarked calls.
+var (
+	benchTime   Time
+	benchString string
+)
+
+func BenchmarkNow(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		benchTime = Now()
+	}
+}
+
+func BenchmarkDate(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		benchTime = Date(2024, time.July, 15, 12, 30, 0, 0)
+	}
+}
+
+func BenchmarkFormat(b *testing.B) {
+	t := Date(2024, time.July, 15, 12, 30, 0, 0)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchString = t.Format(time.RFC3339)
+	}
+}
+
+func BenchmarkFromMoment(b *testing.B) {
+	moment := time.Date(2024, time.July, 15, 12, 30, 0, 0, time.UTC)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchTime = FromMoment(moment)
+	}
+}
+`))
+
+var aliasTemplate = template.Must(template.New("alias").Funcs(templateFuncs).Parse(`// Package {{.PackageName}} is an alias of package {{.AliasOf}}, providing
+// {{.Description}} timezone support for meridian under the name {{.PackageName}}.
+//
+// The types are aliases of the {{.AliasOf}} types, so {{.PackageName}}.Time and
+// {{.AliasOf}}.Time are interchangeable and values can be passed between code
+// that uses either package without conversion.
+package {{.PackageName}}
+
+import (
+	"time"
+
+	{{meridianImport .MeridianPath}}
+	"{{.AliasOfImportPath}}"
+)
+
+// Timezone represents the {{.Description}} timezone. It is an alias of {{.AliasOf}}.Timezone.
+type Timezone = {{.AliasOf}}.Timezone
+
+// Time is a convenience alias for meridian.Time[Timezone].
+type Time = {{.AliasOf}}.Time
+
+// LoadLocation returns the {{.Location}} location. See {{.AliasOf}}.LoadLocation.
+func LoadLocation() (*time.Location, error) {
+	return {{.AliasOf}}.LoadLocation()
+}
+
+// Abbreviations used by the {{.Location}} location. See {{.AliasOf}}.StandardAbbrev.
+const (
+	StandardAbbrev = {{.AliasOf}}.StandardAbbrev
+	DaylightAbbrev = {{.AliasOf}}.DaylightAbbrev
+)
+
+// Now returns the current time in this timezone.
+func Now() Time {
+	return {{.AliasOf}}.Now()
+}
+
+// Date creates a new time in this timezone with the specified date and time components.
+func Date(year int, month time.Month, day, hour, minute, sec, nsec int) Time {
+	return {{.AliasOf}}.Date(year, month, day, hour, minute, sec, nsec)
+}
+
+// FromMoment converts any Moment to {{.Abbrev}} time.
+func FromMoment(m meridian.Moment) Time {
+	return {{.AliasOf}}.FromMoment(m)
+}
+
+// Parse parses a formatted string and returns the time value it represents in {{.Abbrev}}.
+// The layout defines the format by showing how the reference time would be displayed.
+// The time is parsed in the {{.Location}} location.
+func Parse(layout, value string) (Time, error) {
+	return {{.AliasOf}}.Parse(layout, value)
+}
+
+// Unix returns the {{.Abbrev}} time corresponding to the given Unix time,
+// sec seconds and nsec nanoseconds since January 1, 1970 UTC.
+func Unix(sec, nsec int64) Time {
+	return {{.AliasOf}}.Unix(sec, nsec)
+}
+
+// UnixMilli returns the {{.Abbrev}} time corresponding to the given Unix time,
+// msec milliseconds since January 1, 1970 UTC.
+func UnixMilli(msec int64) Time {
+	return {{.AliasOf}}.UnixMilli(msec)
+}
+
+// UnixMicro returns the {{.Abbrev}} time corresponding to the given Unix time,
+// usec microseconds since January 1, 1970 UTC.
+func UnixMicro(usec int64) Time {
+	return {{.AliasOf}}.UnixMicro(usec)
+}
+`))
+
+var aliasTestTemplate = template.Must(template.New("aliasTest").Parse(`package {{.PackageName}}
+
+import (
+	"testing"
+	"time"
+
+	"{{.AliasOfImportPath}}"
+)
+
+func TestAliasInterchangeable(t *testing.T) {
+	// Values are interchangeable with the canonical package without conversion.
+	var canonical {{.AliasOf}}.Time = Date(2024, time.January, 15, 12, 0, 0, 0)
+	var alias Time = {{.AliasOf}}.Date(2024, time.January, 15, 12, 0, 0, 0)
+
+	if !alias.Equal(canonical) {
+		t.Errorf("Date() = %v, want %v", alias, canonical)
+	}
+	if loc := alias.Location().String(); loc != "{{.Location}}" {
+		t.Errorf("Location() = %v, want {{.Location}}", loc)
+	}
+}
+
+func TestAliasFunctions(t *testing.T) {
+	moment := time.Date(2024, time.July, 15, 12, 0, 0, 0, time.UTC)
+	want := {{.AliasOf}}.FromMoment(moment)
+
+	if got := FromMoment(moment); !got.Equal(want) {
+		t.Errorf("FromMoment() = %v, want %v", got, want)
+	}
+	if got := Unix(moment.Unix(), 0); !got.Equal(want) {
+		t.Errorf("Unix() = %v, want %v", got, want)
+	}
+	if got := UnixMilli(moment.UnixMilli()); !got.Equal(want) {
+		t.Errorf("UnixMilli() = %v, want %v", got, want)
+	}
+	if got := UnixMicro(moment.UnixMicro()); !got.Equal(want) {
+		t.Errorf("UnixMicro() = %v, want %v", got, want)
+	}
+	if got, err := Parse(time.RFC3339, moment.Format(time.RFC3339)); err != nil || !got.Equal(want) {
+		t.Errorf("Parse() = %v, %v, want %v", got, err, want)
+	}
+	if got := Now(); got.Location() != want.Location() {
+		t.Errorf("Now().Location() = %v, want %v", got.Location(), want.Location())
+	}
+}
+`))
+
+var registryTemplate = template.Must(template.New("registry").Funcs(templateFuncs).Parse(`/*
+Package registry maps IANA location names and time zone abbreviations to the
+generated timezone packages, for zones that are only known at runtime:
+
+	zone, ok := registry.Lookup(user.Timezone) // e.g. "America/Chicago"
+	if !ok {
+		return fmt.Errorf("unsupported timezone %q", user.Timezone)
+	}
+	local := zone.FromMoment(time.Now())
+
+The values returned by a Zone's functions hold the zone's typed time, such as
+ct.Time, which can be recovered with a type assertion.
+*/
+package {{.PackageName}}
+
+import (
+	"time"
+
+	{{meridianImport .MeridianPath}}
+{{- range .Zones}}
+	"{{.ImportPath}}"
+{{- end}}
+)
+
+// Time is the method set shared by the typed times of every zone, such as
+// et.Time and utc.Time.
+type Time interface {
+	meridian.Moment
+	Format(layout string) string
+	String() string
+	Location() *time.Location
+	Time() time.Time
+}
+
+// Zone describes a generated timezone package and dispatches into it.
+type Zone struct {
+	Name           string // package name, such as "ct"
+	Location       string // location name, such as "America/Chicago"
+	Description    string // human-readable name, such as "Central Time"
+	StandardAbbrev string // abbreviation during standard time
+	DaylightAbbrev string // abbreviation during daylight saving time, or ""
+
+	// FromMoment converts m to the zone's typed time.
+	FromMoment func(m meridian.Moment) Time
+
+	// Parse parses a formatted string into the zone's typed time, as the
+	// package's Parse function does.
+	Parse func(layout, value string) (Time, error)
+
+	// Calendar returns the meridian.Calendar in the zone with the given
+	// opening hours and weekend days, for combining with the calendars of
+	// other zones, as by meridian.CommonWorkingHours.
+	Calendar func(open, closing time.Duration, weekend []time.Weekday) meridian.WorkingHours
+}
+
+// zones lists the generated packages in definition order.
+var zones = []Zone{
+{{- range .Zones}}
+	{
+		Name:           "{{.PackageName}}",
+		Location:       {{printf "%q" .Location}},
+		Description:    {{printf "%q" .Description}},
+		StandardAbbrev: {{.PackageName}}.StandardAbbrev,
+		DaylightAbbrev: {{.PackageName}}.DaylightAbbrev,
+		FromMoment: func(m meridian.Moment) Time {
+			return {{.PackageName}}.FromMoment(m)
+		},
+		Parse: func(layout, value string) (Time, error) {
+			t, err := {{.PackageName}}.Parse(layout, value)
+			if err != nil {
+				return nil, err
+			}
+			return t, nil
+		},
+		Calendar: func(open, closing time.Duration, weekend []time.Weekday) meridian.WorkingHours {
+			return meridian.Calendar[{{.PackageName}}.Timezone]{Open: open, Close: closing, Weekend: weekend}
+		},
+	},
+{{- end}}
+}
+
+// Zones returns every registered zone in definition order.
+func Zones() []Zone {
+	return append([]Zone(nil), zones...)
+}
+
+// Lookup returns the zone with the given package name, such as "ct", or
+// location name, such as "America/Chicago". When several packages share a
+// location, the first in definition order is returned.
+func Lookup(name string) (Zone, bool) {
+	for _, z := range zones {
+		if z.Name == name {
+			return z, true
+		}
+	}
+	for _, z := range zones {
+		if z.Location == name {
+			return z, true
+		}
+	}
+	return Zone{}, false
+}
+
+// LookupAbbrev returns the zones that use abbrev, such as "CST", during
+// standard or daylight saving time. Abbreviations are ambiguous, so several
+// zones may match; they are returned in definition order.
+func LookupAbbrev(abbrev string) []Zone {
+	var matches []Zone
+	for _, z := range zones {
+		if z.StandardAbbrev == abbrev || (z.DaylightAbbrev != "" && z.DaylightAbbrev == abbrev) {
+			matches = append(matches, z)
+		}
+	}
+	return matches
+}
+`))
+
+var registryTestTemplate = template.Must(template.New("registryTest").Funcs(templateFuncs).Parse(`package {{.PackageName}}
+
+import (
+	"testing"
+	"time"
+
+	{{meridianImport .MeridianPath}}
+)
+
+// utcZone is UTC, for comparing the calendars of the registered zones.
+type utcZone struct{}
+
+func (utcZone) Location() *time.Location { return time.UTC }
+
+func TestLookup(t *testing.T) {
+	for _, want := range Zones() {
+		z, ok := Lookup(want.Name)
+		if !ok || z.Name != want.Name {
+			t.Errorf("Lookup(%q) = %q, %v, want %q", want.Name, z.Name, ok, want.Name)
+		}
+
+		z, ok = Lookup(want.Location)
+		if !ok || z.Location != want.Location {
+			t.Errorf("Lookup(%q) = %q, %v, want location %q", want.Location, z.Location, ok, want.Location)
+		}
+	}
+
+	if _, ok := Lookup("Mars/Olympus_Mons"); ok {
+		t.Error("Lookup(\"Mars/Olympus_Mons\") ok = true, want false")
+	}
+}
+
+func TestLookupAbbrev(t *testing.T) {
+	for _, want := range Zones() {
+		found := false
+		for _, z := range LookupAbbrev(want.StandardAbbrev) {
+			if z.Name == want.Name {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("LookupAbbrev(%q) does not include %s", want.StandardAbbrev, want.Name)
+		}
+	}
+
+	if zones := LookupAbbrev(""); len(zones) != 0 {
+		t.Errorf("LookupAbbrev(\"\") returned %d zones, want none", len(zones))
+	}
+}
+
+func TestZoneFunctions(t *testing.T) {
+	instant := time.Date(2024, time.June, 15, 12, 0, 0, 0, time.UTC)
+
+	for _, z := range Zones() {
+		converted := z.FromMoment(instant)
+		if !converted.UTC().Equal(instant) {
+			t.Errorf("%s: FromMoment() = %v, want %v", z.Name, converted.UTC(), instant)
+		}
+		if got := converted.Location().String(); got != z.Location {
+			t.Errorf("%s: FromMoment() location = %q, want %q", z.Name, got, z.Location)
+		}
+
+		parsed, err := z.Parse(time.RFC3339, "2024-06-15T12:00:00Z")
+		if err != nil {
+			t.Errorf("%s: Parse() error = %v", z.Name, err)
+		} else if !parsed.UTC().Equal(instant) {
+			t.Errorf("%s: Parse() = %v, want %v", z.Name, parsed.UTC(), instant)
+		}
+
+		if parsed, err := z.Parse(time.RFC3339, "invalid"); err == nil || parsed != nil {
+			t.Errorf("%s: Parse(invalid) = %v, %v, want nil and an error", z.Name, parsed, err)
+		}
+
+		window := meridian.Interval[utcZone]{
+			Start: meridian.FromMoment[utcZone](instant),
+			End:   meridian.FromMoment[utcZone](instant.Add(time.Hour)),
+		}
+		if open := meridian.CommonWorkingHours(window, z.Calendar(0, 24*time.Hour, []time.Weekday{})); len(open) != 1 || open[0].Duration() != time.Hour {
+			t.Errorf("%s: CommonWorkingHours() with an all-day Calendar() = %v, want %v", z.Name, open, window)
+		}
+		weekend := []time.Weekday{time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday}
+		if open := meridian.CommonWorkingHours(window, z.Calendar(0, 24*time.Hour, weekend)); len(open) != 0 {
+			t.Errorf("%s: CommonWorkingHours() with an all-weekend Calendar() = %v, want none", z.Name, open)
+		}
+	}
+}
+`))
+
+var abbreviationTableTemplate = template.Must(template.New("abbreviationTable").Parse(`package {{.PackageName}}
+
+// abbreviationYear is the year whose rules the table reflects.
+const abbreviationYear = {{.Year}}
+
+// abbreviations maps each time zone abbreviation to the IANA locations that
+// use it, in alphabetical order.
+var abbreviations = map[string][]string{
+{{- range .Abbrevs}}
+	"{{.Abbrev}}": { {{- range $i, $loc := .Locations}}{{if $i}}, {{end}}"{{$loc}}"{{end -}} },
+{{- end}}
+}
+`))
@@ -0,0 +1,139 @@
+package gen
+
+import (
+	"archive/zip"
+	"fmt"
+	"go/build"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// IANADefinitions returns a definition for every zone in the IANA time zone
+// database.
+func IANADefinitions() ([]TimezoneDef, error) {
+	locations, err := ianaLocations()
+	if err != nil {
+		return nil, err
+	}
+
+	defs := make([]TimezoneDef, 0, len(locations))
+	seen := make(map[string]string, len(locations))
+	for _, loc := range locations {
+		name := IANAPackageName(loc)
+		if other, ok := seen[name]; ok {
+			return nil, fmt.Errorf("locations %s and %s both map to package %s", other, loc, name)
+		}
+		seen[name] = loc
+		defs = append(defs, TimezoneDef{Name: name, Location: loc, Description: loc})
+	}
+
+	return defs, nil
+}
+
+// ianaLocations returns the sorted names of all zones in the time zone
+// database.
+func ianaLocations() ([]string, error) {
+	db, err := zoneDatabase()
+	if err != nil {
+		return nil, err
+	}
+
+	locations := make([]string, 0, len(db))
+	for name := range db {
+		// "Factory" is a placeholder zone, not a real location.
+		if name != "Factory" {
+			locations = append(locations, name)
+		}
+	}
+	sort.Strings(locations)
+
+	return locations, nil
+}
+
+// loadLocation loads the named location from the time zone database. Unlike
+// time.LoadLocation it never consults the host's zoneinfo files, so generated
+// output does not vary between machines.
+func loadLocation(name string) (*time.Location, error) {
+	db, err := zoneDatabase()
+	if err != nil {
+		return nil, err
+	}
+	data, ok := db[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown time zone %s", name)
+	}
+	return time.LoadLocationFromTZData(name, data)
+}
+
+var (
+	zoneDatabaseOnce sync.Once
+	zoneDatabaseData map[string][]byte
+	zoneDatabaseErr  error
+)
+
+// zoneDatabase returns the contents of the time zone database shipped with
+// the Go toolchain, or of the zip file named by the ZONEINFO environment
+// variable if it is set (as with time.LoadLocation), keyed by zone name.
+func zoneDatabase() (map[string][]byte, error) {
+	zoneDatabaseOnce.Do(func() {
+		zoneDatabaseData, zoneDatabaseErr = readZoneDatabase()
+	})
+	return zoneDatabaseData, zoneDatabaseErr
+}
+
+func readZoneDatabase() (map[string][]byte, error) {
+	path := os.Getenv("ZONEINFO")
+	if path == "" {
+		path = filepath.Join(build.Default.GOROOT, "lib", "time", "zoneinfo.zip")
+	}
+
+	r, err := zip.OpenReader(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open time zone database: %w", err)
+	}
+	defer r.Close()
+
+	db := make(map[string][]byte, len(r.File))
+	for _, f := range r.File {
+		if strings.HasSuffix(f.Name, "/") {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s from time zone database: %w", f.Name, err)
+		}
+		data, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s from time zone database: %w", f.Name, err)
+		}
+		db[f.Name] = data
+	}
+
+	return db, nil
+}
+
+// IANAPackageName derives a package name from an IANA location name, for
+// example America/Port-au-Prince becomes america_port_au_prince and
+// Etc/GMT+5 becomes etc_gmt_plus_5.
+func IANAPackageName(location string) string {
+	var b strings.Builder
+	for i := 0; i < len(location); i++ {
+		switch c := location[i]; {
+		case c == '+':
+			b.WriteString("_plus_")
+		case c == '-' && i+1 < len(location) && '0' <= location[i+1] && location[i+1] <= '9':
+			b.WriteString("_minus_")
+		case c == '/' || c == '-':
+			b.WriteByte('_')
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return strings.ToLower(b.String())
+}
@@ -2,4 +2,10 @@ module github.com/matthalp/go-meridian/v2
 
 go 1.20
 
-require gopkg.in/yaml.v3 v3.0.1
+require (
+	golang.org/x/mod v0.20.0
+	golang.org/x/tools v0.24.0
+	gopkg.in/yaml.v3 v3.0.1
+)
+
+require golang.org/x/sync v0.8.0 // indirect
@@ -0,0 +1,16 @@
+module github.com/matthalp/go-meridian/v2/gormtype
+
+go 1.20
+
+require (
+	github.com/matthalp/go-meridian/v2 v2.0.0
+	gorm.io/gorm v1.31.1
+)
+
+require (
+	github.com/jinzhu/inflection v1.0.0 // indirect
+	github.com/jinzhu/now v1.1.5 // indirect
+	golang.org/x/text v0.20.0 // indirect
+)
+
+replace github.com/matthalp/go-meridian/v2 => ../
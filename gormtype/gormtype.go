@@ -0,0 +1,138 @@
+/*
+Package gormtype integrates meridian typed times with GORM (gorm.io/gorm).
+
+Two integration styles are provided. The Time wrapper declares its column
+type to GORM, so auto-migration creates a timezone-safe column on every
+supported dialect:
+
+	type Order struct {
+		ID        uint
+		PlacedAt  gormtype.Time[utc.Timezone]
+		ShipsAt   gormtype.Time[et.Timezone]
+	}
+
+Alternatively, existing meridian.Time[TZ] fields can opt in to the "meridian"
+serializer with a struct tag, without changing their type:
+
+	type Order struct {
+		ID       uint
+		PlacedAt utc.Time `gorm:"serializer:meridian"`
+	}
+
+In both cases values are written as UTC and read back into the field's
+timezone type. Because the stored value is an absolute instant, DST
+transitions in the field's timezone cannot shift or duplicate stored times.
+For MySQL, configure the driver with parseTime=true and loc=UTC so DATETIME
+columns round-trip as UTC.
+*/
+package gormtype
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+
+	"github.com/matthalp/go-meridian/v2"
+)
+
+// SerializerName is the name under which Serializer is registered with GORM.
+// Use it in struct tags as `gorm:"serializer:meridian"`.
+const SerializerName = "meridian"
+
+func init() {
+	schema.RegisterSerializer(SerializerName, Serializer{})
+}
+
+// Compile-time interface assertions.
+var (
+	_ schema.GormDataTypeInterface = Time[meridian.Timezone]{}
+	_ driver.Valuer                = Time[meridian.Timezone]{}
+	_ sql.Scanner                  = (*Time[meridian.Timezone])(nil)
+	_ schema.SerializerInterface   = Serializer{}
+)
+
+// Time is a meridian.Time[TZ] that reports its column type to GORM.
+// The embedded Time exposes the full meridian.Time[TZ] API, and its
+// driver.Valuer and sql.Scanner implementations store the value as UTC.
+type Time[TZ meridian.Timezone] struct {
+	meridian.Time[TZ]
+}
+
+// GormDataType returns the general GORM data type for the field.
+// It implements the schema.GormDataTypeInterface interface.
+func (Time[TZ]) GormDataType() string {
+	return string(schema.Time)
+}
+
+// GormDBDataType returns the dialect-specific column type used by
+// auto-migration: timestamptz on PostgreSQL, datetimeoffset on SQL Server,
+// DATETIME on MySQL (microsecond precision unless the field sets one), and
+// datetime on SQLite. Other dialects fall back to their default time type.
+func (Time[TZ]) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	switch db.Dialector.Name() {
+	case "postgres":
+		return "timestamptz"
+	case "sqlserver":
+		return "datetimeoffset"
+	case "mysql":
+		precision := field.Precision
+		if precision == 0 {
+			precision = 6
+		}
+		return fmt.Sprintf("DATETIME(%d)", precision)
+	case "sqlite":
+		return "datetime"
+	default:
+		return ""
+	}
+}
+
+// Serializer is a GORM serializer for meridian.Time[TZ] fields (and pointers
+// to them). It stores the zero time as NULL unless the column is NOT NULL,
+// and scans NULL back into the zero time.
+type Serializer struct{}
+
+// Scan implements the schema.SerializerInterface interface.
+func (Serializer) Scan(ctx context.Context, field *schema.Field, dst reflect.Value, dbValue interface{}) error {
+	fieldValue := reflect.New(field.FieldType)
+
+	if dbValue != nil {
+		target := fieldValue
+		if field.FieldType.Kind() == reflect.Ptr {
+			fieldValue.Elem().Set(reflect.New(field.FieldType.Elem()))
+			target = fieldValue.Elem()
+		}
+
+		scanner, ok := target.Interface().(sql.Scanner)
+		if !ok {
+			return fmt.Errorf("invalid field type %s for meridian serializer: must implement sql.Scanner, as meridian.Time and meridian.Null do", field.FieldType)
+		}
+		if err := scanner.Scan(dbValue); err != nil {
+			return err
+		}
+	}
+
+	field.ReflectValueOf(ctx, dst).Set(fieldValue.Elem())
+	return nil
+}
+
+// Value implements the schema.SerializerValuerInterface interface.
+func (Serializer) Value(_ context.Context, field *schema.Field, _ reflect.Value, fieldValue interface{}) (interface{}, error) {
+	if rv := reflect.ValueOf(fieldValue); !rv.IsValid() || (rv.Kind() == reflect.Ptr && rv.IsNil()) {
+		return nil, nil
+	}
+
+	valuer, ok := fieldValue.(driver.Valuer)
+	if !ok {
+		return nil, fmt.Errorf("invalid field type %T for meridian serializer: must implement driver.Valuer, as meridian.Time and meridian.Null do", fieldValue)
+	}
+	if z, ok := fieldValue.(interface{ IsZero() bool }); ok && z.IsZero() && !field.NotNull {
+		return nil, nil
+	}
+	return valuer.Value()
+}
@@ -0,0 +1,205 @@
+package gormtype
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+	"gorm.io/gorm/utils/tests"
+
+	"github.com/matthalp/go-meridian/v2/timezones/et"
+	"github.com/matthalp/go-meridian/v2/timezones/utc"
+)
+
+// namedDialector is a dialector stub that reports a configurable name.
+type namedDialector struct {
+	tests.DummyDialector
+	name string
+}
+
+func (d namedDialector) Name() string {
+	return d.name
+}
+
+type order struct {
+	ID        uint
+	PlacedAt  Time[utc.Timezone]
+	ShipsAt   Time[et.Timezone] `gorm:"precision:3"`
+	DueAt     utc.Time          `gorm:"serializer:meridian"`
+	ClosedAt  *et.Time          `gorm:"serializer:meridian"`
+	CreatedAt utc.Time          `gorm:"serializer:meridian;not null"`
+}
+
+func parseField(t *testing.T, name string) *schema.Field {
+	t.Helper()
+	s, err := schema.Parse(&order{}, &sync.Map{}, schema.NamingStrategy{})
+	if err != nil {
+		t.Fatalf("schema.Parse() error = %v", err)
+	}
+	field := s.LookUpField(name)
+	if field == nil {
+		t.Fatalf("field %s not found", name)
+	}
+	return field
+}
+
+func TestGormDataType(t *testing.T) {
+	field := parseField(t, "PlacedAt")
+	if field.DataType != schema.Time {
+		t.Errorf("DataType = %q, want %q", field.DataType, schema.Time)
+	}
+}
+
+func TestGormDBDataType(t *testing.T) {
+	tests := []struct {
+		dialect  string
+		field    string
+		expected string
+	}{
+		{dialect: "postgres", field: "PlacedAt", expected: "timestamptz"},
+		{dialect: "sqlserver", field: "PlacedAt", expected: "datetimeoffset"},
+		{dialect: "mysql", field: "PlacedAt", expected: "DATETIME(6)"},
+		{dialect: "mysql", field: "ShipsAt", expected: "DATETIME(3)"},
+		{dialect: "sqlite", field: "PlacedAt", expected: "datetime"},
+		{dialect: "clickhouse", field: "PlacedAt", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.dialect+"/"+tt.field, func(t *testing.T) {
+			db, err := gorm.Open(namedDialector{name: tt.dialect}, &gorm.Config{})
+			if err != nil {
+				t.Fatalf("gorm.Open() error = %v", err)
+			}
+
+			got := Time[utc.Timezone]{}.GormDBDataType(db, parseField(t, tt.field))
+			if got != tt.expected {
+				t.Errorf("GormDBDataType() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestTimeValueAndScan(t *testing.T) {
+	original := Time[et.Timezone]{et.Date(2024, time.March, 10, 3, 30, 0, 0)}
+
+	value, err := original.Value()
+	if err != nil {
+		t.Fatalf("Value() error = %v", err)
+	}
+	stored, ok := value.(time.Time)
+	if !ok {
+		t.Fatalf("Value() returned %T, want time.Time", value)
+	}
+	if stored.Location() != time.UTC {
+		t.Errorf("Value() location = %v, want UTC", stored.Location())
+	}
+
+	var scanned Time[et.Timezone]
+	if err := scanned.Scan(stored); err != nil {
+		t.Fatalf("Scan() error = %v", err)
+	}
+	if !scanned.Equal(original) {
+		t.Errorf("Scan() = %v, want %v", scanned, original)
+	}
+	if scanned.Hour() != 3 {
+		t.Errorf("Hour() = %d, want 3 (EDT)", scanned.Hour())
+	}
+}
+
+func TestSerializerScan(t *testing.T) {
+	ctx := context.Background()
+	stored := time.Date(2024, time.June, 15, 14, 30, 0, 0, time.UTC)
+
+	var o order
+	dst := reflect.ValueOf(&o).Elem()
+
+	if err := (Serializer{}).Scan(ctx, parseField(t, "DueAt"), dst, stored); err != nil {
+		t.Fatalf("Scan(DueAt) error = %v", err)
+	}
+	if !o.DueAt.Equal(stored) {
+		t.Errorf("DueAt = %v, want %v", o.DueAt, stored)
+	}
+
+	if err := (Serializer{}).Scan(ctx, parseField(t, "ClosedAt"), dst, stored); err != nil {
+		t.Fatalf("Scan(ClosedAt) error = %v", err)
+	}
+	if o.ClosedAt == nil || !o.ClosedAt.Equal(stored) {
+		t.Errorf("ClosedAt = %v, want %v", o.ClosedAt, stored)
+	}
+	if o.ClosedAt.Hour() != 10 {
+		t.Errorf("ClosedAt.Hour() = %d, want 10 (EDT)", o.ClosedAt.Hour())
+	}
+
+	// NULL scans back into the zero value.
+	if err := (Serializer{}).Scan(ctx, parseField(t, "DueAt"), dst, nil); err != nil {
+		t.Fatalf("Scan(DueAt, nil) error = %v", err)
+	}
+	if !o.DueAt.IsZero() {
+		t.Errorf("DueAt = %v, want zero", o.DueAt)
+	}
+	if err := (Serializer{}).Scan(ctx, parseField(t, "ClosedAt"), dst, nil); err != nil {
+		t.Fatalf("Scan(ClosedAt, nil) error = %v", err)
+	}
+	if o.ClosedAt != nil {
+		t.Errorf("ClosedAt = %v, want nil", o.ClosedAt)
+	}
+}
+
+func TestSerializerScanInvalid(t *testing.T) {
+	var o order
+	err := (Serializer{}).Scan(context.Background(), parseField(t, "DueAt"), reflect.ValueOf(&o).Elem(), 42)
+	if err == nil {
+		t.Error("Scan() expected error for unsupported database value, got nil")
+	}
+}
+
+func TestSerializerValue(t *testing.T) {
+	ctx := context.Background()
+	due := utc.Date(2024, time.June, 15, 14, 30, 0, 0)
+	closed := et.FromMoment(due)
+
+	tests := []struct {
+		name     string
+		field    string
+		value    interface{}
+		expected interface{}
+	}{
+		{name: "value", field: "DueAt", value: due, expected: due.UTC()},
+		{name: "pointer", field: "ClosedAt", value: &closed, expected: due.UTC()},
+		{name: "zero is NULL", field: "DueAt", value: utc.Time{}, expected: nil},
+		{name: "nil pointer is NULL", field: "ClosedAt", value: (*et.Time)(nil), expected: nil},
+		{name: "zero in NOT NULL column", field: "CreatedAt", value: utc.Time{}, expected: time.Time{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := (Serializer{}).Value(ctx, parseField(t, tt.field), reflect.Value{}, tt.value)
+			if err != nil {
+				t.Fatalf("Value() error = %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("Value() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSerializerValueInvalid(t *testing.T) {
+	_, err := (Serializer{}).Value(context.Background(), parseField(t, "DueAt"), reflect.Value{}, "2024-06-15")
+	if err == nil {
+		t.Error("Value() expected error for unsupported field value, got nil")
+	}
+}
+
+func TestSerializerRegistered(t *testing.T) {
+	if _, ok := schema.GetSerializer(SerializerName); !ok {
+		t.Errorf("serializer %q is not registered", SerializerName)
+	}
+	if field := parseField(t, "DueAt"); field.Serializer == nil {
+		t.Error("DueAt field does not use the meridian serializer")
+	}
+}
@@ -0,0 +1,380 @@
+/*
+Package grpctime converts between google.protobuf.Timestamp and meridian
+typed times at gRPC service boundaries, so handlers work with Time[TZ]
+values and the conversion happens in one place.
+
+FromTimestamp and ToTimestamp convert single values. Decode and Encode copy
+whole messages to and from plain Go structs, converting every Timestamp
+field into a typed time in the timezone of the struct field:
+
+	type CreateEventRequest struct {
+		Name      string
+		StartsAt  et.Time
+		EndsAt    *et.Time
+		Reminders []utc.Time `proto:"reminder_times"`
+	}
+
+	var req CreateEventRequest
+	if err := grpctime.Decode(pbReq, &req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+Handler wraps such a function into a gRPC method implementation, and
+UnaryServerInterceptor and StreamServerInterceptor reject messages holding
+invalid timestamps before any handler sees them:
+
+	grpc.NewServer(
+		grpc.ChainUnaryInterceptor(grpctime.UnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(grpctime.StreamServerInterceptor()),
+	)
+*/
+package grpctime
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/matthalp/go-meridian/v2"
+)
+
+// timestampName is the full name of the google.protobuf.Timestamp message.
+const timestampName protoreflect.FullName = "google.protobuf.Timestamp"
+
+// FromTimestamp returns the instant ts in the specified timezone. A nil ts,
+// as for an unset field, returns the zero time. It fails if ts is invalid:
+// outside the years 1 to 9999, or with nanos out of range.
+func FromTimestamp[TZ meridian.Timezone](ts *timestamppb.Timestamp) (meridian.Time[TZ], error) {
+	if ts == nil {
+		return meridian.Time[TZ]{}, nil
+	}
+	if err := ts.CheckValid(); err != nil {
+		return meridian.Time[TZ]{}, err
+	}
+	return meridian.FromMoment[TZ](ts.AsTime()), nil
+}
+
+// ToTimestamp returns m as a Timestamp. The zero time returns nil, so it
+// leaves the field unset. The parameter m can be any Moment (time.Time or
+// Time[TZ]).
+func ToTimestamp(m meridian.Moment) *timestamppb.Timestamp {
+	t := m.UTC()
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+// Handler adapts fn, which takes and returns plain Go structs, into a gRPC
+// method implementation. The request message is copied into an In with
+// Decode, and the Out that fn returns is copied into a new response message
+// with Encode. A request that cannot be decoded fails with
+// codes.InvalidArgument without calling fn.
+func Handler[Req, Resp proto.Message, In, Out any](fn func(context.Context, In) (Out, error)) func(context.Context, Req) (Resp, error) {
+	return func(ctx context.Context, req Req) (Resp, error) {
+		var zero Resp
+		var in In
+		if err := Decode(req, &in); err != nil {
+			return zero, status.Error(codes.InvalidArgument, err.Error())
+		}
+		out, err := fn(ctx, in)
+		if err != nil {
+			return zero, err
+		}
+		resp := zero.ProtoReflect().Type().New().Interface().(Resp)
+		if err := Encode(&out, resp); err != nil {
+			return zero, status.Error(codes.Internal, err.Error())
+		}
+		return resp, nil
+	}
+}
+
+// Decode copies the fields of msg into the exported fields of the struct
+// that dst points to. Each struct field is filled from the message field
+// named by its proto tag, or else from the field whose name matches the
+// struct field's name ignoring case and underscores, so StartsAt is filled
+// from starts_at. Struct fields tagged "-" or without a matching message
+// field are left alone.
+//
+// Timestamp fields are converted into Time[TZ], *Time[TZ], Null[TZ], or
+// time.Time struct fields; an unset Timestamp leaves the zero value, a nil
+// pointer, or an invalid Null. Repeated fields fill slices. Other fields are
+// copied into struct fields of a convertible type, such as an int64 for an
+// int64 field or the generated type of a nested message.
+func Decode(msg proto.Message, dst any) error {
+	v := reflect.ValueOf(dst)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("cannot decode into %T: want a pointer to a struct", dst)
+	}
+	m := msg.ProtoReflect()
+	return eachField(v.Elem(), m.Descriptor(), func(field reflect.Value, fd protoreflect.FieldDescriptor) error {
+		switch {
+		case fd.IsMap():
+			return fmt.Errorf("map fields are not supported")
+		case fd.IsList():
+			if field.Kind() != reflect.Slice {
+				return fmt.Errorf("cannot decode repeated field into %s", field.Type())
+			}
+			list := m.Get(fd).List()
+			slice := reflect.MakeSlice(field.Type(), list.Len(), list.Len())
+			for i := 0; i < list.Len(); i++ {
+				if err := decodeValue(slice.Index(i), list.Get(i), fd); err != nil {
+					return err
+				}
+			}
+			field.Set(slice)
+			return nil
+		case fd.Message() != nil && !m.Has(fd):
+			field.Set(reflect.Zero(field.Type()))
+			return nil
+		}
+		return decodeValue(field, m.Get(fd), fd)
+	})
+}
+
+// Encode copies the exported fields of the struct that src points to into
+// msg, matching fields as Decode does. Typed times and time.Time values are
+// stored as Timestamps; the zero time, a nil pointer, and an invalid Null
+// leave the Timestamp unset.
+func Encode(src any, msg proto.Message) error {
+	v := reflect.ValueOf(src)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("cannot encode from %T: want a pointer to a struct", src)
+	}
+	m := msg.ProtoReflect()
+	return eachField(v.Elem(), m.Descriptor(), func(field reflect.Value, fd protoreflect.FieldDescriptor) error {
+		switch {
+		case fd.IsMap():
+			return fmt.Errorf("map fields are not supported")
+		case fd.IsList():
+			if field.Kind() != reflect.Slice {
+				return fmt.Errorf("cannot encode %s into repeated field", field.Type())
+			}
+			m.Clear(fd)
+			if field.Len() == 0 {
+				return nil
+			}
+			list := m.Mutable(fd).List()
+			for i := 0; i < field.Len(); i++ {
+				elem, set, err := encodeValue(field.Index(i), fd, list.NewElement)
+				if err != nil {
+					return err
+				}
+				if !set {
+					return fmt.Errorf("cannot encode an unset element into a repeated field")
+				}
+				list.Append(elem)
+			}
+			return nil
+		}
+		value, set, err := encodeValue(field, fd, func() protoreflect.Value { return m.NewField(fd) })
+		if err != nil {
+			return err
+		}
+		if !set {
+			m.Clear(fd)
+			return nil
+		}
+		m.Set(fd, value)
+		return nil
+	})
+}
+
+// eachField calls fn with each exported field of the struct v and the
+// message field it corresponds to. Errors are prefixed with the message
+// field's name.
+func eachField(v reflect.Value, md protoreflect.MessageDescriptor, fn func(reflect.Value, protoreflect.FieldDescriptor) error) error {
+	t := v.Type()
+	fields := md.Fields()
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+		var fd protoreflect.FieldDescriptor
+		switch tag := sf.Tag.Get("proto"); tag {
+		case "-":
+			continue
+		case "":
+			want := normalize(sf.Name)
+			for j := 0; j < fields.Len(); j++ {
+				if normalize(string(fields.Get(j).Name())) == want {
+					fd = fields.Get(j)
+					break
+				}
+			}
+			if fd == nil {
+				continue
+			}
+		default:
+			if fd = fields.ByName(protoreflect.Name(tag)); fd == nil {
+				return fmt.Errorf("%s has no field %s", md.FullName(), tag)
+			}
+		}
+		if err := fn(v.Field(i), fd); err != nil {
+			return fmt.Errorf("field %s: %w", fd.Name(), err)
+		}
+	}
+	return nil
+}
+
+// normalize returns name in lower case without underscores.
+func normalize(name string) string {
+	return strings.ToLower(strings.ReplaceAll(name, "_", ""))
+}
+
+// decodeValue stores the message field value pv in v.
+func decodeValue(v reflect.Value, pv protoreflect.Value, fd protoreflect.FieldDescriptor) error {
+	if fd.Message() != nil && fd.Message().FullName() == timestampName {
+		t, err := readTimestamp(pv.Message())
+		if err != nil {
+			return err
+		}
+		return setTime(v, t)
+	}
+
+	x := reflect.ValueOf(pv.Interface())
+	if fd.Message() != nil {
+		x = reflect.ValueOf(pv.Message().Interface())
+	}
+	if !convertible(x.Type(), v.Type()) {
+		return fmt.Errorf("cannot decode %s field into %s", fd.Kind(), v.Type())
+	}
+	v.Set(x.Convert(v.Type()))
+	return nil
+}
+
+// encodeValue returns v as a value of the message field fd. It reports
+// false if v is an unset time or nil message, which leaves the field unset.
+// newMessage returns an empty message for fd.
+func encodeValue(v reflect.Value, fd protoreflect.FieldDescriptor, newMessage func() protoreflect.Value) (protoreflect.Value, bool, error) {
+	if fd.Message() != nil && fd.Message().FullName() == timestampName {
+		t, set, err := timeOf(v)
+		if err != nil || !set {
+			return protoreflect.Value{}, false, err
+		}
+		pv := newMessage()
+		if err := writeTimestamp(pv.Message(), t); err != nil {
+			return protoreflect.Value{}, false, err
+		}
+		return pv, true, nil
+	}
+
+	if fd.Message() != nil {
+		if (v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface) && v.IsNil() {
+			return protoreflect.Value{}, false, nil
+		}
+		pm, ok := v.Interface().(proto.Message)
+		if !ok || pm.ProtoReflect().Descriptor().FullName() != fd.Message().FullName() {
+			return protoreflect.Value{}, false, fmt.Errorf("cannot encode %s into %s field", v.Type(), fd.Message().FullName())
+		}
+		return protoreflect.ValueOfMessage(pm.ProtoReflect()), true, nil
+	}
+
+	want := reflect.TypeOf(fd.Default().Interface())
+	if !convertible(v.Type(), want) {
+		return protoreflect.Value{}, false, fmt.Errorf("cannot encode %s into %s field", v.Type(), fd.Kind())
+	}
+	return protoreflect.ValueOf(v.Convert(want).Interface()), true, nil
+}
+
+// convertible reports whether values of type from can be converted to type
+// to without reinterpreting them, as converting an integer to a string
+// would.
+func convertible(from, to reflect.Type) bool {
+	if !from.ConvertibleTo(to) {
+		return false
+	}
+	isString := func(t reflect.Type) bool { return t.Kind() == reflect.String }
+	return isString(from) == isString(to)
+}
+
+// readTimestamp returns the instant held by a google.protobuf.Timestamp.
+func readTimestamp(m protoreflect.Message) (time.Time, error) {
+	fields := m.Descriptor().Fields()
+	ts := &timestamppb.Timestamp{
+		Seconds: m.Get(fields.ByName("seconds")).Int(),
+		Nanos:   int32(m.Get(fields.ByName("nanos")).Int()),
+	}
+	if err := ts.CheckValid(); err != nil {
+		return time.Time{}, err
+	}
+	return ts.AsTime(), nil
+}
+
+// writeTimestamp stores t in a google.protobuf.Timestamp.
+func writeTimestamp(m protoreflect.Message, t time.Time) error {
+	ts := timestamppb.New(t)
+	if err := ts.CheckValid(); err != nil {
+		return err
+	}
+	fields := m.Descriptor().Fields()
+	m.Set(fields.ByName("seconds"), protoreflect.ValueOfInt64(ts.Seconds))
+	m.Set(fields.ByName("nanos"), protoreflect.ValueOfInt32(ts.Nanos))
+	return nil
+}
+
+// setTime stores t in v, which is a time.Time, a type whose Scan method
+// accepts a time.Time, such as Time[TZ] and Null[TZ], or a pointer to one.
+func setTime(v reflect.Value, t time.Time) error {
+	if v.Kind() == reflect.Pointer {
+		elem := reflect.New(v.Type().Elem())
+		if err := setTime(elem.Elem(), t); err != nil {
+			return err
+		}
+		v.Set(elem)
+		return nil
+	}
+	if v.Type() == reflect.TypeOf(time.Time{}) {
+		v.Set(reflect.ValueOf(t))
+		return nil
+	}
+	scanner, ok := v.Addr().Interface().(sql.Scanner)
+	if !ok {
+		return fmt.Errorf("cannot decode a timestamp into %s", v.Type())
+	}
+	return scanner.Scan(t)
+}
+
+// timeOf returns the time held by v, which is of a type accepted by
+// setTime. It reports false for the zero time, a nil pointer, or an
+// invalid Null.
+func timeOf(v reflect.Value) (time.Time, bool, error) {
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return time.Time{}, false, nil
+		}
+		v = v.Elem()
+	}
+	var t time.Time
+	switch x := v.Interface().(type) {
+	case time.Time:
+		t = x
+	case driver.Valuer:
+		value, err := x.Value()
+		if err != nil {
+			return time.Time{}, false, err
+		}
+		if value == nil {
+			return time.Time{}, false, nil
+		}
+		vt, ok := value.(time.Time)
+		if !ok {
+			return time.Time{}, false, fmt.Errorf("cannot encode %s into a timestamp", v.Type())
+		}
+		t = vt
+	default:
+		return time.Time{}, false, fmt.Errorf("cannot encode %s into a timestamp", v.Type())
+	}
+	return t, !t.IsZero(), nil
+}
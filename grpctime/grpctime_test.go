@@ -0,0 +1,243 @@
+package grpctime
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/matthalp/go-meridian/v2"
+	"github.com/matthalp/go-meridian/v2/timezones/et"
+	"github.com/matthalp/go-meridian/v2/timezones/utc"
+)
+
+// eventType is the type of a test message:
+//
+//	message Event {
+//		string name = 1;
+//		google.protobuf.Timestamp starts_at = 2;
+//		google.protobuf.Timestamp ends_at = 3;
+//		repeated google.protobuf.Timestamp reminder_times = 4;
+//		int32 attendees = 5;
+//		Event parent = 6;
+//	}
+var eventType = func() protoreflect.MessageType {
+	optional := descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum()
+	message := descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum()
+	file, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:       proto.String("grpctime_test.proto"),
+		Package:    proto.String("grpctime.test"),
+		Syntax:     proto.String("proto3"),
+		Dependency: []string{"google/protobuf/timestamp.proto"},
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name: proto.String("Event"),
+			Field: []*descriptorpb.FieldDescriptorProto{
+				{Name: proto.String("name"), Number: proto.Int32(1), Label: optional, Type: descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum()},
+				{Name: proto.String("starts_at"), Number: proto.Int32(2), Label: optional, Type: message, TypeName: proto.String(".google.protobuf.Timestamp")},
+				{Name: proto.String("ends_at"), Number: proto.Int32(3), Label: optional, Type: message, TypeName: proto.String(".google.protobuf.Timestamp")},
+				{Name: proto.String("reminder_times"), Number: proto.Int32(4), Label: descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum(), Type: message, TypeName: proto.String(".google.protobuf.Timestamp")},
+				{Name: proto.String("attendees"), Number: proto.Int32(5), Label: optional, Type: descriptorpb.FieldDescriptorProto_TYPE_INT32.Enum()},
+				{Name: proto.String("parent"), Number: proto.Int32(6), Label: optional, Type: message, TypeName: proto.String(".grpctime.test.Event")},
+			},
+		}},
+	}, protoregistry.GlobalFiles)
+	if err != nil {
+		panic(err)
+	}
+	return dynamicpb.NewMessageType(file.Messages().Get(0))
+}()
+
+// newEvent returns an Event with the given fields set.
+func newEvent(fields map[string]any) protoreflect.Message {
+	m := eventType.New()
+	for name, value := range fields {
+		fd := m.Descriptor().Fields().ByName(protoreflect.Name(name))
+		switch v := value.(type) {
+		case *timestamppb.Timestamp:
+			ts := m.NewField(fd).Message()
+			ts.Set(ts.Descriptor().Fields().ByName("seconds"), protoreflect.ValueOfInt64(v.Seconds))
+			ts.Set(ts.Descriptor().Fields().ByName("nanos"), protoreflect.ValueOfInt32(v.Nanos))
+			m.Set(fd, protoreflect.ValueOfMessage(ts))
+		case protoreflect.Message:
+			m.Set(fd, protoreflect.ValueOfMessage(v))
+		default:
+			m.Set(fd, protoreflect.ValueOf(v))
+		}
+	}
+	return m
+}
+
+type event struct {
+	Name      string
+	StartsAt  et.Time
+	EndsAt    *et.Time
+	Reminders []utc.Time `proto:"reminder_times"`
+	Attendees int
+	Ignored   string `proto:"-"`
+}
+
+func TestTimestampConversion(t *testing.T) {
+	want := et.Date(2024, time.March, 10, 9, 30, 0, 500)
+	ts := ToTimestamp(want)
+	if ts.Seconds != want.Unix() || ts.Nanos != 500 {
+		t.Errorf("ToTimestamp() = %v", ts)
+	}
+	got, err := FromTimestamp[et.Timezone](ts)
+	if err != nil || !got.Equal(want) {
+		t.Errorf("FromTimestamp() = %v, %v, want %v", got, err, want)
+	}
+
+	if ToTimestamp(utc.Time{}) != nil {
+		t.Error("ToTimestamp(zero) != nil")
+	}
+	if got, err := FromTimestamp[utc.Timezone](nil); err != nil || !got.IsZero() {
+		t.Errorf("FromTimestamp(nil) = %v, %v, want zero time", got, err)
+	}
+	if _, err := FromTimestamp[utc.Timezone](&timestamppb.Timestamp{Nanos: -1}); err == nil {
+		t.Error("FromTimestamp(invalid) succeeded, want error")
+	}
+}
+
+func TestDecode(t *testing.T) {
+	starts := time.Date(2024, time.March, 10, 13, 30, 0, 0, time.UTC)
+	msg := newEvent(map[string]any{
+		"name":      "standup",
+		"starts_at": timestamppb.New(starts),
+		"attendees": int32(12),
+	})
+	list := msg.Mutable(msg.Descriptor().Fields().ByName("reminder_times")).List()
+	for _, d := range []time.Duration{-time.Hour, -5 * time.Minute} {
+		ts := list.NewElement()
+		ts.Message().Set(ts.Message().Descriptor().Fields().ByName("seconds"), protoreflect.ValueOfInt64(starts.Add(d).Unix()))
+		list.Append(ts)
+	}
+
+	ends := et.Now()
+	got := event{EndsAt: &ends, Ignored: "kept"}
+	if err := Decode(msg.Interface(), &got); err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+	if got.Name != "standup" || got.Attendees != 12 || got.Ignored != "kept" {
+		t.Errorf("Decode() = %+v", got)
+	}
+	if !got.StartsAt.Equal(starts) || got.StartsAt.Hour() != 9 {
+		t.Errorf("StartsAt = %v, want 09:30 EDT", got.StartsAt)
+	}
+	if got.EndsAt != nil {
+		t.Errorf("EndsAt = %v, want nil for an unset field", got.EndsAt)
+	}
+	if len(got.Reminders) != 2 || !got.Reminders[1].Equal(starts.Add(-5*time.Minute)) {
+		t.Errorf("Reminders = %v", got.Reminders)
+	}
+}
+
+func TestDecodeNullAndTime(t *testing.T) {
+	starts := time.Date(2024, time.March, 10, 13, 30, 0, 0, time.UTC)
+	msg := newEvent(map[string]any{"starts_at": timestamppb.New(starts)})
+
+	var got struct {
+		StartsAt meridian.Null[et.Timezone]
+		EndsAt   meridian.Null[et.Timezone]
+		Starts   time.Time `proto:"starts_at"`
+	}
+	if err := Decode(msg.Interface(), &got); err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+	if !got.StartsAt.Valid || !got.StartsAt.Time.Equal(starts) {
+		t.Errorf("StartsAt = %+v, want valid %v", got.StartsAt, starts)
+	}
+	if got.EndsAt.Valid {
+		t.Errorf("EndsAt = %+v, want invalid", got.EndsAt)
+	}
+	if !got.Starts.Equal(starts) {
+		t.Errorf("Starts = %v, want %v", got.Starts, starts)
+	}
+}
+
+func TestDecodeErrors(t *testing.T) {
+	msg := newEvent(map[string]any{"starts_at": &timestamppb.Timestamp{Seconds: -1 << 40}})
+	var got event
+	if err := Decode(msg.Interface(), &got); err == nil || !strings.Contains(err.Error(), "starts_at") {
+		t.Errorf("Decode(invalid timestamp) error = %v, want one naming starts_at", err)
+	}
+
+	var wrongType struct{ Name int }
+	if err := Decode(newEvent(map[string]any{"name": "x"}).Interface(), &wrongType); err == nil {
+		t.Error("Decode(string into int) succeeded, want error")
+	}
+
+	var unknown struct {
+		X string `proto:"missing"`
+	}
+	if err := Decode(newEvent(nil).Interface(), &unknown); err == nil {
+		t.Error("Decode(unknown tag) succeeded, want error")
+	}
+	if err := Decode(newEvent(nil).Interface(), got); err == nil {
+		t.Error("Decode(non-pointer) succeeded, want error")
+	}
+}
+
+func TestEncode(t *testing.T) {
+	starts := et.Date(2024, time.March, 10, 9, 30, 0, 0)
+	src := event{
+		Name:      "standup",
+		StartsAt:  starts,
+		Reminders: []utc.Time{utc.FromMoment(starts.Add(-time.Hour))},
+		Attendees: 3,
+	}
+	msg := newEvent(map[string]any{"ends_at": timestamppb.Now()})
+	if err := Encode(&src, msg.Interface()); err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+
+	var back event
+	if err := Decode(msg.Interface(), &back); err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+	if back.Name != src.Name || back.Attendees != 3 || !back.StartsAt.Equal(starts) {
+		t.Errorf("round trip = %+v, want %+v", back, src)
+	}
+	if back.EndsAt != nil {
+		t.Errorf("EndsAt = %v, want nil pointer to clear the field", back.EndsAt)
+	}
+	if len(back.Reminders) != 1 || !back.Reminders[0].Equal(starts.Add(-time.Hour)) {
+		t.Errorf("Reminders = %v", back.Reminders)
+	}
+}
+
+func TestHandler(t *testing.T) {
+	type in struct{ Seconds int64 }
+	type out struct {
+		Seconds int64
+		Nanos   int32
+	}
+	h := Handler[*timestamppb.Timestamp, *durationpb.Duration](func(ctx context.Context, req in) (out, error) {
+		return out{Seconds: req.Seconds * 2, Nanos: 5}, nil
+	})
+	resp, err := h(context.Background(), &timestamppb.Timestamp{Seconds: 21})
+	if err != nil {
+		t.Fatalf("handler error = %v", err)
+	}
+	if resp.Seconds != 42 || resp.Nanos != 5 {
+		t.Errorf("handler response = %v, want 42s 5ns", resp)
+	}
+
+	bad := Handler[*timestamppb.Timestamp, *durationpb.Duration](func(ctx context.Context, req struct{ Seconds string }) (out, error) {
+		t.Error("handler called for a request that cannot be decoded")
+		return out{}, nil
+	})
+	if _, err := bad(context.Background(), &timestamppb.Timestamp{}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("handler error = %v, want InvalidArgument", err)
+	}
+}
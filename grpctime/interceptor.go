@@ -0,0 +1,114 @@
+package grpctime
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// UnaryServerInterceptor returns an interceptor that rejects a request with
+// codes.InvalidArgument if it holds an invalid Timestamp, at any depth, so
+// handlers can convert every timestamp they receive without checking for
+// errors. Responses are checked too: an invalid Timestamp in a response is
+// reported as codes.Internal instead of being sent.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := checkMessage(req); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return resp, err
+		}
+		if err := checkMessage(resp); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		return resp, nil
+	}
+}
+
+// StreamServerInterceptor returns an interceptor that checks each message
+// received and sent on a stream as UnaryServerInterceptor does.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, checkedStream{ss})
+	}
+}
+
+// checkedStream checks the timestamps of the messages on a stream.
+type checkedStream struct {
+	grpc.ServerStream
+}
+
+func (s checkedStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if err := checkMessage(m); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return nil
+}
+
+func (s checkedStream) SendMsg(m any) error {
+	if err := checkMessage(m); err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	return s.ServerStream.SendMsg(m)
+}
+
+// checkMessage returns an error naming the first invalid Timestamp in m,
+// if m is a protobuf message.
+func checkMessage(m any) error {
+	msg, ok := m.(proto.Message)
+	if !ok {
+		return nil
+	}
+	return checkTimestamps(msg.ProtoReflect(), "")
+}
+
+// checkTimestamps checks every Timestamp set in m and its nested messages.
+// The path names m in errors.
+func checkTimestamps(m protoreflect.Message, path string) error {
+	if m.Descriptor().FullName() == timestampName {
+		if _, err := readTimestamp(m); err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		return nil
+	}
+
+	var err error
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		name := string(fd.Name())
+		if path != "" {
+			name = path + "." + name
+		}
+		switch {
+		case fd.IsMap():
+			if fd.MapValue().Message() == nil {
+				return true
+			}
+			v.Map().Range(func(k protoreflect.MapKey, v protoreflect.Value) bool {
+				err = checkTimestamps(v.Message(), fmt.Sprintf("%s[%v]", name, k.Interface()))
+				return err == nil
+			})
+		case fd.IsList():
+			if fd.Message() == nil {
+				return true
+			}
+			list := v.List()
+			for i := 0; i < list.Len() && err == nil; i++ {
+				err = checkTimestamps(list.Get(i).Message(), fmt.Sprintf("%s[%d]", name, i))
+			}
+		case fd.Message() != nil:
+			err = checkTimestamps(v.Message(), name)
+		}
+		return err == nil
+	})
+	return err
+}
@@ -0,0 +1,110 @@
+package grpctime
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var invalidTimestamp = &timestamppb.Timestamp{Seconds: 1 << 40}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	interceptor := UnaryServerInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: "/grpctime.test.Events/Create"}
+	valid := newEvent(map[string]any{"starts_at": timestamppb.New(time.Now())}).Interface()
+
+	called := false
+	echo := func(ctx context.Context, req any) (any, error) {
+		called = true
+		return req, nil
+	}
+	if _, err := interceptor(context.Background(), valid, info, echo); err != nil || !called {
+		t.Errorf("interceptor(valid) error = %v, handler called = %v", err, called)
+	}
+
+	nested := newEvent(map[string]any{"parent": newEvent(map[string]any{"ends_at": invalidTimestamp})})
+	called = false
+	_, err := interceptor(context.Background(), nested.Interface(), info, echo)
+	if status.Code(err) != codes.InvalidArgument || called {
+		t.Errorf("interceptor(invalid) error = %v, handler called = %v, want InvalidArgument", err, called)
+	}
+	if !strings.Contains(err.Error(), "parent.ends_at") {
+		t.Errorf("error %q does not name parent.ends_at", err)
+	}
+
+	badResponse := func(ctx context.Context, req any) (any, error) {
+		return newEvent(map[string]any{"starts_at": invalidTimestamp}).Interface(), nil
+	}
+	if _, err := interceptor(context.Background(), valid, info, badResponse); status.Code(err) != codes.Internal {
+		t.Errorf("interceptor(invalid response) error = %v, want Internal", err)
+	}
+}
+
+func TestUnaryServerInterceptorRepeated(t *testing.T) {
+	msg := newEvent(nil)
+	list := msg.Mutable(msg.Descriptor().Fields().ByName("reminder_times")).List()
+	for _, seconds := range []int64{0, invalidTimestamp.Seconds} {
+		ts := list.NewElement()
+		ts.Message().Set(ts.Message().Descriptor().Fields().ByName("seconds"), protoreflect.ValueOfInt64(seconds))
+		list.Append(ts)
+	}
+	_, err := UnaryServerInterceptor()(context.Background(), msg.Interface(), &grpc.UnaryServerInfo{}, func(ctx context.Context, req any) (any, error) {
+		return nil, nil
+	})
+	if status.Code(err) != codes.InvalidArgument || !strings.Contains(err.Error(), "reminder_times[1]") {
+		t.Errorf("interceptor error = %v, want InvalidArgument naming reminder_times[1]", err)
+	}
+}
+
+// fakeStream is a grpc.ServerStream that receives one message and records
+// the messages sent.
+type fakeStream struct {
+	grpc.ServerStream
+	recv protoreflect.Message
+	sent []any
+}
+
+func (s *fakeStream) RecvMsg(m any) error {
+	m.(*dynamicMessage).Message = s.recv
+	return nil
+}
+
+func (s *fakeStream) SendMsg(m any) error {
+	s.sent = append(s.sent, m)
+	return nil
+}
+
+// dynamicMessage lets fakeStream fill in a message of a dynamic type.
+type dynamicMessage struct {
+	protoreflect.Message
+}
+
+func (m *dynamicMessage) ProtoReflect() protoreflect.Message { return m.Message }
+
+func TestStreamServerInterceptor(t *testing.T) {
+	interceptor := StreamServerInterceptor()
+	stream := &fakeStream{recv: newEvent(map[string]any{"starts_at": invalidTimestamp})}
+
+	err := interceptor(nil, stream, &grpc.StreamServerInfo{}, func(srv any, ss grpc.ServerStream) error {
+		if err := ss.RecvMsg(&dynamicMessage{}); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("RecvMsg(invalid) error = %v, want InvalidArgument", err)
+		}
+		if err := ss.SendMsg(newEvent(map[string]any{"ends_at": invalidTimestamp}).Interface()); status.Code(err) != codes.Internal {
+			t.Errorf("SendMsg(invalid) error = %v, want Internal", err)
+		}
+		return ss.SendMsg(newEvent(map[string]any{"ends_at": timestamppb.Now()}).Interface())
+	})
+	if err != nil {
+		t.Fatalf("interceptor error = %v", err)
+	}
+	if len(stream.sent) != 1 {
+		t.Errorf("sent %d messages, want only the valid one", len(stream.sent))
+	}
+}
@@ -0,0 +1,512 @@
+package meridian
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// GuessLayout infers a reference layout, as used by Parse and
+// time.Time.Format, from example timestamps such as the first rows of an
+// imported CSV file. The returned layout parses every sample; a data-import
+// wizard can show it for confirmation and then call Parse[TZ] with it for
+// the rest of the data.
+//
+// GuessLayout recognizes numeric dates with any common separator ("-", "/",
+// "." or a space) and compact dates such as 20240315, two- and four-digit
+// years, month and weekday names, 12- and 24-hour clocks, fractional seconds
+// with a period or comma, and zone offsets or abbreviations. When the samples
+// vary in width, such as single- and double-digit days or fractional seconds
+// of different lengths, the layout accepts all of them.
+//
+// Numeric dates whose year is not first are ambiguous when no sample has a
+// day above 12. GuessLayout then assumes month first for dates separated by
+// "/", as in the United States, and day first otherwise. A two-digit first
+// field is a year only if some sample's first field exceeds 31 or no other
+// ordering parses. Pass as many samples as are available to narrow the
+// guess.
+func GuessLayout(samples []string) (string, error) {
+	if len(samples) == 0 {
+		return "", errors.New("cannot guess a layout from no samples")
+	}
+	for _, sample := range samples {
+		candidates, err := layoutCandidates(sample)
+		if err != nil {
+			return "", err
+		}
+		for _, layout := range candidates {
+			if parsesAll(layout, samples) {
+				return layout, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("cannot guess a layout that parses all %d samples", len(samples))
+}
+
+// parsesAll reports whether layout parses every sample.
+func parsesAll(layout string, samples []string) bool {
+	for _, s := range samples {
+		if _, err := time.Parse(layout, s); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// guessToken is a run of digits, letters, or other characters in a sample.
+type guessToken struct {
+	kind byte // 'd' for digits, 'a' for letters, 's' for anything else
+	text string
+}
+
+// tokenizeSample splits s into runs of digits, letters, and other characters.
+func tokenizeSample(s string) []guessToken {
+	var toks []guessToken
+	for i := 0; i < len(s); {
+		kind := sampleCharKind(s[i])
+		j := i + 1
+		for j < len(s) && sampleCharKind(s[j]) == kind {
+			j++
+		}
+		toks = append(toks, guessToken{kind: kind, text: s[i:j]})
+		i = j
+	}
+	return toks
+}
+
+func sampleCharKind(c byte) byte {
+	switch {
+	case '0' <= c && c <= '9':
+		return 'd'
+	case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z':
+		return 'a'
+	default:
+		return 's'
+	}
+}
+
+// layoutGuesser builds the candidate layouts for one sample. Each slot holds
+// the alternatives for one element of the layout, most specific first; the
+// numeric date fields are left empty until their order is decided.
+type layoutGuesser struct {
+	toks  []guessToken
+	slots [][]string
+
+	dates     []dateField
+	hourSlot  int
+	hourText  string
+	clock     bool // a clock time has been seen
+	justClock bool // the last number consumed ended a clock time
+	month     bool // the month is spelled out
+	pm        bool // the sample has an AM/PM marker
+	padDay    bool // the next number follows two spaces, as in "Jan  2"
+	compact   bool // the sample has a date without separators
+}
+
+// dateField is a numeric date component whose role is not yet known.
+type dateField struct {
+	slot   int
+	text   string
+	sep    string // the separator preceding the field
+	padded bool
+}
+
+// layoutCandidates returns the layouts that describe sample, most specific
+// first.
+func layoutCandidates(sample string) ([]string, error) {
+	g := &layoutGuesser{toks: tokenizeSample(sample), hourSlot: -1}
+	if len(g.toks) == 0 {
+		return nil, errors.New("cannot guess a layout for an empty sample")
+	}
+	for i := 0; i < len(g.toks); {
+		n, err := g.consume(i)
+		if err != nil {
+			return nil, fmt.Errorf("cannot guess a layout for %q: %w", sample, err)
+		}
+		i += n
+	}
+	if !g.clock && !g.compact && !g.month && len(g.dates) == 0 {
+		return nil, fmt.Errorf("cannot guess a layout for %q: no date or time", sample)
+	}
+	if g.hourSlot >= 0 {
+		g.slots[g.hourSlot] = hourAlternatives(g.hourText, g.pm)
+	}
+	orders, err := g.dateOrders()
+	if err != nil {
+		return nil, fmt.Errorf("cannot guess a layout for %q: %w", sample, err)
+	}
+
+	var layouts []string
+	for _, order := range orders {
+		for i, f := range g.dates {
+			g.slots[f.slot] = order[i]
+		}
+		layouts = append(layouts, expandSlots(g.slots)...)
+	}
+	return layouts, nil
+}
+
+// expandSlots returns every combination of the slot alternatives in order of
+// preference.
+func expandSlots(slots [][]string) []string {
+	layouts := []string{""}
+	for _, alts := range slots {
+		next := make([]string, 0, len(layouts)*len(alts))
+		for _, l := range layouts {
+			for _, a := range alts {
+				next = append(next, l+a)
+			}
+		}
+		layouts = next
+	}
+	return layouts
+}
+
+func (g *layoutGuesser) add(alts ...string) {
+	g.slots = append(g.slots, alts)
+}
+
+// peek returns the token at i, or a zero token past the end.
+func (g *layoutGuesser) peek(i int) guessToken {
+	if i < len(g.toks) {
+		return g.toks[i]
+	}
+	return guessToken{}
+}
+
+// consume adds the layout elements for the token at i and returns the
+// number of tokens used.
+func (g *layoutGuesser) consume(i int) (int, error) {
+	tok := g.toks[i]
+	switch tok.kind {
+	case 'd':
+		return g.consumeNumber(i)
+	case 'a':
+		g.justClock = false
+		return 1, g.consumeWord(tok.text)
+	default:
+		return g.consumeSeparator(i), nil
+	}
+}
+
+func (g *layoutGuesser) consumeSeparator(i int) int {
+	text := g.toks[i].text
+	next := g.peek(i + 1)
+	last := text[len(text)-1]
+	if g.justClock && (last == '+' || last == '-') && next.kind == 'd' {
+		if text != string(last) {
+			g.add(text[:len(text)-1])
+		}
+		return 1 + g.consumeOffset(i+1)
+	}
+	if strings.HasSuffix(text, "  ") && next.kind == 'd' && len(next.text) == 1 {
+		g.add(text[:len(text)-1])
+		g.padDay = true
+		return 1
+	}
+	g.add(text)
+	return 1
+}
+
+// consumeOffset adds a numeric zone offset starting with the digits at i,
+// whose sign has already been consumed, and returns the tokens used.
+func (g *layoutGuesser) consumeOffset(i int) int {
+	g.justClock = false
+	hours := g.toks[i].text
+	if len(hours) == 2 && g.peek(i+1).text == ":" && len(g.peek(i+2).text) == 2 && g.peek(i+2).kind == 'd' {
+		g.add("Z07:00", "-07:00")
+		return 3
+	}
+	switch len(hours) {
+	case 4:
+		g.add("-0700", "Z0700")
+	case 2:
+		g.add("-07", "Z07")
+	default:
+		g.add(hours)
+	}
+	return 1
+}
+
+func (g *layoutGuesser) consumeNumber(i int) (int, error) {
+	text := g.toks[i].text
+	prev := ""
+	if i > 0 {
+		prev = g.toks[i-1].text
+	}
+	if g.peek(i+1).text == ":" && g.peek(i+2).kind == 'd' {
+		return g.consumeClock(i)
+	}
+	if prev == "T" && (len(text) == 4 || len(text) == 6) {
+		g.clock = true
+		if len(text) == 4 {
+			g.add("1504")
+			g.justClock = true
+			return 1, nil
+		}
+		g.add("150405")
+		return 1 + g.consumeFraction(i+1), nil
+	}
+	g.justClock = false
+	switch len(text) {
+	case 8:
+		g.compact = true
+		g.add("20060102")
+		return 1, nil
+	case 14:
+		g.clock, g.compact = true, true
+		g.add("20060102150405")
+		return 1 + g.consumeFraction(i+1), nil
+	case 1, 2, 4:
+		g.dates = append(g.dates, dateField{slot: len(g.slots), text: text, sep: prev, padded: g.padDay})
+		g.padDay = false
+		g.add()
+		return 1, nil
+	}
+	return 0, fmt.Errorf("unrecognized number %s", text)
+}
+
+// consumeClock adds a clock time of the form h:mm, h:mm:ss, or h:mm:ss.fff
+// starting at i and returns the tokens used.
+func (g *layoutGuesser) consumeClock(i int) (int, error) {
+	if g.clock {
+		return 0, errors.New("more than one clock time")
+	}
+	g.clock = true
+	g.hourSlot, g.hourText = len(g.slots), g.toks[i].text
+	g.add()
+	g.add(":")
+	g.add(paddedAlternatives(g.toks[i+2].text, "04", "4")...)
+	n := 3
+	if g.peek(i+3).text == ":" && g.peek(i+4).kind == 'd' {
+		g.add(":")
+		g.add(paddedAlternatives(g.toks[i+4].text, "05", "5")...)
+		n = 5 + g.consumeFraction(i+5)
+	}
+	g.justClock = true
+	return n, nil
+}
+
+// consumeFraction adds fractional seconds if the tokens at i are a period or
+// comma followed by digits, and returns the tokens used.
+func (g *layoutGuesser) consumeFraction(i int) int {
+	g.justClock = true
+	sep, digits := g.peek(i), g.peek(i+1)
+	if (sep.text != "." && sep.text != ",") || digits.kind != 'd' || len(digits.text) > 9 {
+		return 0
+	}
+	g.add(sep.text+strings.Repeat("0", len(digits.text)), sep.text+"999999999")
+	return 2
+}
+
+func (g *layoutGuesser) consumeWord(word string) error {
+	switch {
+	case matchName(word, longMonthNames):
+		g.month = true
+		g.add("January")
+	case matchName(word, shortMonthNames):
+		g.month = true
+		g.add("Jan")
+	case matchName(word, longDayNames):
+		g.add("Monday")
+	case matchName(word, shortDayNames):
+		g.add("Mon")
+	case word == "AM" || word == "PM":
+		g.pm = true
+		g.add("PM")
+	case word == "am" || word == "pm":
+		g.pm = true
+		g.add("pm")
+	case word == "T" || word == "t":
+		g.add("T")
+	case g.clock && word == "Z":
+		g.add("Z07:00", "Z0700")
+	case g.clock && len(word) >= 3 && len(word) <= 5 && strings.ToUpper(word) == word:
+		g.add("MST")
+	case isLayoutLiteral(word):
+		g.add(word)
+	default:
+		return fmt.Errorf("unrecognized word %s", word)
+	}
+	return nil
+}
+
+var (
+	longMonthNames  = monthNames(func(m time.Month) string { return m.String() })
+	shortMonthNames = monthNames(func(m time.Month) string { return m.String()[:3] })
+	longDayNames    = dayNames(func(d time.Weekday) string { return d.String() })
+	shortDayNames   = dayNames(func(d time.Weekday) string { return d.String()[:3] })
+)
+
+func monthNames(name func(time.Month) string) []string {
+	names := make([]string, 0, 12)
+	for m := time.January; m <= time.December; m++ {
+		names = append(names, name(m))
+	}
+	return names
+}
+
+func dayNames(name func(time.Weekday) string) []string {
+	names := make([]string, 0, 7)
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		names = append(names, name(d))
+	}
+	return names
+}
+
+// matchName reports whether word is one of names, ignoring case as
+// time.Parse does.
+func matchName(word string, names []string) bool {
+	for _, name := range names {
+		if strings.EqualFold(word, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// isLayoutLiteral reports whether word can appear in a layout without being
+// read as a layout element.
+func isLayoutLiteral(word string) bool {
+	for _, elem := range []string{"Jan", "Mon", "MST", "PM", "pm", "Z07"} {
+		if strings.Contains(word, elem) {
+			return false
+		}
+	}
+	return true
+}
+
+func hourAlternatives(text string, pm bool) []string {
+	if !pm {
+		return []string{"15"}
+	}
+	return paddedAlternatives(text, "03", "3")
+}
+
+// paddedAlternatives returns the layout elements for a one- or two-digit
+// field. The zero-padded form only matches two digits, so it is offered
+// first and only when the sample has two.
+func paddedAlternatives(text, padded, unpadded string) []string {
+	if len(text) == 2 {
+		return []string{padded, unpadded}
+	}
+	return []string{unpadded}
+}
+
+// dateOrders returns the possible layout elements for the numeric date
+// fields, one slice of alternatives per field for each plausible order.
+func (g *layoutGuesser) dateOrders() ([][][]string, error) {
+	fields := g.dates
+	if g.month {
+		return g.namedMonthOrder()
+	}
+	switch len(fields) {
+	case 0:
+		return [][][]string{nil}, nil
+	case 3:
+	default:
+		return nil, fmt.Errorf("found %d date numbers, want 3", len(fields))
+	}
+
+	ymd, dmy, mdy := [3]byte{'y', 'm', 'd'}, [3]byte{'d', 'm', 'y'}, [3]byte{'m', 'd', 'y'}
+	yearLast := [][3]byte{dmy, mdy}
+	if fields[1].sep == "/" {
+		yearLast = [][3]byte{mdy, dmy}
+	}
+	var roles [][3]byte
+	switch {
+	case len(fields[0].text) == 4:
+		roles = [][3]byte{ymd}
+	case len(fields[2].text) == 4:
+		roles = yearLast
+	case atoi(fields[0].text) > 31:
+		roles = [][3]byte{ymd}
+	default:
+		roles = append(yearLast, ymd)
+	}
+
+	var orders [][][]string
+	for _, r := range roles {
+		order := make([][]string, len(fields))
+		for i, f := range fields {
+			order[i] = dateFieldAlternatives(r[i], f)
+		}
+		if !hasEmpty(order) {
+			orders = append(orders, order)
+		}
+	}
+	if len(orders) == 0 {
+		return nil, errors.New("date numbers are not a day, month, and year")
+	}
+	return orders, nil
+}
+
+// namedMonthOrder assigns the numeric fields of a date whose month is spelled
+// out: a four-digit number is the year, and otherwise the day comes before a
+// two-digit year.
+func (g *layoutGuesser) namedMonthOrder() ([][][]string, error) {
+	if len(g.dates) > 2 {
+		return nil, fmt.Errorf("found %d date numbers besides the month, want at most 2", len(g.dates))
+	}
+	order := make([][]string, len(g.dates))
+	day := false
+	for i, f := range g.dates {
+		switch {
+		case len(f.text) == 4:
+			order[i] = []string{"2006"}
+		case !day:
+			order[i] = dateFieldAlternatives('d', f)
+			day = true
+		default:
+			order[i] = dateFieldAlternatives('y', f)
+		}
+		if len(order[i]) == 0 {
+			return nil, fmt.Errorf("unrecognized date number %s", f.text)
+		}
+	}
+	return [][][]string{order}, nil
+}
+
+// dateFieldAlternatives returns the layout elements for f in the given role
+// ('y', 'm', or 'd'), or nil if f cannot have that role.
+func dateFieldAlternatives(role byte, f dateField) []string {
+	switch role {
+	case 'y':
+		switch len(f.text) {
+		case 4:
+			return []string{"2006"}
+		case 2:
+			return []string{"06"}
+		}
+	case 'm':
+		if len(f.text) <= 2 && atoi(f.text) <= 12 {
+			return paddedAlternatives(f.text, "01", "1")
+		}
+	case 'd':
+		switch {
+		case len(f.text) > 2 || atoi(f.text) > 31:
+		case f.padded:
+			return []string{"_2"}
+		default:
+			return paddedAlternatives(f.text, "02", "2")
+		}
+	}
+	return nil
+}
+
+func hasEmpty(order [][]string) bool {
+	for _, alts := range order {
+		if len(alts) == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// atoi returns the value of a run of at most a few digits.
+func atoi(s string) int {
+	n, _ := strconv.Atoi(s)
+	return n
+}
@@ -0,0 +1,81 @@
+package meridian
+
+import (
+	"testing"
+	"time"
+)
+
+func TestGuessLayout(t *testing.T) {
+	tests := []struct {
+		name    string
+		samples []string
+		want    string
+	}{
+		{"RFC3339", []string{"2024-03-15T10:30:00Z", "2024-03-16T11:00:00+02:00"}, time.RFC3339},
+		{"RFC3339 fraction", []string{"2024-03-15T10:30:00.123Z"}, "2006-01-02T15:04:05.000Z07:00"},
+		{"mixed fractions", []string{"2024-03-15 10:30:00.5", "2024-03-15 10:30:00.123456", "2024-03-15 10:30:00"}, "2006-01-02 15:04:05.999999999"},
+		{"comma fraction", []string{"2024-03-15 10:30:00,250"}, "2006-01-02 15:04:05,000"},
+		{"DateTime", []string{"2024-03-15 10:30:00"}, time.DateTime},
+		{"DateOnly", []string{"2024-03-15", "2024-12-01"}, time.DateOnly},
+		{"US slashes", []string{"03/15/2024", "12/01/2024"}, "01/02/2006"},
+		{"day above 12 first", []string{"01/02/2024", "15/03/2024"}, "02/01/2006"},
+		{"European dots", []string{"15.03.2024 10:30"}, "02.01.2006 15:04"},
+		{"unpadded", []string{"3/15/2024", "12/5/2024"}, "1/2/2006"},
+		{"two-digit year", []string{"03/15/24"}, "01/02/06"},
+		{"two-digit year first", []string{"99-12-31"}, "06-01-02"},
+		{"compact", []string{"20240315T103000Z"}, "20060102T150405Z07:00"},
+		{"compact date", []string{"20240315"}, "20060102"},
+		{"RFC1123", []string{"Fri, 15 Mar 2024 10:30:00 GMT"}, time.RFC1123},
+		{"RFC1123Z", []string{"Fri, 15 Mar 2024 10:30:00 -0400", "Sat, 16 Mar 2024 10:30:00 +0000"}, time.RFC1123Z},
+		{"ANSIC", []string{"Fri Mar  1 10:30:00 2024", "Fri Mar 15 10:30:00 2024"}, time.ANSIC},
+		{"long month", []string{"March 15, 2024 3:04 PM"}, "January 02, 2006 3:04 PM"},
+		{"kitchen", []string{"3:04PM", "11:30AM"}, time.Kitchen},
+		{"lowercase pm", []string{"15-Mar-2024 09:15 am"}, "02-Jan-2006 03:04 pm"},
+		{"time String", []string{"2024-03-15 10:30:00 +0000 UTC"}, "2006-01-02 15:04:05 -0700 MST"},
+		{"literal word", []string{"2024-03-15 at 10:30"}, "2006-01-02 at 15:04"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GuessLayout(tt.samples)
+			if err != nil {
+				t.Fatalf("GuessLayout(%q) error = %v", tt.samples, err)
+			}
+			if got != tt.want {
+				t.Errorf("GuessLayout(%q) = %q, want %q", tt.samples, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGuessLayoutParses(t *testing.T) {
+	samples := []string{"15/03/2024 10:30", "01/04/2024 09:00"}
+	layout, err := GuessLayout(samples)
+	if err != nil {
+		t.Fatalf("GuessLayout() error = %v", err)
+	}
+	got, err := Parse[benchZone](layout, samples[1])
+	if err != nil {
+		t.Fatalf("Parse(%q) error = %v", layout, err)
+	}
+	if got.Month() != time.April || got.Day() != 1 || got.Hour() != 9 {
+		t.Errorf("Parse(%q, %q) = %v, want April 1 09:00", layout, samples[1], got)
+	}
+}
+
+func TestGuessLayoutInvalid(t *testing.T) {
+	tests := [][]string{
+		nil,
+		{""},
+		{"not a date"},
+		{"12345"},
+		{"2024-03"},
+		{"2024-03-15", "15/03/2024"},
+		{"2024-13-45"},
+		{"10:30 and 11:30"},
+	}
+	for _, samples := range tests {
+		if got, err := GuessLayout(samples); err == nil {
+			t.Errorf("GuessLayout(%q) = %q, want error", samples, got)
+		}
+	}
+}
@@ -0,0 +1,185 @@
+package meridian
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ParseHTTPDate parses an HTTP-date header value as defined by RFC 7231,
+// section 7.1.1.1, accepting the preferred IMF-fixdate format as well as the
+// obsolete RFC 850 and ANSI C asctime formats that recipients must support.
+// The result represents the same instant in the specified timezone.
+func ParseHTTPDate[TZ Timezone](value string) (Time[TZ], error) {
+	t, err := http.ParseTime(value)
+	if err != nil {
+		return Time[TZ]{}, err
+	}
+	return Time[TZ]{utcTime: t.UTC()}, nil
+}
+
+// FormatHTTPDate returns t formatted as an RFC 7231 IMF-fixdate, such as
+// "Wed, 25 Dec 2024 14:00:00 GMT". HTTP dates are always expressed in GMT,
+// regardless of the timezone type.
+func (t Time[TZ]) FormatHTTPDate() string {
+	return t.utcTime.Format(http.TimeFormat)
+}
+
+// SetLastModified sets the Last-Modified header in h to m formatted as an
+// HTTP-date. The parameter m can be any Moment (time.Time or Time[TZ]).
+func SetLastModified(h http.Header, m Moment) {
+	setHTTPDate(h, "Last-Modified", m)
+}
+
+// SetExpires sets the Expires header in h to m formatted as an HTTP-date.
+// The parameter m can be any Moment (time.Time or Time[TZ]).
+func SetExpires(h http.Header, m Moment) {
+	setHTTPDate(h, "Expires", m)
+}
+
+// HeaderDate parses the HTTP-date in header key of h. It reports false if the
+// header is absent or is not a valid HTTP-date.
+func HeaderDate[TZ Timezone](h http.Header, key string) (Time[TZ], bool) {
+	value := h.Get(key)
+	if value == "" {
+		return Time[TZ]{}, false
+	}
+	t, err := ParseHTTPDate[TZ](value)
+	if err != nil {
+		return Time[TZ]{}, false
+	}
+	return t, true
+}
+
+// setHTTPDate sets header key of h to m formatted as an IMF-fixdate.
+func setHTTPDate(h http.Header, key string, m Moment) {
+	h.Set(key, m.UTC().Format(http.TimeFormat))
+}
+
+// ParseRetryAfter parses a Retry-After header value as defined by RFC 7231,
+// section 7.1.3, returning the time at which the request may be retried. The
+// value is either an HTTP-date or a number of delay-seconds, which is added to
+// now. The result has the same timezone type as now, so passing utc.Now()
+// yields a utc.Time:
+//
+//	retryAt, err := meridian.ParseRetryAfter(resp.Header.Get("Retry-After"), utc.Now())
+func ParseRetryAfter[TZ Timezone](value string, now Time[TZ]) (Time[TZ], error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return Time[TZ]{}, errors.New("empty Retry-After value")
+	}
+	if isDigits(value) {
+		seconds, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || seconds > maxRetryAfterSeconds {
+			seconds = maxRetryAfterSeconds
+		}
+		return now.Add(time.Duration(seconds) * time.Second), nil
+	}
+	t, err := ParseHTTPDate[TZ](value)
+	if err != nil {
+		return Time[TZ]{}, fmt.Errorf("invalid Retry-After value %q: not delay-seconds or an HTTP-date", value)
+	}
+	return t, nil
+}
+
+// maxRetryAfterSeconds caps delay-seconds so that the resulting duration does
+// not overflow time.Duration.
+const maxRetryAfterSeconds = int64(math.MaxInt64 / int64(time.Second))
+
+// isDigits reports whether s is a non-empty string of ASCII digits.
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// Bounds for cookie Expires values. RFC 6265, section 5.1.1, rejects
+// cookie-dates before the year 1601, and the cookie-date grammar only allows
+// four-digit years.
+var (
+	minCookieExpires = time.Date(1601, time.January, 1, 0, 0, 0, 0, time.UTC)
+	maxCookieExpires = time.Date(9999, time.December, 31, 23, 59, 59, 0, time.UTC)
+)
+
+// maxCacheAgeSeconds is the largest delta-seconds value a cache is required to
+// handle; RFC 7234, section 1.2.1, says larger values must be sent as 2^31.
+const maxCacheAgeSeconds = 1 << 31
+
+// FormatCookieExpires returns m formatted as a cookie Expires attribute value.
+// The time is clamped to the range representable by an RFC 6265 cookie-date
+// (years 1601 through 9999), so the attribute is never dropped by net/http or
+// rejected by user agents.
+func FormatCookieExpires(m Moment) string {
+	return clampCookieExpires(m.UTC()).Format(http.TimeFormat)
+}
+
+// SetCookieExpiry sets both the Expires and Max-Age attributes of c so that the
+// cookie expires at expires, as seen from now. Expires is clamped as described
+// for FormatCookieExpires. If expires is not after now, the cookie is marked
+// for immediate deletion (Max-Age=0). Max-Age is clamped to math.MaxInt32, so
+// it does not overflow int on 32-bit platforms.
+func SetCookieExpiry(c *http.Cookie, expires, now Moment) {
+	c.Expires = clampCookieExpires(expires.UTC())
+	seconds := secondsUntil(expires, now)
+	if seconds <= 0 {
+		c.MaxAge = -1 // net/http writes Max-Age=0
+		return
+	}
+	if seconds > math.MaxInt32 {
+		seconds = math.MaxInt32
+	}
+	c.MaxAge = int(seconds)
+}
+
+// SetCacheExpiry sets the Cache-Control and Expires headers in h so that the
+// response becomes stale at expires, as seen from now. Cache-Control is set to
+// the given directives followed by max-age; max-age is clamped to 2^31 as
+// required by RFC 7234, and is 0 if expires is not after now.
+//
+//	meridian.SetCacheExpiry(w.Header(), midnight, utc.Now(), "public")
+//	// Cache-Control: public, max-age=3600
+//	// Expires: Sun, 16 Jun 2024 00:00:00 GMT
+func SetCacheExpiry(h http.Header, expires, now Moment, directives ...string) {
+	seconds := secondsUntil(expires, now)
+	if seconds < 0 {
+		seconds = 0
+	}
+	if seconds > maxCacheAgeSeconds {
+		seconds = maxCacheAgeSeconds
+	}
+	directives = append(directives[:len(directives):len(directives)], "max-age="+strconv.FormatInt(seconds, 10))
+	h.Set("Cache-Control", strings.Join(directives, ", "))
+	setHTTPDate(h, "Expires", expires)
+}
+
+// clampCookieExpires clamps t to the range representable by a cookie-date.
+func clampCookieExpires(t time.Time) time.Time {
+	if t.Before(minCookieExpires) {
+		return minCookieExpires
+	}
+	if t.After(maxCookieExpires) {
+		return maxCookieExpires
+	}
+	return t
+}
+
+// secondsUntil returns the number of whole seconds from now until t, rounded
+// up so that a partial second still counts as time remaining.
+func secondsUntil(t, now Moment) int64 {
+	d := t.UTC().Sub(now.UTC())
+	seconds := int64(d / time.Second)
+	if d%time.Second > 0 {
+		seconds++
+	}
+	return seconds
+}
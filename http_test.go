@@ -0,0 +1,271 @@
+package meridian
+
+import (
+	"math"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestParseHTTPDate(t *testing.T) {
+	expected := time.Date(1994, time.November, 6, 8, 49, 37, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		value string
+	}{
+		{"IMF-fixdate", "Sun, 06 Nov 1994 08:49:37 GMT"},
+		{"RFC 850", "Sunday, 06-Nov-94 08:49:37 GMT"},
+		{"asctime", "Sun Nov  6 08:49:37 1994"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseHTTPDate[EST](tt.value)
+			if err != nil {
+				t.Fatalf("ParseHTTPDate() error = %v", err)
+			}
+			if !got.Equal(expected) {
+				t.Errorf("ParseHTTPDate() = %v, want %v", got.UTC(), expected)
+			}
+			if got.Hour() != 3 {
+				t.Errorf("Hour() = %d, want 3 (EST)", got.Hour())
+			}
+		})
+	}
+}
+
+func TestParseHTTPDateInvalid(t *testing.T) {
+	for _, value := range []string{"", "2024-12-25T14:00:00Z", "Sun, 06 Nov 1994"} {
+		if _, err := ParseHTTPDate[UTC](value); err == nil {
+			t.Errorf("ParseHTTPDate(%q) expected error, got nil", value)
+		}
+	}
+}
+
+func TestFormatHTTPDate(t *testing.T) {
+	// 9:00 EST is 14:00 GMT; HTTP dates are always in GMT.
+	estTime := Date[EST](2024, time.December, 25, 9, 0, 0, 500)
+	if got := estTime.FormatHTTPDate(); got != "Wed, 25 Dec 2024 14:00:00 GMT" {
+		t.Errorf("FormatHTTPDate() = %q, want %q", got, "Wed, 25 Dec 2024 14:00:00 GMT")
+	}
+
+	roundTrip, err := ParseHTTPDate[EST](estTime.FormatHTTPDate())
+	if err != nil {
+		t.Fatalf("ParseHTTPDate() error = %v", err)
+	}
+	if !roundTrip.Equal(estTime.Truncate(time.Second)) {
+		t.Errorf("round trip = %v, want %v", roundTrip, estTime.Truncate(time.Second))
+	}
+}
+
+func TestSetDateHeaders(t *testing.T) {
+	h := http.Header{}
+	SetLastModified(h, Date[PST](2024, time.June, 15, 7, 30, 0, 0))
+	SetExpires(h, time.Date(2024, time.June, 16, 14, 30, 0, 0, time.UTC))
+
+	if got := h.Get("Last-Modified"); got != "Sat, 15 Jun 2024 14:30:00 GMT" {
+		t.Errorf("Last-Modified = %q, want %q", got, "Sat, 15 Jun 2024 14:30:00 GMT")
+	}
+	if got := h.Get("Expires"); got != "Sun, 16 Jun 2024 14:30:00 GMT" {
+		t.Errorf("Expires = %q, want %q", got, "Sun, 16 Jun 2024 14:30:00 GMT")
+	}
+}
+
+func TestHeaderDate(t *testing.T) {
+	h := http.Header{}
+	h.Set("If-Modified-Since", "Sat, 15 Jun 2024 14:30:00 GMT")
+	h.Set("Expires", "0")
+
+	got, ok := HeaderDate[UTC](h, "If-Modified-Since")
+	if !ok {
+		t.Fatal("HeaderDate() ok = false, want true")
+	}
+	if expected := Date[UTC](2024, time.June, 15, 14, 30, 0, 0); !got.Equal(expected) {
+		t.Errorf("HeaderDate() = %v, want %v", got, expected)
+	}
+
+	if _, ok := HeaderDate[UTC](h, "Expires"); ok {
+		t.Error("HeaderDate() ok = true for invalid date, want false")
+	}
+	if _, ok := HeaderDate[UTC](h, "Date"); ok {
+		t.Error("HeaderDate() ok = true for missing header, want false")
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := Date[UTC](2024, time.June, 15, 14, 30, 0, 0)
+
+	tests := []struct {
+		name     string
+		value    string
+		expected Time[UTC]
+	}{
+		{"delay-seconds", "120", now.Add(2 * time.Minute)},
+		{"zero delay", "0", now},
+		{"surrounding whitespace", " 30 ", now.Add(30 * time.Second)},
+		{"HTTP-date", "Sat, 15 Jun 2024 15:00:00 GMT", Date[UTC](2024, time.June, 15, 15, 0, 0, 0)},
+		{"HTTP-date in the past", "Fri, 14 Jun 2024 15:00:00 GMT", Date[UTC](2024, time.June, 14, 15, 0, 0, 0)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseRetryAfter(tt.value, now)
+			if err != nil {
+				t.Fatalf("ParseRetryAfter() error = %v", err)
+			}
+			if !got.Equal(tt.expected) {
+				t.Errorf("ParseRetryAfter() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseRetryAfterPreservesTimezone(t *testing.T) {
+	now := Date[EST](2024, time.January, 15, 9, 0, 0, 0)
+
+	got, err := ParseRetryAfter("3600", now)
+	if err != nil {
+		t.Fatalf("ParseRetryAfter() error = %v", err)
+	}
+	if got.Hour() != 10 {
+		t.Errorf("Hour() = %d, want 10 (EST)", got.Hour())
+	}
+}
+
+func TestParseRetryAfterOverflow(t *testing.T) {
+	now := Date[UTC](2024, time.June, 15, 14, 30, 0, 0)
+
+	got, err := ParseRetryAfter("99999999999999999999", now)
+	if err != nil {
+		t.Fatalf("ParseRetryAfter() error = %v", err)
+	}
+	if !got.After(now.AddDate(100, 0, 0)) {
+		t.Errorf("ParseRetryAfter() = %v, want a time far in the future", got)
+	}
+}
+
+func TestParseRetryAfterInvalid(t *testing.T) {
+	now := Date[UTC](2024, time.June, 15, 14, 30, 0, 0)
+
+	for _, value := range []string{"", "-5", "1.5", "soon", "2024-06-15T15:00:00Z"} {
+		if _, err := ParseRetryAfter(value, now); err == nil {
+			t.Errorf("ParseRetryAfter(%q) expected error, got nil", value)
+		}
+	}
+}
+
+func TestFormatCookieExpires(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    Moment
+		expected string
+	}{
+		{"in range", Date[EST](2024, time.December, 25, 9, 0, 0, 0), "Wed, 25 Dec 2024 14:00:00 GMT"},
+		{"before 1601", time.Date(1500, time.January, 1, 0, 0, 0, 0, time.UTC), "Mon, 01 Jan 1601 00:00:00 GMT"},
+		{"zero time", Time[UTC]{}, "Mon, 01 Jan 1601 00:00:00 GMT"},
+		{"after 9999", time.Date(12000, time.January, 1, 0, 0, 0, 0, time.UTC), "Fri, 31 Dec 9999 23:59:59 GMT"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FormatCookieExpires(tt.value); got != tt.expected {
+				t.Errorf("FormatCookieExpires() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSetCookieExpiry(t *testing.T) {
+	now := Date[UTC](2024, time.June, 15, 14, 30, 0, 0)
+
+	c := &http.Cookie{Name: "session", Value: "abc"}
+	SetCookieExpiry(c, now.Add(time.Hour+500*time.Millisecond), now)
+
+	if c.MaxAge != 3601 {
+		t.Errorf("MaxAge = %d, want 3601", c.MaxAge)
+	}
+	if got := c.String(); !contains(got, "Expires=Sat, 15 Jun 2024 15:30:00 GMT") || !contains(got, "Max-Age=3601") {
+		t.Errorf("Cookie.String() = %q, want Expires and Max-Age attributes", got)
+	}
+
+	SetCookieExpiry(c, now.Add(-time.Hour), now)
+	if got := c.String(); !contains(got, "Max-Age=0") {
+		t.Errorf("Cookie.String() = %q, want Max-Age=0 for past expiry", got)
+	}
+
+	SetCookieExpiry(c, Time[UTC]{}, now)
+	if got := c.String(); !contains(got, "Expires=Mon, 01 Jan 1601 00:00:00 GMT") {
+		t.Errorf("Cookie.String() = %q, want clamped Expires", got)
+	}
+
+	SetCookieExpiry(c, Date[UTC](9000, time.January, 1, 0, 0, 0, 0), now)
+	if c.MaxAge != math.MaxInt32 {
+		t.Errorf("MaxAge = %d, want %d for far-future expiry", c.MaxAge, math.MaxInt32)
+	}
+}
+
+func TestSetCacheExpiry(t *testing.T) {
+	now := Date[UTC](2024, time.June, 15, 23, 0, 0, 0)
+	midnight := Date[UTC](2024, time.June, 16, 0, 0, 0, 0)
+
+	tests := []struct {
+		name         string
+		expires      Moment
+		directives   []string
+		cacheControl string
+		expiresValue string
+	}{
+		{
+			name:         "future",
+			expires:      midnight,
+			cacheControl: "max-age=3600",
+			expiresValue: "Sun, 16 Jun 2024 00:00:00 GMT",
+		},
+		{
+			name:         "with directives",
+			expires:      midnight,
+			directives:   []string{"public", "must-revalidate"},
+			cacheControl: "public, must-revalidate, max-age=3600",
+			expiresValue: "Sun, 16 Jun 2024 00:00:00 GMT",
+		},
+		{
+			name:         "past",
+			expires:      now.Add(-time.Minute),
+			cacheControl: "max-age=0",
+			expiresValue: "Sat, 15 Jun 2024 22:59:00 GMT",
+		},
+		{
+			name:         "clamped",
+			expires:      now.AddDate(100, 0, 0),
+			cacheControl: "max-age=2147483648",
+			expiresValue: "Thu, 15 Jun 2124 23:00:00 GMT",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := http.Header{}
+			SetCacheExpiry(h, tt.expires, now, tt.directives...)
+
+			if got := h.Get("Cache-Control"); got != tt.cacheControl {
+				t.Errorf("Cache-Control = %q, want %q", got, tt.cacheControl)
+			}
+			if got := h.Get("Expires"); got != tt.expiresValue {
+				t.Errorf("Expires = %q, want %q", got, tt.expiresValue)
+			}
+		})
+	}
+}
+
+func TestSetCacheExpiryDoesNotModifyDirectives(t *testing.T) {
+	directives := make([]string, 1, 4)
+	directives[0] = "public"
+	now := Date[UTC](2024, time.June, 15, 23, 0, 0, 0)
+
+	SetCacheExpiry(http.Header{}, now.Add(time.Hour), now, directives...)
+
+	if extended := directives[:2]; extended[1] != "" {
+		t.Errorf("SetCacheExpiry() wrote into caller's slice: %q", extended)
+	}
+}
@@ -0,0 +1,157 @@
+/*
+Package ical formats and parses iCalendar (RFC 5545) DATE-TIME values for
+meridian typed times.
+
+RFC 5545 defines three forms of DATE-TIME: UTC times with a "Z" suffix,
+local times qualified by a TZID parameter, and floating times that are not
+bound to any zone. Format chooses the form from the timezone type, using the
+IANA location name of generated timezone packages as the TZID:
+
+	ical.Format(et.Date(2024, time.December, 25, 9, 0, 0, 0))
+	// TZID=America/New_York:20241225T090000
+
+	ical.Format(utc.Date(2024, time.December, 25, 14, 0, 0, 0))
+	// 20241225T140000Z
+
+Parse accepts all three forms and converts the instant into the requested
+timezone type. TZIDs are resolved with meridian.LoadLocation, so they follow
+any timezone data installed with meridian.Reload, and a value exported from
+one zone can be imported into another:
+
+	t, err := ical.Parse[pt.Timezone]("TZID=America/New_York:20241225T090000")
+	// 2024-12-25 06:00 PST
+*/
+package ical
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/matthalp/go-meridian/v2"
+)
+
+// Layouts for the DATE-TIME value forms.
+const (
+	localLayout = "20060102T150405"
+	utcLayout   = "20060102T150405Z"
+)
+
+// Format returns t as an iCalendar DATE-TIME value with its parameters, such
+// as "TZID=America/New_York:20241225T090000". Times whose timezone is UTC are
+// formatted in the UTC form, "20241225T140000Z", without a TZID. Fractional
+// seconds are truncated because RFC 5545 does not allow them.
+func Format[TZ meridian.Timezone](t meridian.Time[TZ]) string {
+	return FormatMoment(t)
+}
+
+// FormatMoment is like Format but accepts any Moment, taking the TZID from
+// the location reported by meridian.LocationOf. A time.Time therefore keeps
+// its own zone, while Moments without a location, and times in time.Local,
+// whose name is not an IANA location, are formatted in the UTC form.
+func FormatMoment(m meridian.Moment) string {
+	loc := meridian.LocationOf(m)
+	if isUTC(loc) || loc == time.Local {
+		return FormatUTC(m)
+	}
+	return "TZID=" + loc.String() + ":" + meridian.FormatMoment(m, localLayout)
+}
+
+// FormatUTC returns m as an iCalendar DATE-TIME value in the UTC form, such as
+// "20241225T140000Z". The parameter m can be any Moment (time.Time or Time[TZ]).
+func FormatUTC(m meridian.Moment) string {
+	return m.UTC().Format(utcLayout)
+}
+
+// Property returns a complete content line for the named property, such as
+// "DTSTART;TZID=America/New_York:20241225T090000".
+func Property[TZ meridian.Timezone](name string, t meridian.Time[TZ]) string {
+	value := Format(t)
+	if strings.HasPrefix(value, "TZID=") {
+		return name + ";" + value
+	}
+	return name + ":" + value
+}
+
+// Parse parses an iCalendar DATE-TIME value, optionally preceded by its
+// parameters and property name, and returns the instant in the specified
+// timezone. The following forms are accepted:
+//
+//	20241225T140000Z                              UTC time
+//	TZID=America/New_York:20241225T090000         local time in the TZID zone
+//	DTSTART;TZID=America/New_York:20241225T090000 full content line
+//	20241225T090000                               floating time
+//
+// Floating times have no zone of their own and are interpreted in the
+// timezone's location. An unknown TZID is an error.
+func Parse[TZ meridian.Timezone](s string) (meridian.Time[TZ], error) {
+	var params, value string
+	if i := strings.LastIndexByte(s, ':'); i >= 0 {
+		params, value = s[:i], s[i+1:]
+	} else {
+		value = s
+	}
+
+	loc, err := parseParams(params)
+	if err != nil {
+		return meridian.Time[TZ]{}, fmt.Errorf("invalid iCalendar DATE-TIME %q: %w", s, err)
+	}
+
+	var t time.Time
+	switch {
+	case strings.HasSuffix(value, "Z"):
+		if loc != nil {
+			return meridian.Time[TZ]{}, fmt.Errorf("invalid iCalendar DATE-TIME %q: UTC time must not have a TZID", s)
+		}
+		t, err = time.Parse(utcLayout, value)
+	case loc != nil:
+		t, err = time.ParseInLocation(localLayout, value, loc)
+	default:
+		return meridian.Parse[TZ](localLayout, value)
+	}
+	if err != nil {
+		return meridian.Time[TZ]{}, fmt.Errorf("invalid iCalendar DATE-TIME %q: %w", s, err)
+	}
+	return meridian.FromMoment[TZ](t), nil
+}
+
+// parseParams parses the property name and parameters preceding a DATE-TIME
+// value, returning the location named by TZID, or nil if there is none.
+func parseParams(params string) (*time.Location, error) {
+	if params == "" {
+		return nil, nil
+	}
+
+	var loc *time.Location
+	for i, param := range strings.Split(params, ";") {
+		key, val, ok := strings.Cut(param, "=")
+		if !ok {
+			if i == 0 {
+				continue // property name
+			}
+			return nil, fmt.Errorf("malformed parameter %q", param)
+		}
+		switch strings.ToUpper(key) {
+		case "TZID":
+			tzid := strings.TrimPrefix(strings.Trim(val, `"`), "/")
+			if tzid == "" || tzid == "Local" {
+				return nil, fmt.Errorf("unknown TZID %q", val)
+			}
+			l, err := meridian.LoadLocation(tzid)
+			if err != nil {
+				return nil, fmt.Errorf("unknown TZID %q", val)
+			}
+			loc = l
+		case "VALUE":
+			if !strings.EqualFold(val, "DATE-TIME") {
+				return nil, fmt.Errorf("unsupported VALUE %q", val)
+			}
+		}
+	}
+	return loc, nil
+}
+
+// isUTC reports whether loc is the UTC location.
+func isUTC(loc *time.Location) bool {
+	return loc == time.UTC || loc.String() == "UTC"
+}
@@ -0,0 +1,146 @@
+package ical
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matthalp/go-meridian/v2"
+	"github.com/matthalp/go-meridian/v2/internal/tztest"
+	"github.com/matthalp/go-meridian/v2/timezones/et"
+	"github.com/matthalp/go-meridian/v2/timezones/jst"
+	"github.com/matthalp/go-meridian/v2/timezones/pt"
+	"github.com/matthalp/go-meridian/v2/timezones/utc"
+)
+
+func TestFormat(t *testing.T) {
+	if got := Format(et.Date(2024, time.December, 25, 9, 0, 0, 999)); got != "TZID=America/New_York:20241225T090000" {
+		t.Errorf("Format(et) = %q, want %q", got, "TZID=America/New_York:20241225T090000")
+	}
+	if got := Format(jst.Date(2024, time.July, 1, 18, 30, 15, 0)); got != "TZID=Asia/Tokyo:20240701T183015" {
+		t.Errorf("Format(jst) = %q, want %q", got, "TZID=Asia/Tokyo:20240701T183015")
+	}
+	if got := Format(utc.Date(2024, time.December, 25, 14, 0, 0, 0)); got != "20241225T140000Z" {
+		t.Errorf("Format(utc) = %q, want %q", got, "20241225T140000Z")
+	}
+}
+
+func TestFormatMoment(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := FormatMoment(time.Date(2024, time.July, 1, 18, 30, 15, 0, tokyo)); got != "TZID=Asia/Tokyo:20240701T183015" {
+		t.Errorf("FormatMoment(time.Time) = %q, want %q", got, "TZID=Asia/Tokyo:20240701T183015")
+	}
+	if got := FormatMoment(time.Date(2024, time.July, 1, 9, 30, 15, 0, time.UTC)); got != "20240701T093015Z" {
+		t.Errorf("FormatMoment(UTC time.Time) = %q, want %q", got, "20240701T093015Z")
+	}
+}
+
+func TestFormatUTC(t *testing.T) {
+	if got := FormatUTC(et.Date(2024, time.December, 25, 9, 0, 0, 0)); got != "20241225T140000Z" {
+		t.Errorf("FormatUTC() = %q, want %q", got, "20241225T140000Z")
+	}
+}
+
+func TestProperty(t *testing.T) {
+	if got := Property("DTSTART", et.Date(2024, time.December, 25, 9, 0, 0, 0)); got != "DTSTART;TZID=America/New_York:20241225T090000" {
+		t.Errorf("Property(et) = %q", got)
+	}
+	if got := Property("DTSTAMP", utc.Date(2024, time.December, 25, 14, 0, 0, 0)); got != "DTSTAMP:20241225T140000Z" {
+		t.Errorf("Property(utc) = %q", got)
+	}
+}
+
+func TestParse(t *testing.T) {
+	expected := time.Date(2024, time.December, 25, 14, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		value string
+	}{
+		{"UTC", "20241225T140000Z"},
+		{"TZID", "TZID=America/New_York:20241225T090000"},
+		{"quoted TZID", `TZID="America/New_York":20241225T090000`},
+		{"content line", "DTSTART;TZID=America/New_York:20241225T090000"},
+		{"content line with VALUE", "DTSTART;VALUE=DATE-TIME;TZID=America/Los_Angeles:20241225T060000"},
+		{"UTC content line", "DTSTAMP:20241225T140000Z"},
+		{"other zone", "TZID=Asia/Tokyo:20241225T230000"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse[pt.Timezone](tt.value)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if !got.Equal(expected) {
+				t.Errorf("Parse() = %v, want %v", got.UTC(), expected)
+			}
+			if got.Hour() != 6 {
+				t.Errorf("Hour() = %d, want 6 (PST)", got.Hour())
+			}
+		})
+	}
+}
+
+func TestParseFloating(t *testing.T) {
+	got, err := Parse[et.Timezone]("20240704T120000")
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if expected := et.Date(2024, time.July, 4, 12, 0, 0, 0); !got.Equal(expected) {
+		t.Errorf("Parse() = %v, want %v", got, expected)
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	original := et.Date(2024, time.March, 10, 3, 30, 0, 0)
+
+	got, err := Parse[et.Timezone](Format(original))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if !got.Equal(original) {
+		t.Errorf("round trip = %v, want %v", got, original)
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	for _, value := range []string{
+		"",
+		"2024-12-25T14:00:00Z",
+		"20241225",
+		"TZID=America/NewYork:20241225T090000",
+		"TZID=:20241225T090000",
+		"TZID=America/New_York:20241225T140000Z",
+		"DTSTART;VALUE=DATE:20241225",
+		"DTSTART;TZID:20241225T090000",
+	} {
+		if _, err := Parse[utc.Timezone](value); err == nil {
+			t.Errorf("Parse(%q) expected error, got nil", value)
+		}
+	}
+}
+
+func TestParseReloadedTZID(t *testing.T) {
+	if err := meridian.Reload(tztest.TokyoAsNewYork(t)); err != nil {
+		t.Fatalf("Reload() error = %v", err)
+	}
+	t.Cleanup(func() {
+		if err := meridian.Reload(nil); err != nil {
+			t.Errorf("Reload(nil) error = %v", err)
+		}
+	})
+
+	got, err := Parse[utc.Timezone]("TZID=America/New_York:20240704T210000")
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if want := time.Date(2024, time.July, 4, 12, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("Parse() = %v, want %v under the reloaded rules", got, want)
+	}
+	if _, err := Parse[utc.Timezone]("TZID=Europe/Paris:20240704T210000"); err == nil {
+		t.Error("Parse() with a TZID missing from the reloaded data succeeded, want error")
+	}
+}
@@ -0,0 +1,119 @@
+/*
+Package idtime extracts the creation timestamps embedded in time-ordered
+identifiers as typed UTC times.
+
+ULIDs, KSUIDs, and snowflake IDs all carry the instant they were generated in
+their leading bits. Event-sourcing and log-processing systems often order or
+bucket records by that instant; these helpers replace the inline bit math:
+
+	created, err := idtime.FromULID("01ARZ3NDEKTSV4RRFFQ69G5FAV")
+	posted := idtime.FromSnowflake(1212092628029698048, idtime.TwitterEpoch)
+
+The timestamps have the precision of the ID format: milliseconds for ULIDs and
+snowflakes, seconds for KSUIDs.
+*/
+package idtime
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/matthalp/go-meridian/v2/timezones/utc"
+)
+
+// Snowflake epochs of well-known services. A snowflake ID stores milliseconds
+// elapsed since its service's epoch; pass the matching epoch to FromSnowflake.
+var (
+	// TwitterEpoch is the epoch of Twitter (X) snowflake IDs: 2010-11-04 01:42:54.657 UTC.
+	TwitterEpoch = time.UnixMilli(1288834974657).UTC()
+
+	// DiscordEpoch is the epoch of Discord snowflake IDs: 2015-01-01 00:00:00 UTC.
+	DiscordEpoch = time.UnixMilli(1420070400000).UTC()
+)
+
+// snowflakeTimestampShift is the number of low-order bits below the timestamp
+// in a snowflake ID (worker, process, and sequence fields).
+const snowflakeTimestampShift = 22
+
+// FromSnowflake returns the time at which the snowflake ID was generated.
+// The top 42 bits of a snowflake are milliseconds elapsed since epoch, which
+// differs between services; see TwitterEpoch and DiscordEpoch.
+func FromSnowflake(id uint64, epoch time.Time) utc.Time {
+	ms := int64(id >> snowflakeTimestampShift)
+	return utc.FromMoment(epoch.Add(time.Duration(ms) * time.Millisecond))
+}
+
+const (
+	ulidLength   = 26
+	ulidAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+)
+
+// FromULID returns the time at which the ULID was generated. The ULID must be
+// in its canonical 26-character Crockford base32 form; letters are matched
+// case-insensitively. The first 10 characters encode a 48-bit count of
+// milliseconds since the Unix epoch.
+func FromULID(id string) (utc.Time, error) {
+	if len(id) != ulidLength {
+		return utc.Time{}, fmt.Errorf("invalid ULID %q: length %d, want %d", id, len(id), ulidLength)
+	}
+
+	var ms int64
+	for i := 0; i < len(id); i++ {
+		v := strings.IndexByte(ulidAlphabet, upper(id[i]))
+		if v < 0 {
+			return utc.Time{}, fmt.Errorf("invalid ULID %q: invalid character %q", id, id[i])
+		}
+		// The first character carries only 3 bits of the 128-bit value.
+		if i == 0 && v > 7 {
+			return utc.Time{}, fmt.Errorf("invalid ULID %q: value overflows 128 bits", id)
+		}
+		if i < 10 {
+			ms = ms<<5 | int64(v)
+		}
+	}
+	return utc.UnixMilli(ms), nil
+}
+
+// KSUIDEpoch is the epoch of KSUID timestamps: 2014-05-13 16:53:20 UTC.
+var KSUIDEpoch = time.Unix(1400000000, 0).UTC()
+
+const (
+	ksuidLength   = 27
+	ksuidAlphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+)
+
+// FromKSUID returns the time at which the KSUID was generated. The KSUID must
+// be in its canonical 27-character base62 form. Its 160-bit value begins with
+// a 32-bit count of seconds since KSUIDEpoch.
+func FromKSUID(id string) (utc.Time, error) {
+	if len(id) != ksuidLength {
+		return utc.Time{}, fmt.Errorf("invalid KSUID %q: length %d, want %d", id, len(id), ksuidLength)
+	}
+
+	value := new(big.Int)
+	base := big.NewInt(int64(len(ksuidAlphabet)))
+	for i := 0; i < len(id); i++ {
+		v := strings.IndexByte(ksuidAlphabet, id[i])
+		if v < 0 {
+			return utc.Time{}, fmt.Errorf("invalid KSUID %q: invalid character %q", id, id[i])
+		}
+		value.Mul(value, base)
+		value.Add(value, big.NewInt(int64(v)))
+	}
+	if value.BitLen() > 160 {
+		return utc.Time{}, fmt.Errorf("invalid KSUID %q: value overflows 160 bits", id)
+	}
+
+	sec := value.Rsh(value, 128).Int64()
+	return utc.FromMoment(KSUIDEpoch.Add(time.Duration(sec) * time.Second)), nil
+}
+
+// upper returns the ASCII upper-case form of c.
+func upper(c byte) byte {
+	if 'a' <= c && c <= 'z' {
+		return c - ('a' - 'A')
+	}
+	return c
+}
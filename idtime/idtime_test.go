@@ -0,0 +1,130 @@
+package idtime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFromULID(t *testing.T) {
+	tests := []struct {
+		id       string
+		expected time.Time
+	}{
+		{"01ARZ3NDEKTSV4RRFFQ69G5FAV", time.Date(2016, time.July, 30, 23, 54, 10, 259000000, time.UTC)},
+		{"01arz3ndektsv4rrffq69g5fav", time.Date(2016, time.July, 30, 23, 54, 10, 259000000, time.UTC)},
+		{"00000000000000000000000000", time.Unix(0, 0).UTC()},
+		{"7ZZZZZZZZZZZZZZZZZZZZZZZZZ", time.UnixMilli(1<<48 - 1).UTC()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			got, err := FromULID(tt.id)
+			if err != nil {
+				t.Fatalf("FromULID() error = %v", err)
+			}
+			if !got.Equal(tt.expected) {
+				t.Errorf("FromULID() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFromULIDInvalid(t *testing.T) {
+	ids := []string{
+		"",
+		"01ARZ3NDEKTSV4RRFFQ69G5FA",
+		"01ARZ3NDEKTSV4RRFFQ69G5FAVX",
+		"01ARZ3NDEKTSV4RRFFQ69G5FAU",
+		"01ARZ3NDEKTSV4RRFFQ69G5FA!",
+		"80000000000000000000000000",
+	}
+
+	for _, id := range ids {
+		if _, err := FromULID(id); err == nil {
+			t.Errorf("FromULID(%q) expected error, got nil", id)
+		}
+	}
+}
+
+func TestFromKSUID(t *testing.T) {
+	tests := []struct {
+		id       string
+		expected time.Time
+	}{
+		{"0ujtsYcgvSTl8PAuAdqWYSMnLOv", time.Date(2017, time.October, 10, 4, 0, 47, 0, time.UTC)},
+		{"000000000000000000000000000", time.Date(2014, time.May, 13, 16, 53, 20, 0, time.UTC)},
+		{"aWgEPTl1tmebfsQzFP4bxwgy80V", time.Unix(1400000000+1<<32-1, 0).UTC()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			got, err := FromKSUID(tt.id)
+			if err != nil {
+				t.Fatalf("FromKSUID() error = %v", err)
+			}
+			if !got.Equal(tt.expected) {
+				t.Errorf("FromKSUID() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFromKSUIDInvalid(t *testing.T) {
+	ids := []string{
+		"",
+		"0ujtsYcgvSTl8PAuAdqWYSMnLO",
+		"0ujtsYcgvSTl8PAuAdqWYSMnLOv0",
+		"0ujtsYcgvSTl8PAuAdqWYSMnLO-",
+		"aWgEPTl1tmebfsQzFP4bxwgy80W",
+		"zzzzzzzzzzzzzzzzzzzzzzzzzzz",
+	}
+
+	for _, id := range ids {
+		if _, err := FromKSUID(id); err == nil {
+			t.Errorf("FromKSUID(%q) expected error, got nil", id)
+		}
+	}
+}
+
+func TestFromSnowflake(t *testing.T) {
+	tests := []struct {
+		name     string
+		id       uint64
+		epoch    time.Time
+		expected time.Time
+	}{
+		{
+			name:     "Discord",
+			id:       175928847299117063,
+			epoch:    DiscordEpoch,
+			expected: time.Date(2016, time.April, 30, 11, 18, 25, 796000000, time.UTC),
+		},
+		{
+			name:     "Twitter",
+			id:       1212092628029698048,
+			epoch:    TwitterEpoch,
+			expected: time.Date(2019, time.December, 31, 19, 26, 16, 771000000, time.UTC),
+		},
+		{
+			name:     "custom epoch",
+			id:       1000 << 22,
+			epoch:    time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+			expected: time.Date(2024, time.January, 1, 0, 0, 1, 0, time.UTC),
+		},
+		{
+			name:     "zero ID is the epoch",
+			id:       0,
+			epoch:    DiscordEpoch,
+			expected: time.Date(2015, time.January, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FromSnowflake(tt.id, tt.epoch)
+			if !got.Equal(tt.expected) {
+				t.Errorf("FromSnowflake() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+/*
+Package tzoverride holds the locations that meridiantest.OverrideLocation
+substitutes for timezone types during tests. It is internal so that only the
+meridiantest package can install overrides; the meridian package only reads
+them.
+*/
+package tzoverride
+
+import (
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+var (
+	// active counts the installed overrides, so that Active costs a single
+	// atomic load when there are none.
+	active atomic.Int32
+
+	mu        sync.RWMutex
+	locations = make(map[reflect.Type]*time.Location)
+)
+
+// Active reports whether any override is installed. Callers check it before
+// Lookup to keep the common case cheap.
+func Active() bool {
+	return active.Load() != 0
+}
+
+// Lookup returns the location installed for the timezone type tz, if any.
+func Lookup(tz reflect.Type) (*time.Location, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	loc, ok := locations[tz]
+	return loc, ok
+}
+
+// Set installs loc as the location of the timezone type tz. The returned
+// function reinstates whatever was installed before, so overrides nest.
+func Set(tz reflect.Type, loc *time.Location) (restore func()) {
+	mu.Lock()
+	defer mu.Unlock()
+	prev, hadPrev := locations[tz]
+	locations[tz] = loc
+	if !hadPrev {
+		active.Add(1)
+	}
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			mu.Lock()
+			defer mu.Unlock()
+			if hadPrev {
+				locations[tz] = prev
+				return
+			}
+			delete(locations, tz)
+			active.Add(-1)
+		})
+	}
+}
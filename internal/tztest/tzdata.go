@@ -0,0 +1,64 @@
+package tztest
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+// TokyoAsNewYork returns a zoneinfo.zip archive, with version 2099z, whose
+// America/New_York entry holds the rules of Asia/Tokyo, as if New York had
+// moved to UTC+9 without daylight saving time. Pass it to meridian.Reload to
+// tell reloaded rules apart from the system ones. It skips t if the Go
+// installation has no zoneinfo.zip.
+func TokyoAsNewYork(t *testing.T) []byte {
+	t.Helper()
+	archive, err := os.ReadFile(filepath.Join(runtime.GOROOT(), "lib", "time", "zoneinfo.zip"))
+	if err != nil {
+		t.Skipf("zoneinfo.zip not available: %v", err)
+	}
+	r, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var tokyo []byte
+	for _, f := range r.File {
+		if f.Name == "Asia/Tokyo" {
+			rc, err := f.Open()
+			if err != nil {
+				t.Fatal(err)
+			}
+			tokyo, err = io.ReadAll(rc)
+			rc.Close()
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	if tokyo == nil {
+		t.Skip("zoneinfo.zip has no Asia/Tokyo")
+	}
+
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for name, content := range map[string][]byte{
+		"+VERSION":         []byte("2099z\n"),
+		"America/New_York": tokyo,
+	} {
+		f, err := w.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := f.Write(content); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
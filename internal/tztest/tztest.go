@@ -0,0 +1,236 @@
+/*
+Package tztest is the shared test kit for the generated timezone packages.
+
+Each generated package's tests call into this package rather than carrying
+their own copy of the test logic, so a fix here applies to every zone:
+
+	func TestStandardSuite(t *testing.T) {
+		tztest.RunStandardSuite(t, Timezone{}, "America/New_York")
+	}
+
+	func TestConstructors(t *testing.T) {
+		tztest.RunConstructorSuite(t, tztest.Constructors[Timezone]{
+			Now:  Now,
+			Date: Date,
+			...
+		})
+	}
+*/
+package tztest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matthalp/go-meridian/v2"
+)
+
+// utcZone and pacificZone are reference timezones for conversion tests. The
+// kit cannot import the generated utc and pt packages, whose own tests import
+// the kit.
+type (
+	utcZone     struct{}
+	pacificZone struct{}
+)
+
+func (utcZone) Location() *time.Location { return time.UTC }
+
+var pacific = mustLoadLocation("America/Los_Angeles")
+
+func (pacificZone) Location() *time.Location { return pacific }
+
+func mustLoadLocation(name string) *time.Location {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		panic(err)
+	}
+	return loc
+}
+
+// RunStandardSuite runs the tests shared by every timezone package against
+// TZ, whose location must be named location.
+func RunStandardSuite[TZ meridian.Timezone](t *testing.T, tz TZ, location string) {
+	t.Helper()
+
+	t.Run("Location", func(t *testing.T) {
+		if got := tz.Location().String(); got != location {
+			t.Errorf("Timezone.Location() = %v, want %v", got, location)
+		}
+	})
+
+	t.Run("Date", func(t *testing.T) {
+		tzTime := meridian.Date[TZ](2024, time.January, 1, 12, 0, 0, 0)
+		if hour := tzTime.UTC().In(tz.Location()).Hour(); hour != 12 {
+			t.Errorf("Date() hour in %s = %v, want 12", location, hour)
+		}
+	})
+
+	t.Run("FromMoment", func(t *testing.T) {
+		moments := map[string]meridian.Moment{
+			"time.Time": time.Date(2024, time.January, 15, 17, 0, 0, 0, time.UTC),
+			"UTC":       meridian.Date[utcZone](2024, time.January, 15, 17, 0, 0, 0),
+			"PT":        meridian.Date[pacificZone](2024, time.January, 15, 9, 0, 0, 0),
+		}
+		for name, m := range moments {
+			if got := meridian.FromMoment[TZ](m); !got.UTC().Equal(m.UTC()) {
+				t.Errorf("FromMoment(%s) UTC = %v, want %v", name, got.UTC(), m.UTC())
+			}
+		}
+	})
+
+	// Sampling January and July at a quarter to the hour catches offsets
+	// that are not whole hours, such as UTC+09:30, in both hemispheres.
+	t.Run("offset", func(t *testing.T) {
+		for _, month := range []time.Month{time.January, time.July} {
+			tzTime := meridian.Date[TZ](2024, month, 15, 12, 45, 0, 0)
+			name, offset := tzTime.Zone()
+			wantName, wantOffset := time.Date(2024, month, 15, 12, 45, 0, 0, tz.Location()).Zone()
+			if name != wantName || offset != wantOffset {
+				t.Errorf("Zone() in %v = %s %d, want %s %d", month, name, offset, wantName, wantOffset)
+			}
+			if got := tzTime.UTC().Add(time.Duration(offset) * time.Second).Format("15:04"); got != "12:45" {
+				t.Errorf("UTC plus offset in %v = %s, want 12:45", month, got)
+			}
+		}
+	})
+
+	t.Run("round trip conversion", func(t *testing.T) {
+		original := meridian.Date[TZ](2024, time.January, 15, 14, 30, 0, 0)
+		viaUTC := meridian.FromMoment[TZ](meridian.FromMoment[utcZone](original))
+
+		if !viaUTC.Equal(original) {
+			t.Error("Round trip conversion changed the moment in time")
+		}
+		if got, want := viaUTC.Format(time.RFC3339), original.Format(time.RFC3339); got != want {
+			t.Errorf("Round trip format = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("Parse", func(t *testing.T) {
+		parsed, err := meridian.Parse[TZ]("2006-01-02 15:04:05", "2024-01-15 12:00:00")
+		if err != nil {
+			t.Fatalf("Parse() error = %v", err)
+		}
+		if want := meridian.Date[TZ](2024, time.January, 15, 12, 0, 0, 0); !parsed.Equal(want) {
+			t.Errorf("Parse() = %v, want %v", parsed, want)
+		}
+
+		if _, err := meridian.Parse[TZ](time.RFC3339, "invalid-time-string"); err == nil {
+			t.Error("Parse() expected error for invalid input, got nil")
+		}
+	})
+
+	// Zones whose mid-July offset is zero read clock times as UTC does.
+	if _, offset := time.Date(2024, time.July, 15, 12, 0, 0, 0, tz.Location()).Zone(); offset != 0 {
+		t.Run("timezone specific interpretation", func(t *testing.T) {
+			tzParsed, err := meridian.Parse[TZ]("2006-01-02 15:04:05", "2024-07-15 12:00:00")
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			utcParsed, err := meridian.Parse[utcZone]("2006-01-02 15:04:05", "2024-07-15 12:00:00")
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if tzParsed.Equal(utcParsed) {
+				t.Errorf("%s and UTC parse of same clock time should be different moments", location)
+			}
+		})
+	}
+}
+
+// CheckAbbreviations verifies that TZ uses the standard and daylight saving
+// time abbreviations recorded by its package, sampling June and December of
+// 2025 to cover both hemispheres.
+func CheckAbbreviations[TZ meridian.Timezone](t *testing.T, tz TZ, standard, daylight string) {
+	t.Helper()
+
+	for _, month := range []time.Month{time.June, time.December} {
+		tzTime := time.Date(2025, month, 1, 12, 0, 0, 0, tz.Location())
+		name, _ := tzTime.Zone()
+
+		want := standard
+		if tzTime.IsDST() {
+			want = daylight
+		}
+		if name != want {
+			t.Errorf("Zone() in %v = %q, want %q", month, name, want)
+		}
+	}
+}
+
+// Constructors are a timezone package's constructor functions.
+type Constructors[TZ meridian.Timezone] struct {
+	Now        func() meridian.Time[TZ]
+	Date       func(year int, month time.Month, day, hour, minute, sec, nsec int) meridian.Time[TZ]
+	FromMoment func(m meridian.Moment) meridian.Time[TZ]
+	Parse      func(layout, value string) (meridian.Time[TZ], error)
+	Unix       func(sec, nsec int64) meridian.Time[TZ]
+	UnixMilli  func(msec int64) meridian.Time[TZ]
+	UnixMicro  func(usec int64) meridian.Time[TZ]
+}
+
+// RunConstructorSuite verifies that a package's constructors agree with the
+// corresponding generic meridian functions.
+func RunConstructorSuite[TZ meridian.Timezone](t *testing.T, c Constructors[TZ]) {
+	t.Helper()
+
+	t.Run("Now", func(t *testing.T) {
+		before := time.Now()
+		got := c.Now()
+		after := time.Now()
+		if got.Before(before.Add(-time.Second)) || got.After(after.Add(time.Second)) {
+			t.Errorf("Now() = %v, want between %v and %v", got, before, after)
+		}
+	})
+
+	t.Run("Date", func(t *testing.T) {
+		got := c.Date(2024, time.January, 15, 12, 0, 0, 0)
+		if want := meridian.Date[TZ](2024, time.January, 15, 12, 0, 0, 0); !got.Equal(want) {
+			t.Errorf("Date() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("FromMoment", func(t *testing.T) {
+		moment := time.Date(2024, time.January, 15, 17, 0, 0, 0, time.UTC)
+		if got := c.FromMoment(moment); !got.UTC().Equal(moment) {
+			t.Errorf("FromMoment() UTC = %v, want %v", got.UTC(), moment)
+		}
+	})
+
+	t.Run("Parse", func(t *testing.T) {
+		got, err := c.Parse("2006-01-02 15:04:05", "2024-01-15 12:00:00")
+		if err != nil {
+			t.Fatalf("Parse() error = %v", err)
+		}
+		if want := meridian.Date[TZ](2024, time.January, 15, 12, 0, 0, 0); !got.Equal(want) {
+			t.Errorf("Parse() = %v, want %v", got, want)
+		}
+		if _, err := c.Parse(time.RFC3339, "invalid-time-string"); err == nil {
+			t.Error("Parse() expected error for invalid input, got nil")
+		}
+	})
+
+	t.Run("Unix", func(t *testing.T) {
+		for _, sec := range []int64{0, 1705320000} {
+			if got := c.Unix(sec, 0); !got.UTC().Equal(time.Unix(sec, 0)) {
+				t.Errorf("Unix(%d, 0) UTC = %v, want %v", sec, got.UTC(), time.Unix(sec, 0).UTC())
+			}
+		}
+	})
+
+	t.Run("UnixMilli", func(t *testing.T) {
+		for _, msec := range []int64{1705320000000, 1705320000123} {
+			if got := c.UnixMilli(msec); !got.UTC().Equal(time.UnixMilli(msec)) {
+				t.Errorf("UnixMilli(%d) UTC = %v, want %v", msec, got.UTC(), time.UnixMilli(msec).UTC())
+			}
+		}
+	})
+
+	t.Run("UnixMicro", func(t *testing.T) {
+		for _, usec := range []int64{1705320000000000, 1705320000123456} {
+			if got := c.UnixMicro(usec); !got.UTC().Equal(time.UnixMicro(usec)) {
+				t.Errorf("UnixMicro(%d) UTC = %v, want %v", usec, got.UTC(), time.UnixMicro(usec).UTC())
+			}
+		}
+	})
+}
@@ -0,0 +1,50 @@
+package tztest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matthalp/go-meridian/v2"
+)
+
+var (
+	tokyo    = mustLoadLocation("Asia/Tokyo")
+	adelaide = mustLoadLocation("Australia/Adelaide")
+)
+
+type (
+	tokyoZone    struct{}
+	adelaideZone struct{}
+)
+
+func (tokyoZone) Location() *time.Location    { return tokyo }
+func (adelaideZone) Location() *time.Location { return adelaide }
+
+func TestRunStandardSuite(t *testing.T) {
+	t.Run("Asia/Tokyo", func(t *testing.T) {
+		RunStandardSuite(t, tokyoZone{}, "Asia/Tokyo")
+	})
+	t.Run("UTC", func(t *testing.T) {
+		RunStandardSuite(t, utcZone{}, "UTC")
+	})
+	t.Run("Australia/Adelaide", func(t *testing.T) {
+		RunStandardSuite(t, adelaideZone{}, "Australia/Adelaide")
+	})
+}
+
+func TestCheckAbbreviations(t *testing.T) {
+	CheckAbbreviations(t, tokyoZone{}, "JST", "")
+	CheckAbbreviations(t, pacificZone{}, "PST", "PDT")
+}
+
+func TestRunConstructorSuite(t *testing.T) {
+	RunConstructorSuite(t, Constructors[tokyoZone]{
+		Now:        meridian.Now[tokyoZone],
+		Date:       meridian.Date[tokyoZone],
+		FromMoment: meridian.FromMoment[tokyoZone],
+		Parse:      meridian.Parse[tokyoZone],
+		Unix:       meridian.Unix[tokyoZone],
+		UnixMilli:  meridian.UnixMilli[tokyoZone],
+		UnixMicro:  meridian.UnixMicro[tokyoZone],
+	})
+}
@@ -0,0 +1,185 @@
+package meridian
+
+import (
+	"sort"
+	"time"
+)
+
+// Interval is the half-open span of time from Start up to, but not including,
+// End, in timezone TZ. An interval whose End is not after its Start is empty.
+type Interval[TZ Timezone] struct {
+	Start, End Time[TZ]
+}
+
+// Duration returns the length of i, or zero if i is empty.
+func (i Interval[TZ]) Duration() time.Duration {
+	if i.IsEmpty() {
+		return 0
+	}
+	return i.End.Sub(i.Start)
+}
+
+// IsEmpty reports whether i contains no instants.
+func (i Interval[TZ]) IsEmpty() bool {
+	return CompareTime(i.End, i.Start) <= 0
+}
+
+// Contains reports whether m is within i. The parameter m can be any Moment
+// (time.Time or Time[TZ]).
+func (i Interval[TZ]) Contains(m Moment) bool {
+	u := m.UTC()
+	return !u.Before(i.Start.utcTime) && u.Before(i.End.utcTime)
+}
+
+// Overlaps reports whether i and j have any instant in common.
+func (i Interval[TZ]) Overlaps(j Interval[TZ]) bool {
+	return !i.Intersect(j).IsEmpty()
+}
+
+// Intersect returns the instants common to i and j, which is empty if they do
+// not overlap.
+func (i Interval[TZ]) Intersect(j Interval[TZ]) Interval[TZ] {
+	if CompareTime(j.Start, i.Start) > 0 {
+		i.Start = j.Start
+	}
+	if CompareTime(j.End, i.End) < 0 {
+		i.End = j.End
+	}
+	return i
+}
+
+// String returns i formatted as "[start, end)".
+func (i Interval[TZ]) String() string {
+	return "[" + i.Start.String() + ", " + i.End.String() + ")"
+}
+
+// IntervalSet is a set of instants, such as the busy times of a calendar,
+// kept as sorted, disjoint intervals. Overlapping and adjacent intervals are
+// merged as they are added. The zero IntervalSet is empty and ready to use.
+//
+// IntervalSet values are immutable: methods return a new set rather than
+// modifying their receiver, so sets can be shared freely.
+type IntervalSet[TZ Timezone] struct {
+	intervals []Interval[TZ]
+}
+
+// NewIntervalSet returns the set of instants within any of intervals.
+func NewIntervalSet[TZ Timezone](intervals ...Interval[TZ]) IntervalSet[TZ] {
+	return normalizeIntervals(append([]Interval[TZ](nil), intervals...))
+}
+
+// Intervals returns the disjoint intervals of s in chronological order.
+func (s IntervalSet[TZ]) Intervals() []Interval[TZ] {
+	return append([]Interval[TZ](nil), s.intervals...)
+}
+
+// IsEmpty reports whether s contains no instants.
+func (s IntervalSet[TZ]) IsEmpty() bool {
+	return len(s.intervals) == 0
+}
+
+// Duration returns the total length of the intervals of s.
+func (s IntervalSet[TZ]) Duration() time.Duration {
+	var d time.Duration
+	for _, i := range s.intervals {
+		d += i.Duration()
+	}
+	return d
+}
+
+// Contains reports whether m is within s. The parameter m can be any Moment
+// (time.Time or Time[TZ]).
+func (s IntervalSet[TZ]) Contains(m Moment) bool {
+	u := m.UTC()
+	n := sort.Search(len(s.intervals), func(k int) bool {
+		return u.Before(s.intervals[k].End.utcTime)
+	})
+	return n < len(s.intervals) && s.intervals[n].Contains(u)
+}
+
+// Add returns the set of instants in s or i.
+func (s IntervalSet[TZ]) Add(i Interval[TZ]) IntervalSet[TZ] {
+	return NewIntervalSet(append(s.Intervals(), i)...)
+}
+
+// Subtract returns the set of instants in s but not in i.
+func (s IntervalSet[TZ]) Subtract(i Interval[TZ]) IntervalSet[TZ] {
+	return s.Difference(NewIntervalSet(i))
+}
+
+// Union returns the set of instants in s or t.
+func (s IntervalSet[TZ]) Union(t IntervalSet[TZ]) IntervalSet[TZ] {
+	return NewIntervalSet(append(s.Intervals(), t.intervals...)...)
+}
+
+// Intersect returns the set of instants in both s and t.
+func (s IntervalSet[TZ]) Intersect(t IntervalSet[TZ]) IntervalSet[TZ] {
+	var out []Interval[TZ]
+	for a, b := 0, 0; a < len(s.intervals) && b < len(t.intervals); {
+		if common := s.intervals[a].Intersect(t.intervals[b]); !common.IsEmpty() {
+			out = append(out, common)
+		}
+		// Advance past whichever interval ends first.
+		if CompareTime(s.intervals[a].End, t.intervals[b].End) < 0 {
+			a++
+		} else {
+			b++
+		}
+	}
+	return IntervalSet[TZ]{intervals: out}
+}
+
+// Difference returns the set of instants in s but not in t.
+func (s IntervalSet[TZ]) Difference(t IntervalSet[TZ]) IntervalSet[TZ] {
+	var out []Interval[TZ]
+	b := 0
+	for _, i := range s.intervals {
+		// Skip the intervals of t that end before i starts.
+		for b < len(t.intervals) && CompareTime(t.intervals[b].End, i.Start) <= 0 {
+			b++
+		}
+		for k := b; k < len(t.intervals) && CompareTime(t.intervals[k].Start, i.End) < 0; k++ {
+			if before := (Interval[TZ]{Start: i.Start, End: t.intervals[k].Start}); !before.IsEmpty() {
+				out = append(out, before)
+			}
+			if CompareTime(t.intervals[k].End, i.Start) > 0 {
+				i.Start = t.intervals[k].End
+			}
+		}
+		if !i.IsEmpty() {
+			out = append(out, i)
+		}
+	}
+	return IntervalSet[TZ]{intervals: out}
+}
+
+// Gaps returns the intervals within window that are not in s, in
+// chronological order, such as the free times between busy blocks.
+func (s IntervalSet[TZ]) Gaps(window Interval[TZ]) []Interval[TZ] {
+	return NewIntervalSet(window).Difference(s).intervals
+}
+
+// normalizeIntervals sorts intervals, dropping empty ones and merging those
+// that overlap or touch. It reuses the intervals slice.
+func normalizeIntervals[TZ Timezone](intervals []Interval[TZ]) IntervalSet[TZ] {
+	sort.Slice(intervals, func(a, b int) bool {
+		return CompareTime(intervals[a].Start, intervals[b].Start) < 0
+	})
+	out := intervals[:0]
+	for _, i := range intervals {
+		if i.IsEmpty() {
+			continue
+		}
+		if n := len(out); n > 0 && CompareTime(i.Start, out[n-1].End) <= 0 {
+			if CompareTime(i.End, out[n-1].End) > 0 {
+				out[n-1].End = i.End
+			}
+			continue
+		}
+		out = append(out, i)
+	}
+	if len(out) == 0 {
+		return IntervalSet[TZ]{}
+	}
+	return IntervalSet[TZ]{intervals: out}
+}
@@ -0,0 +1,125 @@
+package meridian
+
+import (
+	"testing"
+	"time"
+)
+
+// utcAt returns 9 a.m. plus h hours on July 1, 2024, UTC.
+func utcAt(h float64) Time[UTC] {
+	return Date[UTC](2024, time.July, 1, 9, 0, 0, 0).Add(time.Duration(h * float64(time.Hour)))
+}
+
+func utcSpan(start, end float64) Interval[UTC] {
+	return Interval[UTC]{Start: utcAt(start), End: utcAt(end)}
+}
+
+func equalIntervals(a, b []Interval[UTC]) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k := range a {
+		if !a[k].Start.Equal(b[k].Start) || !a[k].End.Equal(b[k].End) {
+			return false
+		}
+	}
+	return true
+}
+
+func TestInterval(t *testing.T) {
+	i := utcSpan(0, 2)
+
+	if got := i.Duration(); got != 2*time.Hour {
+		t.Errorf("Duration() = %v, want 2h", got)
+	}
+	if i.IsEmpty() || !utcSpan(1, 1).IsEmpty() || !utcSpan(2, 1).IsEmpty() {
+		t.Error("IsEmpty() is wrong")
+	}
+	if got := utcSpan(2, 1).Duration(); got != 0 {
+		t.Errorf("Duration() of an empty interval = %v, want 0", got)
+	}
+	if !i.Contains(utcAt(0)) || !i.Contains(utcAt(1.5)) || i.Contains(utcAt(2)) || i.Contains(utcAt(-1)) {
+		t.Error("Contains() is wrong at the boundaries")
+	}
+	if !i.Contains(FromMoment[EST](utcAt(1))) {
+		t.Error("Contains() of the same instant in another zone = false")
+	}
+	if !i.Overlaps(utcSpan(1, 3)) || i.Overlaps(utcSpan(2, 3)) {
+		t.Error("Overlaps() is wrong")
+	}
+	if got := i.Intersect(utcSpan(1, 3)); got != utcSpan(1, 2) {
+		t.Errorf("Intersect() = %v, want %v", got, utcSpan(1, 2))
+	}
+}
+
+func TestNewIntervalSet(t *testing.T) {
+	s := NewIntervalSet(utcSpan(4, 5), utcSpan(0, 1), utcSpan(0.5, 2), utcSpan(2, 3), utcSpan(6, 6))
+	want := []Interval[UTC]{utcSpan(0, 3), utcSpan(4, 5)}
+	if got := s.Intervals(); !equalIntervals(got, want) {
+		t.Errorf("NewIntervalSet() = %v, want %v", got, want)
+	}
+	if got := s.Duration(); got != 4*time.Hour {
+		t.Errorf("Duration() = %v, want 4h", got)
+	}
+
+	var empty IntervalSet[UTC]
+	if !empty.IsEmpty() || empty.Contains(utcAt(0)) {
+		t.Error("zero IntervalSet is not empty")
+	}
+}
+
+func TestIntervalSetContains(t *testing.T) {
+	s := NewIntervalSet(utcSpan(0, 1), utcSpan(2, 3))
+	for h, want := range map[float64]bool{-1: false, 0: true, 0.5: true, 1: false, 1.5: false, 2: true, 3: false} {
+		if got := s.Contains(utcAt(h)); got != want {
+			t.Errorf("Contains(%v) = %v, want %v", utcAt(h), got, want)
+		}
+	}
+}
+
+func TestIntervalSetAlgebra(t *testing.T) {
+	busy := NewIntervalSet(utcSpan(0, 1), utcSpan(3, 5))
+	other := NewIntervalSet(utcSpan(0.5, 4), utcSpan(6, 7))
+
+	tests := []struct {
+		name string
+		got  IntervalSet[UTC]
+		want []Interval[UTC]
+	}{
+		{"Add", busy.Add(utcSpan(1, 2)), []Interval[UTC]{utcSpan(0, 2), utcSpan(3, 5)}},
+		{"Add bridging", busy.Add(utcSpan(0.5, 3.5)), []Interval[UTC]{utcSpan(0, 5)}},
+		{"Subtract middle", busy.Subtract(utcSpan(3.5, 4)), []Interval[UTC]{utcSpan(0, 1), utcSpan(3, 3.5), utcSpan(4, 5)}},
+		{"Subtract across", busy.Subtract(utcSpan(0.5, 4)), []Interval[UTC]{utcSpan(0, 0.5), utcSpan(4, 5)}},
+		{"Subtract everything", busy.Subtract(utcSpan(-1, 6)), nil},
+		{"Union", busy.Union(other), []Interval[UTC]{utcSpan(0, 5), utcSpan(6, 7)}},
+		{"Intersect", busy.Intersect(other), []Interval[UTC]{utcSpan(0.5, 1), utcSpan(3, 4)}},
+		{"Difference", busy.Difference(other), []Interval[UTC]{utcSpan(0, 0.5), utcSpan(4, 5)}},
+		{"Difference multiple holes", NewIntervalSet(utcSpan(0, 10)).Difference(busy), []Interval[UTC]{utcSpan(1, 3), utcSpan(5, 10)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.got.Intervals(); !equalIntervals(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// The receivers are unchanged.
+	if got := busy.Intervals(); !equalIntervals(got, []Interval[UTC]{utcSpan(0, 1), utcSpan(3, 5)}) {
+		t.Errorf("busy was modified: %v", got)
+	}
+}
+
+func TestIntervalSetGaps(t *testing.T) {
+	busy := NewIntervalSet(utcSpan(1, 2), utcSpan(3, 4), utcSpan(7, 9))
+
+	got := busy.Gaps(utcSpan(0, 8))
+	want := []Interval[UTC]{utcSpan(0, 1), utcSpan(2, 3), utcSpan(4, 7)}
+	if !equalIntervals(got, want) {
+		t.Errorf("Gaps() = %v, want %v", got, want)
+	}
+
+	if got := busy.Gaps(utcSpan(1, 2)); len(got) != 0 {
+		t.Errorf("Gaps() of a busy window = %v, want none", got)
+	}
+}
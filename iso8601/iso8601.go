@@ -0,0 +1,333 @@
+/*
+Package iso8601 parses ISO 8601 date and time representations into meridian
+typed times.
+
+The time package's layouts cannot express most of the ISO 8601 grammar.
+Parse accepts the complete and reduced representations in both the basic and
+extended formats:
+
+	2024-06-15        20240615        calendar date
+	2024-167          2024167         ordinal date
+	2024-W24-6        2024W246        week date
+	2024-W24          2024W24         week (Monday)
+	2024-06  2024                     reduced calendar precision
+
+optionally followed by "T" and a time of day, with a decimal fraction (using
+either "." or ",") on the smallest unit, and an optional UTC designator or
+offset:
+
+	2024-06-15T14:30:00Z
+	20240615T143000,5+0200
+	2024-W24-6T14:30.25-05:00
+	2024-167T14.5
+
+Values without a UTC designator or offset are interpreted in the requested
+timezone's location:
+
+	t, err := iso8601.Parse[et.Timezone]("2024-W24-6T09:00")
+	// 2024-06-15 09:00 EDT
+*/
+package iso8601
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/matthalp/go-meridian/v2"
+	"github.com/matthalp/go-meridian/v2/timezones/utc"
+)
+
+// ParseError describes a value that is not a valid ISO 8601 representation.
+type ParseError struct {
+	Value  string // the value being parsed
+	Reason string // why the value is invalid
+}
+
+// Error implements the error interface.
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("invalid ISO 8601 value %q: %s", e.Value, e.Reason)
+}
+
+// date is a calendar date resolved from any of the date representations.
+type date struct {
+	year  int
+	month time.Month
+	day   int
+}
+
+// Parse parses an ISO 8601 date or date-time representation and returns the
+// instant it represents in the specified timezone. Date-only values represent
+// midnight in the timezone's location.
+func Parse[TZ meridian.Timezone](s string) (meridian.Time[TZ], error) {
+	datePart, timePart, hasTime := strings.Cut(s, "T")
+
+	d, err := parseDate(datePart)
+	if err != nil {
+		return meridian.Time[TZ]{}, &ParseError{Value: s, Reason: err.Error()}
+	}
+	if !hasTime {
+		return meridian.Date[TZ](d.year, d.month, d.day, 0, 0, 0, 0), nil
+	}
+
+	clock, offset, err := splitOffset(timePart)
+	if err != nil {
+		return meridian.Time[TZ]{}, &ParseError{Value: s, Reason: err.Error()}
+	}
+	elapsed, err := parseClock(clock)
+	if err != nil {
+		return meridian.Time[TZ]{}, &ParseError{Value: s, Reason: err.Error()}
+	}
+
+	// time.Date normalizes the nanoseconds into wall-clock fields before
+	// applying the zone, so local times are correct across DST transitions.
+	if offset == nil {
+		return meridian.Date[TZ](d.year, d.month, d.day, 0, 0, 0, int(elapsed)), nil
+	}
+	t := time.Date(d.year, d.month, d.day, 0, 0, 0, int(elapsed), offset)
+	return meridian.FromMoment[TZ](t), nil
+}
+
+// parseDate parses any of the supported date representations.
+func parseDate(s string) (date, error) {
+	if len(s) < 4 {
+		return date{}, fmt.Errorf("date %q is too short", s)
+	}
+	year, err := atoi(s[:4], "year")
+	if err != nil {
+		return date{}, err
+	}
+	rest := strings.TrimPrefix(s[4:], "-")
+	extended := len(rest) < len(s)-4
+
+	switch {
+	case rest == "":
+		return date{year: year, month: time.January, day: 1}, nil
+	case rest[0] == 'W':
+		return parseWeekDate(year, rest[1:], extended)
+	case len(rest) == 3:
+		return parseOrdinalDate(year, rest)
+	default:
+		return parseCalendarDate(year, rest, extended)
+	}
+}
+
+// parseCalendarDate parses the MM-DD, MMDD, or (extended only) MM portion of a
+// calendar date.
+func parseCalendarDate(year int, s string, extended bool) (date, error) {
+	var monthText, dayText string
+	switch {
+	case extended && len(s) == 2:
+		monthText, dayText = s, "01"
+	case extended && len(s) == 5 && s[2] == '-':
+		monthText, dayText = s[:2], s[3:]
+	case !extended && len(s) == 4:
+		monthText, dayText = s[:2], s[2:]
+	default:
+		return date{}, fmt.Errorf("malformed date")
+	}
+
+	month, err := atoi(monthText, "month")
+	if err != nil {
+		return date{}, err
+	}
+	day, err := atoi(dayText, "day")
+	if err != nil {
+		return date{}, err
+	}
+	if month < 1 || month > 12 {
+		return date{}, fmt.Errorf("month %d out of range", month)
+	}
+	if day < 1 || day > daysIn(year, time.Month(month)) {
+		return date{}, fmt.Errorf("day %d out of range", day)
+	}
+	return date{year: year, month: time.Month(month), day: day}, nil
+}
+
+// parseOrdinalDate parses the DDD portion of an ordinal date.
+func parseOrdinalDate(year int, s string) (date, error) {
+	yday, err := atoi(s, "day of year")
+	if err != nil {
+		return date{}, err
+	}
+	daysInYear := 365
+	if daysIn(year, time.February) == 29 {
+		daysInYear = 366
+	}
+	if yday < 1 || yday > daysInYear {
+		return date{}, fmt.Errorf("day of year %d out of range", yday)
+	}
+	t := time.Date(year, time.January, yday, 0, 0, 0, 0, time.UTC)
+	return date{year: t.Year(), month: t.Month(), day: t.Day()}, nil
+}
+
+// parseWeekDate parses the ww-D, wwD, or ww portion of a week date following
+// the "W" designator.
+func parseWeekDate(year int, s string, extended bool) (date, error) {
+	weekText, dayText := s, "1"
+	switch {
+	case len(s) == 2:
+	case extended && len(s) == 4 && s[2] == '-':
+		weekText, dayText = s[:2], s[3:]
+	case !extended && len(s) == 3:
+		weekText, dayText = s[:2], s[2:]
+	default:
+		return date{}, fmt.Errorf("malformed week date")
+	}
+
+	week, err := atoi(weekText, "week")
+	if err != nil {
+		return date{}, err
+	}
+	weekday, err := atoi(dayText, "weekday")
+	if err != nil {
+		return date{}, err
+	}
+	if weekday < 1 || weekday > 7 {
+		return date{}, fmt.Errorf("weekday %d out of range", weekday)
+	}
+
+	// FromISOWeek normalizes out-of-range weeks, so reject any that do not
+	// round-trip.
+	t := meridian.FromISOWeek[utc.Timezone](year, week, time.Weekday(weekday%7))
+	if y, w := t.ISOWeek(); y != year || w != week {
+		return date{}, fmt.Errorf("week %d out of range", week)
+	}
+	return date{year: t.Year(), month: t.Month(), day: t.Day()}, nil
+}
+
+// splitOffset separates a trailing UTC designator or offset from a time of day.
+// It returns a nil location if the time has no offset.
+func splitOffset(s string) (clock string, loc *time.Location, err error) {
+	if strings.HasSuffix(s, "Z") {
+		return s[:len(s)-1], time.UTC, nil
+	}
+	i := strings.LastIndexAny(s, "+-")
+	if i < 0 {
+		return s, nil, nil
+	}
+
+	sign := 1
+	if s[i] == '-' {
+		sign = -1
+	}
+	offset := strings.Replace(s[i+1:], ":", "", 1)
+	var hours, minutes int
+	switch len(offset) {
+	case 2:
+		hours, err = atoi(offset, "offset hour")
+	case 4:
+		hours, err = atoi(offset[:2], "offset hour")
+		if err == nil {
+			minutes, err = atoi(offset[2:], "offset minute")
+		}
+	default:
+		return "", nil, fmt.Errorf("malformed offset %q", s[i:])
+	}
+	if err != nil {
+		return "", nil, err
+	}
+	if hours > 23 || minutes > 59 {
+		return "", nil, fmt.Errorf("offset %q out of range", s[i:])
+	}
+	seconds := sign * (hours*3600 + minutes*60)
+	if seconds == 0 {
+		return s[:i], time.UTC, nil
+	}
+	return s[:i], time.FixedZone("", seconds), nil
+}
+
+// parseClock parses a time of day in the basic or extended format, returning
+// the time elapsed since midnight. A decimal fraction may follow the last unit.
+func parseClock(s string) (time.Duration, error) {
+	clock, fraction, hasFraction := cutAny(s, ".,")
+
+	var fields []string
+	if strings.Contains(clock, ":") {
+		fields = strings.Split(clock, ":")
+	} else {
+		for ; len(clock) > 2; clock = clock[2:] {
+			fields = append(fields, clock[:2])
+		}
+		fields = append(fields, clock)
+	}
+	if len(fields) == 0 || len(fields) > len(clockUnits) {
+		return 0, fmt.Errorf("malformed time %q", s)
+	}
+
+	var elapsed time.Duration
+	for i, field := range fields {
+		u := clockUnits[i]
+		if len(field) != 2 {
+			return 0, fmt.Errorf("malformed time %q", s)
+		}
+		v, err := atoi(field, u.name)
+		if err != nil {
+			return 0, err
+		}
+		if v > u.max {
+			return 0, fmt.Errorf("%s %d out of range", u.name, v)
+		}
+		elapsed += time.Duration(v) * u.unit
+	}
+
+	if hasFraction {
+		f, err := parseFraction(fraction, clockUnits[len(fields)-1].unit)
+		if err != nil {
+			return 0, err
+		}
+		elapsed += f
+	}
+
+	// 24:00 denotes the end of the day and is only valid without a remainder.
+	if fields[0] == "24" && elapsed != 24*time.Hour {
+		return 0, fmt.Errorf("time %q out of range", s)
+	}
+	return elapsed, nil
+}
+
+// clockUnits are the fields of a time of day, in order.
+var clockUnits = []struct {
+	name string
+	unit time.Duration
+	max  int
+}{
+	{"hour", time.Hour, 24},
+	{"minute", time.Minute, 59},
+	{"second", time.Second, 59},
+}
+
+// parseFraction converts the digits of a decimal fraction of unit to a duration.
+func parseFraction(digits string, unit time.Duration) (time.Duration, error) {
+	if digits == "" || strings.Trim(digits, "0123456789") != "" {
+		return 0, fmt.Errorf("malformed fraction %q", digits)
+	}
+	f, err := strconv.ParseFloat("0."+digits, 64)
+	if err != nil {
+		return 0, fmt.Errorf("malformed fraction %q", digits)
+	}
+	return time.Duration(math.Round(f * float64(unit))), nil
+}
+
+// cutAny slices s around the first instance of any byte in chars.
+func cutAny(s, chars string) (before, after string, found bool) {
+	if i := strings.IndexAny(s, chars); i >= 0 {
+		return s[:i], s[i+1:], true
+	}
+	return s, "", false
+}
+
+// atoi parses a fixed-width field of ASCII digits.
+func atoi(s, field string) (int, error) {
+	if s == "" || strings.Trim(s, "0123456789") != "" {
+		return 0, fmt.Errorf("malformed %s %q", field, s)
+	}
+	return strconv.Atoi(s)
+}
+
+// daysIn returns the number of days in the month of year.
+func daysIn(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
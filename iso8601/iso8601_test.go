@@ -0,0 +1,152 @@
+package iso8601
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/matthalp/go-meridian/v2/timezones/et"
+	"github.com/matthalp/go-meridian/v2/timezones/utc"
+)
+
+func TestParseDates(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected time.Time
+	}{
+		{"2024-06-15", time.Date(2024, time.June, 15, 0, 0, 0, 0, time.UTC)},
+		{"20240615", time.Date(2024, time.June, 15, 0, 0, 0, 0, time.UTC)},
+		{"2024-06", time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC)},
+		{"2024", time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		{"2024-167", time.Date(2024, time.June, 15, 0, 0, 0, 0, time.UTC)},
+		{"2024167", time.Date(2024, time.June, 15, 0, 0, 0, 0, time.UTC)},
+		{"2024-366", time.Date(2024, time.December, 31, 0, 0, 0, 0, time.UTC)},
+		{"2024-W24-6", time.Date(2024, time.June, 15, 0, 0, 0, 0, time.UTC)},
+		{"2024W246", time.Date(2024, time.June, 15, 0, 0, 0, 0, time.UTC)},
+		{"2024-W24", time.Date(2024, time.June, 10, 0, 0, 0, 0, time.UTC)},
+		{"2024W24", time.Date(2024, time.June, 10, 0, 0, 0, 0, time.UTC)},
+		// Week 1 of 2025 starts in 2024; week 53 of 2020 ends in 2021.
+		{"2025-W01-1", time.Date(2024, time.December, 30, 0, 0, 0, 0, time.UTC)},
+		{"2020-W53-7", time.Date(2021, time.January, 3, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := Parse[utc.Timezone](tt.value)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if !got.Equal(tt.expected) {
+				t.Errorf("Parse() = %v, want %v", got.UTC(), tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseDateTimes(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected time.Time
+	}{
+		{"2024-06-15T14:30:45Z", time.Date(2024, time.June, 15, 14, 30, 45, 0, time.UTC)},
+		{"20240615T143045Z", time.Date(2024, time.June, 15, 14, 30, 45, 0, time.UTC)},
+		{"2024-06-15T14:30:45.123456789Z", time.Date(2024, time.June, 15, 14, 30, 45, 123456789, time.UTC)},
+		{"2024-06-15T14:30:45,5Z", time.Date(2024, time.June, 15, 14, 30, 45, 500000000, time.UTC)},
+		{"2024-06-15T14:30Z", time.Date(2024, time.June, 15, 14, 30, 0, 0, time.UTC)},
+		{"2024-06-15T14:30.25Z", time.Date(2024, time.June, 15, 14, 30, 15, 0, time.UTC)},
+		{"2024-06-15T14Z", time.Date(2024, time.June, 15, 14, 0, 0, 0, time.UTC)},
+		{"2024-06-15T14,75Z", time.Date(2024, time.June, 15, 14, 45, 0, 0, time.UTC)},
+		{"2024-06-15T16:30:45+02:00", time.Date(2024, time.June, 15, 14, 30, 45, 0, time.UTC)},
+		{"20240615T163045+0200", time.Date(2024, time.June, 15, 14, 30, 45, 0, time.UTC)},
+		{"2024-06-15T09:30:45-05", time.Date(2024, time.June, 15, 14, 30, 45, 0, time.UTC)},
+		{"2024-06-15T14:30:45+00:00", time.Date(2024, time.June, 15, 14, 30, 45, 0, time.UTC)},
+		{"2024-W24-6T14:30:45Z", time.Date(2024, time.June, 15, 14, 30, 45, 0, time.UTC)},
+		{"2024-167T14:30:45Z", time.Date(2024, time.June, 15, 14, 30, 45, 0, time.UTC)},
+		{"2024-06-15T24:00:00Z", time.Date(2024, time.June, 16, 0, 0, 0, 0, time.UTC)},
+		{"2024-06-15T24Z", time.Date(2024, time.June, 16, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := Parse[et.Timezone](tt.value)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if !got.Equal(tt.expected) {
+				t.Errorf("Parse() = %v, want %v", got.UTC(), tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseLocalTime(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected et.Time
+	}{
+		{"2024-06-15", et.Date(2024, time.June, 15, 0, 0, 0, 0)},
+		{"2024-W24-6T09:00", et.Date(2024, time.June, 15, 9, 0, 0, 0)},
+		{"20240115T093000", et.Date(2024, time.January, 15, 9, 30, 0, 0)},
+		// Wall-clock times after the spring-forward transition keep their clock reading.
+		{"2024-03-10T03:30", et.Date(2024, time.March, 10, 3, 30, 0, 0)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := Parse[et.Timezone](tt.value)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if !got.Equal(tt.expected) {
+				t.Errorf("Parse() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	values := []string{
+		"",
+		"24",
+		"2024-13-01",
+		"2024-02-30",
+		"2023-02-29",
+		"202406",
+		"2024-0615",
+		"2023-366",
+		"2024-000",
+		"2024-W54-1",
+		"2021-W53-1",
+		"2024-W24-8",
+		"2024-W24-0",
+		"2024-06-15T",
+		"2024-06-15T25:00",
+		"2024-06-15T14:60",
+		"2024-06-15T14:30:60",
+		"2024-06-15T24:00:01",
+		"2024-06-15T24:30",
+		"2024-06-15T1430:45",
+		"2024-06-15T14:30:45.",
+		"2024-06-15T14:30:45.x",
+		"2024-06-15T14:30:45+2",
+		"2024-06-15T14:30:45+24:00",
+		"2024-06-15T14:30:45+05:60",
+		"June 15, 2024",
+	}
+
+	for _, value := range values {
+		t.Run(value, func(t *testing.T) {
+			_, err := Parse[utc.Timezone](value)
+			if err == nil {
+				t.Fatalf("Parse(%q) expected error, got nil", value)
+			}
+			var parseErr *ParseError
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("Parse(%q) error = %T, want *ParseError", value, err)
+			}
+			if parseErr.Value != value {
+				t.Errorf("ParseError.Value = %q, want %q", parseErr.Value, value)
+			}
+		})
+	}
+}
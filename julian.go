@@ -0,0 +1,72 @@
+package meridian
+
+import (
+	"math"
+	"time"
+)
+
+// Julian day numbers count days, including fractional days, from noon
+// Universal Time on January 1, 4713 BC in the proleptic Julian calendar.
+// The modified Julian day starts at midnight UTC on November 17, 1858.
+const (
+	// unixEpochJulianDay is the Julian day of January 1, 1970 00:00 UTC.
+	unixEpochJulianDay = 2440587.5
+
+	// modifiedJulianDayOffset is the difference between a Julian day and
+	// the corresponding modified Julian day.
+	modifiedJulianDayOffset = 2400000.5
+
+	nanosecondsPerDay = 24 * int64(time.Hour)
+)
+
+// ToJulianDay returns t as a Julian day, the number of days elapsed since noon
+// UTC on January 1, 4713 BC (proleptic Julian calendar). Julian days are
+// defined in Universal Time, so the result does not depend on TZ.
+//
+// A float64 Julian day near the present is precise to roughly 40 microseconds.
+func (t Time[TZ]) ToJulianDay() float64 {
+	return unixEpochJulianDay + t.unixDays()
+}
+
+// ToModifiedJulianDay returns t as a modified Julian day (MJD), the number of
+// days elapsed since midnight UTC on November 17, 1858. It equals the Julian
+// day minus 2400000.5 but retains more precision for modern dates.
+func (t Time[TZ]) ToModifiedJulianDay() float64 {
+	return unixEpochJulianDay - modifiedJulianDayOffset + t.unixDays()
+}
+
+// FromJulianDay returns the Time corresponding to the Julian day jd, in the
+// specified timezone. The result is rounded to the nearest microsecond, which
+// is finer than the precision of a float64 Julian day.
+func FromJulianDay[TZ Timezone](jd float64) Time[TZ] {
+	return fromUnixDays[TZ](jd - unixEpochJulianDay)
+}
+
+// FromModifiedJulianDay returns the Time corresponding to the modified Julian
+// day mjd, in the specified timezone. The result is rounded to the nearest
+// microsecond.
+func FromModifiedJulianDay[TZ Timezone](mjd float64) Time[TZ] {
+	return fromUnixDays[TZ](mjd - (unixEpochJulianDay - modifiedJulianDayOffset))
+}
+
+// unixDays returns the number of days elapsed since the Unix epoch. Whole days
+// and the fraction of the day are computed separately to limit rounding error.
+func (t Time[TZ]) unixDays() float64 {
+	sec := t.utcTime.Unix()
+	days := sec / 86400
+	rem := sec % 86400
+	if rem < 0 {
+		days--
+		rem += 86400
+	}
+	nsec := rem*int64(time.Second) + int64(t.utcTime.Nanosecond())
+	return float64(days) + float64(nsec)/float64(nanosecondsPerDay)
+}
+
+// fromUnixDays returns the Time that is days after the Unix epoch, rounded to
+// the nearest microsecond.
+func fromUnixDays[TZ Timezone](days float64) Time[TZ] {
+	whole, frac := math.Modf(days)
+	nsec := int64(math.Round(frac*float64(nanosecondsPerDay/1000))) * 1000
+	return Unix[TZ](int64(whole)*86400, nsec)
+}
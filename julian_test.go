@@ -0,0 +1,95 @@
+package meridian
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestToJulianDay(t *testing.T) {
+	tests := []struct {
+		name string
+		time Time[UTC]
+		jd   float64
+		mjd  float64
+	}{
+		{
+			name: "Unix epoch",
+			time: Date[UTC](1970, time.January, 1, 0, 0, 0, 0),
+			jd:   2440587.5,
+			mjd:  40587,
+		},
+		{
+			name: "J2000.0 epoch",
+			time: Date[UTC](2000, time.January, 1, 12, 0, 0, 0),
+			jd:   2451545.0,
+			mjd:  51544.5,
+		},
+		{
+			name: "MJD epoch",
+			time: Date[UTC](1858, time.November, 17, 0, 0, 0, 0),
+			jd:   2400000.5,
+			mjd:  0,
+		},
+		{
+			name: "fractional day",
+			time: Date[UTC](2024, time.June, 15, 18, 0, 0, 0),
+			jd:   2460477.25,
+			mjd:  60476.75,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.time.ToJulianDay(); got != tt.jd {
+				t.Errorf("ToJulianDay() = %f, want %f", got, tt.jd)
+			}
+			if got := tt.time.ToModifiedJulianDay(); got != tt.mjd {
+				t.Errorf("ToModifiedJulianDay() = %f, want %f", got, tt.mjd)
+			}
+			if got := FromJulianDay[UTC](tt.jd); !got.Equal(tt.time) {
+				t.Errorf("FromJulianDay(%f) = %v, want %v", tt.jd, got, tt.time)
+			}
+			if got := FromModifiedJulianDay[UTC](tt.mjd); !got.Equal(tt.time) {
+				t.Errorf("FromModifiedJulianDay(%f) = %v, want %v", tt.mjd, got, tt.time)
+			}
+		})
+	}
+}
+
+func TestJulianDayIndependentOfTimezone(t *testing.T) {
+	ny := Date[EST](2024, time.June, 15, 14, 0, 0, 0)
+	la := FromMoment[PST](ny)
+
+	if ny.ToJulianDay() != la.ToJulianDay() {
+		t.Errorf("ToJulianDay() differs across timezones: %f vs %f", ny.ToJulianDay(), la.ToJulianDay())
+	}
+
+	got := FromJulianDay[PST](ny.ToJulianDay())
+	if !got.Equal(ny) {
+		t.Errorf("FromJulianDay() = %v, want %v", got.UTC(), ny.UTC())
+	}
+	if got.Location().String() != "America/Los_Angeles" {
+		t.Errorf("FromJulianDay() location = %v, want America/Los_Angeles", got.Location())
+	}
+}
+
+func TestJulianDayRoundTrip(t *testing.T) {
+	times := []Time[UTC]{
+		Date[UTC](2024, time.June, 15, 14, 30, 45, 123456000),
+		Date[UTC](1969, time.December, 31, 23, 59, 59, 999999000),
+		Date[UTC](1600, time.March, 1, 6, 15, 0, 0),
+	}
+
+	for _, want := range times {
+		got := FromJulianDay[UTC](want.ToJulianDay())
+		if d := got.Sub(want); math.Abs(float64(d)) > float64(50*time.Microsecond) {
+			t.Errorf("FromJulianDay(ToJulianDay(%v)) = %v, off by %v", want, got, d)
+		}
+
+		got = FromModifiedJulianDay[UTC](want.ToModifiedJulianDay())
+		if d := got.Sub(want); math.Abs(float64(d)) > float64(10*time.Microsecond) {
+			t.Errorf("FromModifiedJulianDay(ToModifiedJulianDay(%v)) = %v, off by %v", want, got, d)
+		}
+	}
+}
@@ -0,0 +1,90 @@
+/*
+Package kafkatime converts Kafka record timestamps to and from typed times,
+so stream processors keep zone-typed event times end to end.
+
+The Kafka protocol stores a record timestamp as milliseconds since the Unix
+epoch, with -1 meaning the record has none. FromKafkaTimestamp and
+ToKafkaTimestamp convert that wire value directly:
+
+	created := kafkatime.FromKafkaTimestamp[et.Timezone](ms)
+	ms := kafkatime.ToKafkaTimestamp(order.PlacedAt)
+
+Client libraries expose the timestamp as a time.Time instead. FromRecordTime
+and ToRecordTime convert those fields, treating the zero time and the
+decoded -1 sentinel alike as no timestamp. With github.com/twmb/franz-go:
+
+	client.Produce(ctx, &kgo.Record{
+		Topic:     "orders",
+		Value:     payload,
+		Timestamp: kafkatime.ToRecordTime(order.PlacedAt),
+	}, nil)
+
+	fetches.EachRecord(func(r *kgo.Record) {
+		placed := kafkatime.FromRecordTime[et.Timezone](r.Timestamp)
+		...
+	})
+
+With github.com/segmentio/kafka-go:
+
+	err := writer.WriteMessages(ctx, kafka.Message{
+		Value: payload,
+		Time:  kafkatime.ToRecordTime(order.PlacedAt),
+	})
+
+	m, err := reader.ReadMessage(ctx)
+	placed := kafkatime.FromRecordTime[et.Timezone](m.Time)
+
+Kafka timestamps have millisecond precision; finer digits are truncated.
+*/
+package kafkatime
+
+import (
+	"time"
+
+	"github.com/matthalp/go-meridian/v2"
+)
+
+// NoTimestamp is the wire value of a record without a timestamp.
+const NoTimestamp int64 = -1
+
+// FromKafkaTimestamp returns the time of a Kafka record timestamp, in
+// milliseconds since the Unix epoch, in the specified timezone. Negative
+// timestamps, including NoTimestamp, return the zero Time.
+func FromKafkaTimestamp[TZ meridian.Timezone](ms int64) meridian.Time[TZ] {
+	if ms < 0 {
+		return meridian.Time[TZ]{}
+	}
+	return meridian.UnixMilli[TZ](ms)
+}
+
+// ToKafkaTimestamp returns m as a Kafka record timestamp in milliseconds
+// since the Unix epoch. The zero time, and any time before the epoch, which
+// Kafka cannot represent, returns NoTimestamp.
+func ToKafkaTimestamp(m meridian.Moment) int64 {
+	t := m.UTC()
+	if t.IsZero() || t.Before(time.Unix(0, 0)) {
+		return NoTimestamp
+	}
+	return t.UnixMilli()
+}
+
+// FromRecordTime returns the timestamp of a record as decoded by a Kafka
+// client, such as kgo.Record.Timestamp or kafka.Message.Time, in the
+// specified timezone. A record without a timestamp returns the zero Time.
+func FromRecordTime[TZ meridian.Timezone](t time.Time) meridian.Time[TZ] {
+	if t.IsZero() {
+		return meridian.Time[TZ]{}
+	}
+	return FromKafkaTimestamp[TZ](t.UnixMilli())
+}
+
+// ToRecordTime returns m truncated to milliseconds, for the timestamp field
+// of a record to produce. The zero time and times before the epoch return
+// the zero time.Time, which clients replace with the produce time.
+func ToRecordTime(m meridian.Moment) time.Time {
+	ms := ToKafkaTimestamp(m)
+	if ms == NoTimestamp {
+		return time.Time{}
+	}
+	return time.UnixMilli(ms).UTC()
+}
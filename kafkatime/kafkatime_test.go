@@ -0,0 +1,53 @@
+package kafkatime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matthalp/go-meridian/v2/timezones/et"
+	"github.com/matthalp/go-meridian/v2/timezones/utc"
+)
+
+func TestKafkaTimestamp(t *testing.T) {
+	const ms = 1710077400123 // 2024-03-10 13:30:00.123 UTC
+	got := FromKafkaTimestamp[et.Timezone](ms)
+	if got.UnixMilli() != ms || got.Hour() != 9 {
+		t.Errorf("FromKafkaTimestamp(%d) = %v, want 09:30:00.123 EDT", ms, got)
+	}
+	if back := ToKafkaTimestamp(got.Add(456 * time.Microsecond)); back != ms {
+		t.Errorf("ToKafkaTimestamp() = %d, want %d", back, ms)
+	}
+
+	if got := FromKafkaTimestamp[et.Timezone](NoTimestamp); !got.IsZero() {
+		t.Errorf("FromKafkaTimestamp(NoTimestamp) = %v, want zero time", got)
+	}
+	if got := ToKafkaTimestamp(et.Time{}); got != NoTimestamp {
+		t.Errorf("ToKafkaTimestamp(zero) = %d, want NoTimestamp", got)
+	}
+	if got := ToKafkaTimestamp(utc.Date(1969, time.December, 31, 0, 0, 0, 0)); got != NoTimestamp {
+		t.Errorf("ToKafkaTimestamp(before epoch) = %d, want NoTimestamp", got)
+	}
+	if got := ToKafkaTimestamp(time.Unix(0, 0)); got != 0 {
+		t.Errorf("ToKafkaTimestamp(epoch) = %d, want 0", got)
+	}
+}
+
+func TestRecordTime(t *testing.T) {
+	want := et.Date(2024, time.March, 10, 9, 30, 0, 123456789)
+	record := ToRecordTime(want)
+	if record.Nanosecond() != 123000000 || record.Location() != time.UTC {
+		t.Errorf("ToRecordTime() = %v, want truncated to milliseconds in UTC", record)
+	}
+	if got := FromRecordTime[et.Timezone](record); !got.Equal(want.Truncate(time.Millisecond)) {
+		t.Errorf("FromRecordTime() = %v, want %v", got, want.Truncate(time.Millisecond))
+	}
+
+	for _, noTimestamp := range []time.Time{{}, time.UnixMilli(NoTimestamp)} {
+		if got := FromRecordTime[utc.Timezone](noTimestamp); !got.IsZero() {
+			t.Errorf("FromRecordTime(%v) = %v, want zero time", noTimestamp, got)
+		}
+	}
+	if got := ToRecordTime(utc.Time{}); !got.IsZero() {
+		t.Errorf("ToRecordTime(zero) = %v, want zero time", got)
+	}
+}
@@ -0,0 +1,91 @@
+package meridian
+
+import (
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// Layout is a time layout prepared for formatting many times, such as the
+// timestamps of a log pipeline. It remembers the text of the last whole
+// second it formatted, so formatting times that fall within the same second
+// and zone, the common case for a stream of events, returns the remembered
+// text instead of formatting again.
+//
+// Layouts that print fractional seconds are not cached, since their output
+// changes within a second; formatting with them costs the same as Format.
+//
+// A Layout is safe for concurrent use. Create it once with NewLayout and
+// reuse it:
+//
+//	var logLayout = meridian.NewLayout(time.RFC3339)
+//
+//	line = event.Time.AppendFormatLayout(line, logLayout)
+type Layout struct {
+	layout string
+	cached bool
+	last   atomic.Pointer[formattedSecond]
+}
+
+// formattedSecond is the text of a layout for one whole second in one location.
+type formattedSecond struct {
+	sec  int64
+	loc  *time.Location
+	text string
+}
+
+// NewLayout returns a Layout for the given layout string, which is
+// interpreted as by time.Time.Format.
+func NewLayout(layout string) *Layout {
+	return &Layout{layout: layout, cached: !printsFraction(layout)}
+}
+
+// String returns the layout string.
+func (l *Layout) String() string {
+	return l.layout
+}
+
+// format returns the text of t, which must be in loc, using the cache when
+// the layout allows it.
+func (l *Layout) format(t time.Time, loc *time.Location) string {
+	if !l.cached {
+		return t.Format(l.layout)
+	}
+	sec := t.Unix()
+	if last := l.last.Load(); last != nil && last.sec == sec && last.loc == loc {
+		return last.text
+	}
+	text := t.Format(l.layout)
+	l.last.Store(&formattedSecond{sec: sec, loc: loc, text: text})
+	return text
+}
+
+// printsFraction reports whether layout contains a fractional second element,
+// a period or comma followed by a run of 0s or 9s. It errs on the side of
+// reporting true, which only disables caching.
+func printsFraction(layout string) bool {
+	for _, sep := range []string{".0", ".9", ",0", ",9"} {
+		if strings.Contains(layout, sep) {
+			return true
+		}
+	}
+	return false
+}
+
+// FormatLayout is like Format but uses a prepared Layout, which avoids
+// formatting again times within a second of the last one formatted.
+func (t Time[TZ]) FormatLayout(l *Layout) string {
+	loc := getLocation[TZ]()
+	return l.format(t.utcTime.In(loc), loc)
+}
+
+// AppendFormatLayout is like AppendFormat but uses a prepared Layout, which
+// avoids formatting again times within a second of the last one formatted.
+func (t Time[TZ]) AppendFormatLayout(b []byte, l *Layout) []byte {
+	loc := getLocation[TZ]()
+	native := t.utcTime.In(loc)
+	if !l.cached {
+		return native.AppendFormat(b, l.layout)
+	}
+	return append(b, l.format(native, loc)...)
+}
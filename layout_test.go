@@ -0,0 +1,77 @@
+package meridian
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestFormatLayout(t *testing.T) {
+	times := []Time[benchZone]{
+		Date[benchZone](2024, time.July, 15, 12, 30, 0, 0),
+		Date[benchZone](2024, time.July, 15, 12, 30, 0, 500000000),
+		Date[benchZone](2024, time.July, 15, 12, 30, 1, 0),
+		Date[benchZone](2024, time.December, 15, 12, 30, 1, 0),
+	}
+	layouts := []string{
+		time.RFC3339,
+		time.RFC3339Nano,
+		time.DateTime,
+		"2006-01-02 15:04:05.000 MST",
+		"15:04:05,999",
+		"Jan _2 15:04:05",
+	}
+
+	for _, layout := range layouts {
+		l := NewLayout(layout)
+		for _, tm := range times {
+			want := tm.Format(layout)
+			if got := tm.FormatLayout(l); got != want {
+				t.Errorf("FormatLayout(%q) = %q, want %q", layout, got, want)
+			}
+			if got := string(tm.AppendFormatLayout([]byte("at "), l)); got != "at "+want {
+				t.Errorf("AppendFormatLayout(%q) = %q, want %q", layout, got, "at "+want)
+			}
+		}
+	}
+}
+
+func TestFormatLayoutAcrossTimezones(t *testing.T) {
+	l := NewLayout("15:04 MST")
+	utcTime := Date[UTC](2024, time.July, 15, 16, 30, 0, 0)
+	estTime := FromMoment[EST](utcTime)
+
+	if got, want := utcTime.FormatLayout(l), "16:30 UTC"; got != want {
+		t.Errorf("FormatLayout() in UTC = %q, want %q", got, want)
+	}
+	if got, want := estTime.FormatLayout(l), "12:30 EDT"; got != want {
+		t.Errorf("FormatLayout() in EST = %q, want %q", got, want)
+	}
+}
+
+func TestFormatLayoutConcurrent(t *testing.T) {
+	l := NewLayout(time.RFC3339)
+	start := Date[benchZone](2024, time.July, 15, 12, 30, 0, 0)
+
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				tm := start.Add(time.Duration(i%3) * time.Second)
+				if got, want := tm.FormatLayout(l), tm.Format(time.RFC3339); got != want {
+					t.Errorf("FormatLayout() = %q, want %q", got, want)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestLayoutString(t *testing.T) {
+	if got := NewLayout(time.Kitchen).String(); got != time.Kitchen {
+		t.Errorf("String() = %q, want %q", got, time.Kitchen)
+	}
+}
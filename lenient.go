@@ -0,0 +1,68 @@
+package meridian
+
+import (
+	"strings"
+	"time"
+)
+
+// ParseRFC3339Lenient parses an RFC 3339 time, tolerating the deviations
+// common in logs and CSV exports that Parse with time.RFC3339 rejects:
+//
+//   - a space or lowercase "t" instead of "T" between the date and time
+//   - a missing seconds field, as in "2024-03-15T10:30Z"
+//   - a lowercase "z" for UTC
+//   - a comma instead of a period before fractional seconds
+//   - a missing offset, in which case the value is a wall-clock time in the
+//     timezone, interpreted as by Parse
+//
+// Values with an offset represent the same instant in the specified
+// timezone. Errors are of type *ParseError, with offsets into value.
+func ParseRFC3339Lenient[TZ Timezone](value string) (Time[TZ], error) {
+	norm := normalizeRFC3339(value)
+	layout := "2006-01-02T15:04"
+	if len(norm) > 16 && norm[16] == ':' {
+		layout += ":05"
+	}
+
+	loc := getLocation[TZ]()
+	var t time.Time
+	var err error
+	if hasRFC3339Offset(norm) {
+		layout += "Z07:00"
+		t, err = time.Parse(layout, norm)
+	} else {
+		t, err = time.ParseInLocation(layout, norm, loc)
+	}
+	if err != nil {
+		parseErr := newParseError(layout, norm, loc, err)
+		parseErr.Value = value
+		return Time[TZ]{}, parseErr
+	}
+	return Time[TZ]{utcTime: t.UTC()}, nil
+}
+
+// normalizeRFC3339 rewrites the tolerated deviations of value to strict
+// RFC 3339. Each replacement is a single byte, so offsets into the result
+// are offsets into value.
+func normalizeRFC3339(value string) string {
+	if len(value) <= 10 {
+		return value
+	}
+	b := []byte(value)
+	if b[10] == ' ' || b[10] == 't' {
+		b[10] = 'T'
+	}
+	if len(b) > 19 && b[19] == ',' {
+		b[19] = '.'
+	}
+	if b[len(b)-1] == 'z' {
+		b[len(b)-1] = 'Z'
+	}
+	return string(b)
+}
+
+// hasRFC3339Offset reports whether the normalized value ends in "Z" or has a
+// signed offset after its date.
+func hasRFC3339Offset(norm string) bool {
+	return strings.HasSuffix(norm, "Z") || len(norm) > 11 && strings.ContainsAny(norm[11:], "+-")
+}
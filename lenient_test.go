@@ -0,0 +1,61 @@
+package meridian
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseRFC3339Lenient(t *testing.T) {
+	est, _ := time.LoadLocation("America/New_York")
+	tests := []struct {
+		value string
+		want  time.Time
+	}{
+		{"2024-03-15T10:30:00Z", time.Date(2024, time.March, 15, 10, 30, 0, 0, time.UTC)},
+		{"2024-03-15 10:30:00Z", time.Date(2024, time.March, 15, 10, 30, 0, 0, time.UTC)},
+		{"2024-03-15t10:30:00z", time.Date(2024, time.March, 15, 10, 30, 0, 0, time.UTC)},
+		{"2024-03-15T10:30Z", time.Date(2024, time.March, 15, 10, 30, 0, 0, time.UTC)},
+		{"2024-03-15T10:30+02:00", time.Date(2024, time.March, 15, 8, 30, 0, 0, time.UTC)},
+		{"2024-03-15 10:30:00,250-05:00", time.Date(2024, time.March, 15, 15, 30, 0, 250000000, time.UTC)},
+		{"2024-03-15T10:30:00.123456789Z", time.Date(2024, time.March, 15, 10, 30, 0, 123456789, time.UTC)},
+		{"2024-03-15 10:30:00", time.Date(2024, time.March, 15, 10, 30, 0, 0, est)},
+		{"2024-03-15 10:30", time.Date(2024, time.March, 15, 10, 30, 0, 0, est)},
+		{"2024-03-15 10:30:00,5", time.Date(2024, time.March, 15, 10, 30, 0, 500000000, est)},
+	}
+	for _, tt := range tests {
+		got, err := ParseRFC3339Lenient[EST](tt.value)
+		if err != nil {
+			t.Errorf("ParseRFC3339Lenient(%q) error = %v", tt.value, err)
+			continue
+		}
+		if !got.Equal(tt.want) {
+			t.Errorf("ParseRFC3339Lenient(%q) = %v, want %v", tt.value, got.UTC(), tt.want.UTC())
+		}
+	}
+}
+
+func TestParseRFC3339LenientInvalid(t *testing.T) {
+	for _, value := range []string{"", "2024-03-15", "2024-03-15X10:30:00Z", "2024-03-15T10Z", "2024-03-15T10:30:00+2", "15/03/2024 10:30"} {
+		_, err := ParseRFC3339Lenient[UTC](value)
+		var parseErr *ParseError
+		if !errors.As(err, &parseErr) {
+			t.Errorf("ParseRFC3339Lenient(%q) error = %v, want *ParseError", value, err)
+			continue
+		}
+		if parseErr.Value != value {
+			t.Errorf("ParseRFC3339Lenient(%q) error Value = %q", value, parseErr.Value)
+		}
+	}
+}
+
+func TestParseRFC3339LenientErrorOffset(t *testing.T) {
+	_, err := ParseRFC3339Lenient[UTC]("2024-03-15 10:3x")
+	var parseErr *ParseError
+	if !errors.As(err, &parseErr) {
+		t.Fatalf("error = %v, want *ParseError", err)
+	}
+	if parseErr.Offset != 14 {
+		t.Errorf("Offset = %d, want 14", parseErr.Offset)
+	}
+}
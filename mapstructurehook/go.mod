@@ -0,0 +1,11 @@
+module github.com/matthalp/go-meridian/v2/mapstructurehook
+
+go 1.20
+
+require (
+	cloud.google.com/go v0.112.0
+	github.com/go-viper/mapstructure/v2 v2.4.0
+	github.com/matthalp/go-meridian/v2 v2.0.0
+)
+
+replace github.com/matthalp/go-meridian/v2 => ../
@@ -0,0 +1,245 @@
+/*
+Package mapstructurehook provides a mapstructure decode hook that converts
+configuration values into meridian typed times, civil dates and times from
+cloud.google.com/go/civil, and meridian.CalendarDuration, so config structs
+can hold those types directly. Register it with viper when unmarshaling:
+
+	type Config struct {
+		Launch    et.Time                   `mapstructure:"launch"`
+		Holiday   civil.Date                `mapstructure:"holiday"`
+		Retention meridian.CalendarDuration `mapstructure:"retention"`
+	}
+
+	var cfg Config
+	err := viper.Unmarshal(&cfg, viper.DecodeHook(mapstructure.ComposeDecodeHookFunc(
+		mapstructurehook.DecodeHook(),
+		mapstructure.StringToTimeDurationHookFunc(),
+	)))
+
+with a config file such as:
+
+	launch: "2025-03-10 09:00"    # 09:00 in New York
+	holiday: "2025-12-25"
+	retention: P1M15D
+
+The hook works with both github.com/go-viper/mapstructure/v2, which viper
+uses since v1.20, and github.com/mitchellh/mapstructure.
+*/
+package mapstructurehook
+
+import (
+	"encoding"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/civil"
+	"github.com/go-viper/mapstructure/v2"
+
+	"github.com/matthalp/go-meridian/v2"
+)
+
+// Layouts are the layouts, tried in order, used to parse strings into typed
+// times. A string without a UTC offset is read as a wall-clock time in the
+// timezone of the field, so "2025-03-10 09:00" in an et.Time field is 09:00
+// in New York.
+var Layouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04",
+	"2006-01-02 15:04",
+	time.DateOnly,
+}
+
+var (
+	timeType             = reflect.TypeOf(time.Time{})
+	calendarDurationType = reflect.TypeOf(meridian.CalendarDuration{})
+	civilDateType        = reflect.TypeOf(civil.Date{})
+	civilTimeType        = reflect.TypeOf(civil.Time{})
+	civilDateTimeType    = reflect.TypeOf(civil.DateTime{})
+	momentType           = reflect.TypeOf((*meridian.MomentWithLocation)(nil)).Elem()
+	textUnmarshalerType  = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// DecodeHook returns a hook that converts:
+//
+//   - strings, whole Unix seconds, and time.Time values (as decoded from
+//     YAML and TOML timestamps) into meridian.Time[TZ] fields of any
+//     timezone. Strings are parsed with Layouts.
+//   - strings in the formats of civil.ParseDate, civil.ParseTime, and
+//     civil.ParseDateTime, and time.Time values, into civil.Date, civil.Time,
+//     and civil.DateTime fields.
+//   - ISO 8601 duration strings such as "P1Y2M10DT2H30M" into
+//     meridian.CalendarDuration fields. Weeks ("P2W") are counted as 7 days,
+//     and a leading "-" negates every field.
+//
+// Values of other types, and values destined for other field types, are
+// returned unchanged for the next hook or mapstructure itself to handle.
+func DecodeHook() mapstructure.DecodeHookFuncType {
+	return func(from, to reflect.Type, data interface{}) (interface{}, error) {
+		switch to {
+		case calendarDurationType:
+			if s, ok := data.(string); ok {
+				return ParseCalendarDuration(s)
+			}
+			return data, nil
+		case civilDateType, civilTimeType, civilDateTimeType:
+			return decodeCivil(to, data)
+		case timeType:
+			return data, nil
+		}
+		if to.Implements(momentType) && reflect.PointerTo(to).Implements(textUnmarshalerType) {
+			return decodeTime(to, data)
+		}
+		return data, nil
+	}
+}
+
+// decodeTime converts data into a value of the typed time type to.
+func decodeTime(to reflect.Type, data interface{}) (interface{}, error) {
+	loc := reflect.Zero(to).Interface().(meridian.MomentWithLocation).Location()
+
+	var t time.Time
+	switch v := data.(type) {
+	case string:
+		parsed, err := parseTime(v, loc)
+		if err != nil {
+			return nil, err
+		}
+		t = parsed
+	case time.Time:
+		t = v
+	case int:
+		t = time.Unix(int64(v), 0)
+	case int64:
+		t = time.Unix(v, 0)
+	case float64:
+		if v != math.Trunc(v) {
+			return nil, fmt.Errorf("cannot decode %v into a time: Unix seconds must be whole", v)
+		}
+		t = time.Unix(int64(v), 0)
+	default:
+		return data, nil
+	}
+
+	out := reflect.New(to)
+	text, err := t.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	if err := out.Interface().(encoding.TextUnmarshaler).UnmarshalText(text); err != nil {
+		return nil, err
+	}
+	return out.Elem().Interface(), nil
+}
+
+// parseTime parses s with each of Layouts in turn, reading times without a
+// UTC offset in loc.
+func parseTime(s string, loc *time.Location) (time.Time, error) {
+	for _, layout := range Layouts {
+		if t, err := time.ParseInLocation(layout, s, loc); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("cannot decode %q into a time in %s: no matching layout in Layouts", s, loc)
+}
+
+// decodeCivil converts data into a civil.Date, civil.Time, or civil.DateTime.
+func decodeCivil(to reflect.Type, data interface{}) (interface{}, error) {
+	switch v := data.(type) {
+	case string:
+		switch to {
+		case civilDateType:
+			return civil.ParseDate(v)
+		case civilTimeType:
+			return civil.ParseTime(v)
+		default:
+			return civil.ParseDateTime(v)
+		}
+	case time.Time:
+		switch to {
+		case civilDateType:
+			return civil.DateOf(v), nil
+		case civilTimeType:
+			return civil.TimeOf(v), nil
+		default:
+			return civil.DateTimeOf(v), nil
+		}
+	}
+	return data, nil
+}
+
+// ParseCalendarDuration parses an ISO 8601 duration, such as "P1M15D" or
+// "-PT36H", into a meridian.CalendarDuration. Years, months, weeks, and days
+// become calendar fields; hours, minutes, and seconds, which may have a
+// fraction, become Duration.
+func ParseCalendarDuration(s string) (meridian.CalendarDuration, error) {
+	var d meridian.CalendarDuration
+	rest := s
+	neg := strings.HasPrefix(rest, "-")
+	rest = strings.TrimPrefix(strings.TrimPrefix(rest, "-"), "+")
+	if !strings.HasPrefix(rest, "P") || rest == "P" {
+		return d, fmt.Errorf("invalid ISO 8601 duration %q", s)
+	}
+	rest = rest[1:]
+
+	inTime := false
+	for rest != "" {
+		if rest[0] == 'T' {
+			if inTime || len(rest) == 1 {
+				return d, fmt.Errorf("invalid ISO 8601 duration %q", s)
+			}
+			inTime = true
+			rest = rest[1:]
+			continue
+		}
+		i := 0
+		for i < len(rest) && (rest[i] == '.' || '0' <= rest[i] && rest[i] <= '9') {
+			i++
+		}
+		if i == 0 || i == len(rest) {
+			return d, fmt.Errorf("invalid ISO 8601 duration %q", s)
+		}
+		number, unit := rest[:i], rest[i]
+		rest = rest[i+1:]
+
+		if inTime {
+			if unit != 'H' && unit != 'M' && unit != 'S' {
+				return d, fmt.Errorf("invalid ISO 8601 duration %q", s)
+			}
+			v, err := time.ParseDuration(number + strings.ToLower(string(unit)))
+			if err != nil {
+				return d, fmt.Errorf("invalid ISO 8601 duration %q", s)
+			}
+			d.Duration += v
+			continue
+		}
+
+		n, err := strconv.Atoi(number)
+		if err != nil {
+			return d, fmt.Errorf("invalid ISO 8601 duration %q", s)
+		}
+		switch unit {
+		case 'Y':
+			d.Years += n
+		case 'M':
+			d.Months += n
+		case 'W':
+			d.Days += 7 * n
+		case 'D':
+			d.Days += n
+		default:
+			return d, fmt.Errorf("invalid ISO 8601 duration %q", s)
+		}
+	}
+
+	if neg {
+		d = meridian.CalendarDuration{Years: -d.Years, Months: -d.Months, Days: -d.Days, Duration: -d.Duration}
+	}
+	return d, nil
+}
@@ -0,0 +1,136 @@
+package mapstructurehook
+
+import (
+	"testing"
+	"time"
+
+	"cloud.google.com/go/civil"
+	"github.com/go-viper/mapstructure/v2"
+
+	"github.com/matthalp/go-meridian/v2"
+	"github.com/matthalp/go-meridian/v2/timezones/et"
+	"github.com/matthalp/go-meridian/v2/timezones/utc"
+)
+
+type config struct {
+	Launch    et.Time
+	Deadline  *utc.Time
+	Epoch     utc.Time
+	Holiday   civil.Date
+	Opens     civil.Time
+	Party     civil.DateTime
+	Retention meridian.CalendarDuration
+	Timeout   time.Duration
+}
+
+func decode(t *testing.T, input map[string]interface{}) (config, error) {
+	t.Helper()
+	var cfg config
+	dec, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		DecodeHook: mapstructure.ComposeDecodeHookFunc(DecodeHook(), mapstructure.StringToTimeDurationHookFunc()),
+		Result:     &cfg,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cfg, dec.Decode(input)
+}
+
+func TestDecodeHook(t *testing.T) {
+	cfg, err := decode(t, map[string]interface{}{
+		"launch":    "2025-03-10 09:00",
+		"deadline":  "2025-03-10T17:00:00+01:00",
+		"epoch":     1700000000,
+		"holiday":   "2025-12-25",
+		"opens":     "08:30:00",
+		"party":     "2025-12-31T20:00:00",
+		"retention": "P1M15DT12H",
+		"timeout":   "30s",
+	})
+	if err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+
+	if want := et.Date(2025, time.March, 10, 9, 0, 0, 0); !cfg.Launch.Equal(want) {
+		t.Errorf("Launch = %v, want %v", cfg.Launch, want)
+	}
+	if cfg.Deadline == nil || !cfg.Deadline.Equal(time.Date(2025, time.March, 10, 16, 0, 0, 0, time.UTC)) {
+		t.Errorf("Deadline = %v, want 2025-03-10 16:00 UTC", cfg.Deadline)
+	}
+	if got := cfg.Epoch.Unix(); got != 1700000000 {
+		t.Errorf("Epoch.Unix() = %d, want 1700000000", got)
+	}
+	if want := (civil.Date{Year: 2025, Month: time.December, Day: 25}); cfg.Holiday != want {
+		t.Errorf("Holiday = %v, want %v", cfg.Holiday, want)
+	}
+	if want := (civil.Time{Hour: 8, Minute: 30}); cfg.Opens != want {
+		t.Errorf("Opens = %v, want %v", cfg.Opens, want)
+	}
+	if want := (civil.DateTime{Date: civil.Date{Year: 2025, Month: time.December, Day: 31}, Time: civil.Time{Hour: 20}}); cfg.Party != want {
+		t.Errorf("Party = %v, want %v", cfg.Party, want)
+	}
+	if want := (meridian.CalendarDuration{Months: 1, Days: 15, Duration: 12 * time.Hour}); cfg.Retention != want {
+		t.Errorf("Retention = %+v, want %+v", cfg.Retention, want)
+	}
+	if cfg.Timeout != 30*time.Second {
+		t.Errorf("Timeout = %v, want 30s", cfg.Timeout)
+	}
+}
+
+func TestDecodeHookTimeValues(t *testing.T) {
+	// YAML and TOML decoders produce time.Time for timestamps and dates.
+	stamp := time.Date(2025, time.June, 1, 12, 0, 0, 0, time.UTC)
+	cfg, err := decode(t, map[string]interface{}{"launch": stamp, "holiday": stamp})
+	if err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+	if !cfg.Launch.Equal(stamp) {
+		t.Errorf("Launch = %v, want %v", cfg.Launch, stamp)
+	}
+	if want := (civil.Date{Year: 2025, Month: time.June, Day: 1}); cfg.Holiday != want {
+		t.Errorf("Holiday = %v, want %v", cfg.Holiday, want)
+	}
+}
+
+func TestDecodeHookErrors(t *testing.T) {
+	for _, input := range []map[string]interface{}{
+		{"launch": "next tuesday"},
+		{"epoch": 1.5},
+		{"holiday": "2025-13-01"},
+		{"retention": "1 month"},
+	} {
+		if _, err := decode(t, input); err == nil {
+			t.Errorf("Decode(%v) succeeded, want error", input)
+		}
+	}
+}
+
+func TestParseCalendarDuration(t *testing.T) {
+	tests := []struct {
+		s    string
+		want meridian.CalendarDuration
+	}{
+		{"P1Y2M10DT2H30M", meridian.CalendarDuration{Years: 1, Months: 2, Days: 10, Duration: 2*time.Hour + 30*time.Minute}},
+		{"P2W", meridian.CalendarDuration{Days: 14}},
+		{"PT0.5S", meridian.CalendarDuration{Duration: 500 * time.Millisecond}},
+		{"PT1.5H", meridian.CalendarDuration{Duration: 90 * time.Minute}},
+		{"-P1DT1H", meridian.CalendarDuration{Days: -1, Duration: -time.Hour}},
+		{"P0D", meridian.CalendarDuration{}},
+	}
+	for _, tt := range tests {
+		got, err := ParseCalendarDuration(tt.s)
+		if err != nil {
+			t.Errorf("ParseCalendarDuration(%q) error = %v", tt.s, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseCalendarDuration(%q) = %+v, want %+v", tt.s, got, tt.want)
+		}
+	}
+
+	for _, s := range []string{"", "P", "PT", "1D", "P1H", "PT1D", "P1.5D", "P1DT", "PTT1H", "P1"} {
+		if _, err := ParseCalendarDuration(s); err == nil {
+			t.Errorf("ParseCalendarDuration(%q) succeeded, want error", s)
+		}
+	}
+}
@@ -19,7 +19,7 @@ timezone, causing incorrect behavior.
 Meridian encodes timezone information directly in the type system using Go generics.
 Time[TZ] is a time.Time wrapper where TZ is a timezone type parameter:
 
-	import "github.com/matthalp/go-meridian/utc"
+	import "github.com/matthalp/go-meridian/v2/timezones/utc"
 
 	func ProcessDeadline(deadline utc.Time) {
 		// Now the timezone is guaranteed by the compiler!
@@ -76,8 +76,14 @@ import (
 	"database/sql/driver"
 	"encoding"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"math"
+	"reflect"
+	"strings"
 	"time"
+
+	"github.com/matthalp/go-meridian/v2/internal/tzoverride"
 )
 
 // Version is the current version of the meridian package.
@@ -86,6 +92,11 @@ const Version = "2.0.0"
 // Timezone interface that all timezone types must implement.
 // Each timezone package defines its own Timezone type that satisfies this interface,
 // enabling Time[TZ] to be parameterized with type-safe timezone information.
+//
+// Location is called on the zero value of the type by every method that reads
+// the time in its zone, such as Format and Hour, so it should return a
+// location loaded once, as the generated packages do, rather than calling
+// time.LoadLocation each time.
 type Timezone interface {
 	Location() *time.Location
 }
@@ -97,6 +108,33 @@ type Moment interface {
 	UTC() time.Time
 }
 
+// MomentWithLocation is a Moment that also reports the location it is
+// presented in. Both time.Time and Time[TZ] implement it, so generic code
+// that accepts a Moment can recover the source zone with LocationOf instead
+// of flattening every value to UTC.
+type MomentWithLocation interface {
+	Moment
+	Location() *time.Location
+}
+
+// LocationOf returns the location of m if it implements MomentWithLocation,
+// and time.UTC otherwise. A nil location is reported as time.UTC.
+func LocationOf(m Moment) *time.Location {
+	if ml, ok := m.(MomentWithLocation); ok {
+		if loc := ml.Location(); loc != nil {
+			return loc
+		}
+	}
+	return time.UTC
+}
+
+// FormatMoment returns m formatted with layout in the location reported by
+// LocationOf, so a Time[TZ] or time.Time keeps its own zone while other
+// Moment implementations are formatted in UTC.
+func FormatMoment(m Moment, layout string) string {
+	return m.UTC().In(LocationOf(m)).Format(layout)
+}
+
 // Now returns the current time in the specified timezone.
 // The timezone type parameter TZ is typically inferred from context or explicitly
 // specified. For most use cases, prefer timezone-specific helpers like est.Now()
@@ -116,26 +154,93 @@ func Date[TZ Timezone](year int, month time.Month, day, hour, minute, sec, nsec
 	return Time[TZ]{utcTime: t.UTC()}
 }
 
+// ErrOutOfRange is returned, wrapped, by DateValidated when a date or time
+// component is outside its valid range.
+var ErrOutOfRange = errors.New("date component out of range")
+
+// DateValidated is like Date but returns an error wrapping ErrOutOfRange,
+// instead of normalizing, if any component is out of range: month must be
+// 1 through 12, day must exist in that month, hour 0 through 23, minute and
+// sec 0 through 59, and nsec 0 through 999999999. It suits validating
+// user-supplied components, where Date would turn month 13 into January of
+// the next year.
+//
+// A valid wall-clock time that is skipped by a daylight saving time
+// transition is not an error; it is normalized as Date does.
+func DateValidated[TZ Timezone](year int, month time.Month, day, hour, minute, sec, nsec int) (Time[TZ], error) {
+	switch {
+	case month < time.January || month > time.December:
+		return Time[TZ]{}, fmt.Errorf("%w: month %d", ErrOutOfRange, int(month))
+	case day < 1 || day > daysIn(year, month):
+		return Time[TZ]{}, fmt.Errorf("%w: day %d of %s %d", ErrOutOfRange, day, month, year)
+	case hour < 0 || hour > 23:
+		return Time[TZ]{}, fmt.Errorf("%w: hour %d", ErrOutOfRange, hour)
+	case minute < 0 || minute > 59:
+		return Time[TZ]{}, fmt.Errorf("%w: minute %d", ErrOutOfRange, minute)
+	case sec < 0 || sec > 59:
+		return Time[TZ]{}, fmt.Errorf("%w: second %d", ErrOutOfRange, sec)
+	case nsec < 0 || nsec > 999999999:
+		return Time[TZ]{}, fmt.Errorf("%w: nanosecond %d", ErrOutOfRange, nsec)
+	}
+	return Date[TZ](year, month, day, hour, minute, sec, nsec), nil
+}
+
 // FromMoment creates a Time[TZ] from any Moment (e.g., time.Time or another Time[TZ]).
 // This is the primary way to convert between timezones explicitly. The conversion
 // preserves the moment in time (UTC equality) but changes the timezone type, making
 // the conversion visible in code review. For most use cases, prefer timezone-specific
 // helpers like est.FromMoment() or pst.FromMoment() for better readability.
+// Only the instant of m is used: the result is presented in TZ, so the
+// location m reports through LocationOf does not affect it.
 func FromMoment[TZ Timezone](m Moment) Time[TZ] {
 	return Time[TZ]{utcTime: m.UTC()}
 }
 
 // Parse parses a formatted string and returns the time value it represents in the specified timezone.
 // The layout defines the format by showing how the reference time would be displayed.
+// Errors are of type *ParseError.
 func Parse[TZ Timezone](layout, value string) (Time[TZ], error) {
 	loc := getLocation[TZ]()
 	t, err := time.ParseInLocation(layout, value, loc)
 	if err != nil {
-		return Time[TZ]{}, err
+		return Time[TZ]{}, newParseError(layout, value, loc, err)
 	}
 	return Time[TZ]{utcTime: t.UTC()}, nil
 }
 
+// ParseError describes a failure to parse a value with Parse. It wraps the
+// error from the time package, usually a *time.ParseError, and adds where in
+// the value parsing stopped and the timezone it was parsed in.
+type ParseError struct {
+	Layout string // the layout the value was parsed with
+	Value  string // the value being parsed
+	Offset int    // byte offset in Value where parsing failed, or -1 if unknown
+	Zone   string // name of the timezone's location
+	Err    error  // the underlying error
+}
+
+func newParseError(layout, value string, loc *time.Location, err error) *ParseError {
+	offset := -1
+	var stdErr *time.ParseError
+	if errors.As(err, &stdErr) && strings.HasSuffix(value, stdErr.ValueElem) {
+		offset = len(value) - len(stdErr.ValueElem)
+	}
+	return &ParseError{Layout: layout, Value: value, Offset: offset, Zone: loc.String(), Err: err}
+}
+
+// Error implements the error interface.
+func (e *ParseError) Error() string {
+	if e.Offset < 0 {
+		return fmt.Sprintf("%v (in %s)", e.Err, e.Zone)
+	}
+	return fmt.Sprintf("%v (in %s, at offset %d)", e.Err, e.Zone, e.Offset)
+}
+
+// Unwrap returns the underlying error.
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
 // Unix returns the Time corresponding to the given Unix time,
 // sec seconds and nsec nanoseconds since January 1, 1970 UTC,
 // in the specified timezone.
@@ -155,8 +260,26 @@ func UnixMicro[TZ Timezone](usec int64) Time[TZ] {
 	return Time[TZ]{utcTime: time.UnixMicro(usec).UTC()}
 }
 
-// getLocation extracts the *time.Location from a timezone type.
+// FromISOWeek returns the Time at midnight, in the specified timezone's location,
+// of the given day of an ISO 8601 week. It is the inverse of ISOWeek: week 1 is
+// the week containing January 4th of year, and weeks start on Monday, so
+// time.Sunday is the last day of the week. Like Date, out-of-range week values
+// are normalized; for example, week 0 of 2025 is the last week of 2024.
+func FromISOWeek[TZ Timezone](year, week int, weekday time.Weekday) Time[TZ] {
+	jan4 := time.Date(year, time.January, 4, 0, 0, 0, 0, time.UTC)
+	monday := 4 - (int(jan4.Weekday())+6)%7
+	day := monday + (week-1)*7 + (int(weekday)+6)%7
+	return Date[TZ](year, time.January, day, 0, 0, 0, 0)
+}
+
+// getLocation extracts the *time.Location from a timezone type, unless a
+// test has replaced it with meridiantest.OverrideLocation.
 func getLocation[TZ Timezone]() *time.Location {
+	if tzoverride.Active() {
+		if loc, ok := tzoverride.Lookup(reflect.TypeOf((*TZ)(nil)).Elem()); ok {
+			return loc
+		}
+	}
 	var tz TZ
 	return tz.Location()
 }
@@ -170,6 +293,11 @@ type Time[TZ Timezone] struct {
 	// We use UTC internally because the zero value of time.Time in Go is UTC,
 	// which ensures our zero values have well-defined behavior. The timezone
 	// type parameter TZ is applied during display and component extraction.
+	//
+	// The localized time is deliberately not cached alongside utcTime.
+	// Converting costs only a location lookup and a copy, while a cached
+	// location pointer would make == and map keys depend on which
+	// *time.Location a Timezone happened to return.
 	utcTime time.Time
 }
 
@@ -185,6 +313,8 @@ var (
 	_ encoding.BinaryUnmarshaler = (*Time[Timezone])(nil)
 	_ driver.Valuer              = Time[Timezone]{}
 	_ sql.Scanner                = (*Time[Timezone])(nil)
+	_ MomentWithLocation         = Time[Timezone]{}
+	_ MomentWithLocation         = time.Time{}
 )
 
 // Formatting & String Output
@@ -234,6 +364,56 @@ func (t Time[TZ]) AddDate(years, months, days int) Time[TZ] {
 	return Time[TZ]{utcTime: t.utcTime.AddDate(years, months, days)}
 }
 
+// AddChecked is like Add but reports false, with the zero Time, if the
+// result falls outside the range a Time can represent, instead of clamping to
+// the end of that range.
+//
+// A Time covers billions of years, but UnixNano is only defined for years 1678
+// through 2262; check with UnixNanoInRange before relying on it for long
+// horizons such as retention dates.
+func (t Time[TZ]) AddChecked(d time.Duration) (Time[TZ], bool) {
+	u := t.utcTime.Add(d)
+	if u.Sub(t.utcTime) != d {
+		return Time[TZ]{}, false
+	}
+	return Time[TZ]{utcTime: u}, true
+}
+
+// AddDateChecked is like AddDate but reports false, with the zero Time, if
+// the arguments are large enough that the result would overflow the range a
+// Time can represent, which AddDate does not detect and silently wraps.
+func (t Time[TZ]) AddDateChecked(years, months, days int) (Time[TZ], bool) {
+	// Estimate the resulting year in floating point, which cannot overflow,
+	// and require the computed result to agree with it.
+	year, month, day := t.utcTime.Date()
+	want := float64(year) + float64(years) +
+		(float64(month-1)+float64(months))/12 +
+		(float64(day-1)+float64(days))/365.2425
+	if math.Abs(want) > maxYear {
+		return Time[TZ]{}, false
+	}
+	u := t.utcTime.AddDate(years, months, days)
+	if math.Abs(float64(u.Year())-want) > 1 {
+		return Time[TZ]{}, false
+	}
+	return Time[TZ]{utcTime: u}, true
+}
+
+// maxYear bounds the years AddDateChecked accepts, comfortably inside the
+// roughly 292 billion years a time.Time can represent either side of year 1.
+const maxYear = 290e9
+
+// UnixNanoInRange reports whether t.UnixNano returns a meaningful result,
+// that is, whether t lies between 1677-09-21 and 2262-04-11 UTC.
+func (t Time[TZ]) UnixNanoInRange() bool {
+	return !t.utcTime.Before(minUnixNano) && !t.utcTime.After(maxUnixNano)
+}
+
+var (
+	minUnixNano = time.Unix(0, math.MinInt64).UTC()
+	maxUnixNano = time.Unix(0, math.MaxInt64).UTC()
+)
+
 // Sub returns the duration t-u. If the result exceeds the maximum (or minimum)
 // value that can be stored in a Duration, the maximum (or minimum) duration
 // will be returned. The parameter u can be any Moment (time.Time or Time[TZ]).
@@ -280,6 +460,20 @@ func (t Time[TZ]) Compare(u Moment) int {
 	return t.utcTime.Compare(u.UTC())
 }
 
+// EqualTime reports whether t and u represent the same time instant.
+// Unlike t.Equal(u), it takes u as a Time rather than a Moment, so comparing
+// typed times in a tight loop does not box u into an interface and allocate.
+func EqualTime[TZ1, TZ2 Timezone](t Time[TZ1], u Time[TZ2]) bool {
+	return t.utcTime.Equal(u.utcTime)
+}
+
+// CompareTime compares the time instant t with u like t.Compare(u), without
+// boxing u into a Moment. CompareTime(t, u) < 0 reports whether t is before u,
+// and CompareTime(t, u) > 0 whether it is after.
+func CompareTime[TZ1, TZ2 Timezone](t Time[TZ1], u Time[TZ2]) int {
+	return t.utcTime.Compare(u.utcTime)
+}
+
 // IsZero reports whether t represents the zero time instant,
 // January 1, year 1, 00:00:00 UTC.
 func (t Time[TZ]) IsZero() bool {
@@ -299,6 +493,47 @@ func (t Time[TZ]) Date() (year int, month time.Month, day int) {
 	return t.nativeTimeInLocation().Date()
 }
 
+// DateTime returns the date and clock time in which t occurs, in the
+// timezone's location. It converts to the location once, so it is cheaper
+// than calling Year, Month, Day, Hour, and the others in turn.
+func (t Time[TZ]) DateTime() (year int, month time.Month, day, hour, minute, sec, nsec int) {
+	local := t.nativeTimeInLocation()
+	year, month, day = local.Date()
+	hour, minute, sec = local.Clock()
+	return year, month, day, hour, minute, sec, local.Nanosecond()
+}
+
+// Components are the calendar, clock, and zone fields of a Time in its
+// timezone's location, as returned by Time.Components.
+type Components struct {
+	Year       int
+	Month      time.Month
+	Day        int
+	Hour       int
+	Minute     int
+	Second     int
+	Nanosecond int
+	Weekday    time.Weekday
+	YearDay    int
+	Zone       string // abbreviation in effect, such as "EST"
+	Offset     int    // offset in effect, in seconds east of UTC
+}
+
+// Components returns the fields of t in the timezone's location from a
+// single conversion, for callers that need several of them.
+func (t Time[TZ]) Components() Components {
+	local := t.nativeTimeInLocation()
+	c := Components{
+		Weekday: local.Weekday(),
+		YearDay: local.YearDay(),
+	}
+	c.Year, c.Month, c.Day = local.Date()
+	c.Hour, c.Minute, c.Second = local.Clock()
+	c.Nanosecond = local.Nanosecond()
+	c.Zone, c.Offset = local.Zone()
+	return c
+}
+
 // Year returns the year in which t occurs, in the timezone's location.
 func (t Time[TZ]) Year() int {
 	return t.nativeTimeInLocation().Year()
@@ -510,8 +745,61 @@ func (t Time[TZ]) Value() (driver.Value, error) {
 	return t.utcTime, nil
 }
 
+// ScanLayouts are the layouts Scan uses, in order, to parse string and []byte
+// database values. The defaults cover RFC 3339 and the DATETIME/TIMESTAMP text
+// formats produced by PostgreSQL, SQLite, MySQL (without parseTime=true), and
+// ClickHouse. Values without a UTC offset are interpreted as UTC, matching how
+// Value stores times. ScanLayouts may be replaced to support other formats.
+//
+// It is a process-wide setting: set it once at init time, before any Scan,
+// because changing it while another goroutine may be scanning is a data race.
+var ScanLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999-07:00",
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999-07",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04:05.999999999",
+	time.DateOnly,
+}
+
+// ZeroDatePolicy controls how Scan handles MySQL zero dates such as
+// "0000-00-00" and "0000-00-00 00:00:00", which legacy schemas use in place of
+// NULL and which do not correspond to any valid time.
+type ZeroDatePolicy int
+
+const (
+	// ZeroDateAsZeroTime scans zero dates into the zero Time[TZ], so IsZero
+	// reports true. This is the default.
+	ZeroDateAsZeroTime ZeroDatePolicy = iota
+
+	// ZeroDateAsError makes Scan return an error wrapping ErrZeroDate.
+	ZeroDateAsError
+)
+
+// ErrZeroDate is returned by Scan for MySQL zero dates when ScanZeroDatePolicy
+// is ZeroDateAsError.
+var ErrZeroDate = errors.New("MySQL zero date")
+
+// ScanZeroDatePolicy is the policy Scan applies to MySQL zero dates delivered
+// as string or []byte values. Drivers that convert zero dates to a zero
+// time.Time themselves (such as go-sql-driver/mysql with parseTime=true) always
+// produce the zero Time[TZ].
+//
+// It is a process-wide setting: set it once at init time, before any Scan,
+// because changing it while another goroutine may be scanning is a data race.
+var ScanZeroDatePolicy = ZeroDateAsZeroTime
+
 // Scan implements the sql.Scanner interface for database/sql.
-// It accepts time.Time values and stores them as UTC internally.
+// Values are converted as follows, and stored as UTC internally:
+//   - nil scans into the zero time.
+//   - time.Time is used as-is.
+//   - string and []byte holding a MySQL zero date are handled according to
+//     ScanZeroDatePolicy; other strings are parsed with each of ScanLayouts in
+//     turn, and the first layout that succeeds wins.
+//   - int64 is interpreted as Unix seconds.
+//
+// Any other type is an error.
 func (t *Time[TZ]) Scan(value interface{}) error {
 	if value == nil {
 		t.utcTime = time.Time{}
@@ -522,15 +810,46 @@ func (t *Time[TZ]) Scan(value interface{}) error {
 	case time.Time:
 		t.utcTime = v.UTC()
 		return nil
+	case string:
+		return t.scanString(v)
+	case []byte:
+		return t.scanString(string(v))
+	case int64:
+		t.utcTime = time.Unix(v, 0).UTC()
+		return nil
 	default:
 		return fmt.Errorf("cannot scan type %T into meridian.Time", value)
 	}
 }
 
+// scanString parses a textual database value using ScanLayouts.
+func (t *Time[TZ]) scanString(s string) error {
+	if isZeroDate(s) {
+		if ScanZeroDatePolicy == ZeroDateAsError {
+			return fmt.Errorf("cannot scan %q into meridian.Time: %w", s, ErrZeroDate)
+		}
+		t.utcTime = time.Time{}
+		return nil
+	}
+	for _, layout := range ScanLayouts {
+		if parsed, err := time.Parse(layout, s); err == nil {
+			t.utcTime = parsed.UTC()
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot scan %q into meridian.Time: no matching layout in ScanLayouts", s)
+}
+
+// isZeroDate reports whether s is a MySQL zero date, optionally followed by a
+// zero time of day with any fractional precision.
+func isZeroDate(s string) bool {
+	return strings.HasPrefix(s, "0000-00-00") && strings.Trim(s, "0-:. T") == ""
+}
+
 // nativeTimeInLocation returns the native time in the location of the timezone.
+// In only sets the location of a copy of utcTime, so this neither allocates
+// nor computes the zone offset; the accessors that call it cost the same as
+// time.Time's own.
 func (t Time[TZ]) nativeTimeInLocation() time.Time {
-	// This is a bit of a hack to get the timezone's location.
-	// We're using a type assertion to get the timezone type and then calling the Location method.
-	loc := getLocation[TZ]()
-	return t.utcTime.In(loc)
+	return t.utcTime.In(getLocation[TZ]())
 }
@@ -0,0 +1,178 @@
+package meridian
+
+import (
+	"testing"
+	"time"
+)
+
+// benchZone loads its location once, as the generated packages do.
+type benchZone struct{}
+
+var benchLocation = mustLoadBenchLocation()
+
+func mustLoadBenchLocation() *time.Location {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		panic(err)
+	}
+	return loc
+}
+
+func (benchZone) Location() *time.Location { return benchLocation }
+
+// Sinks keep the compiler from optimizing away benchmarked calls.
+var (
+	benchInt    int
+	benchString string
+	benchBytes  []byte
+)
+
+func BenchmarkAccessors(b *testing.B) {
+	t := Date[benchZone](2024, time.July, 15, 12, 30, 0, 0)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		benchInt = t.Year() + int(t.Month()) + t.Day() + t.Hour()
+	}
+}
+
+func BenchmarkDateTime(b *testing.B) {
+	t := Date[benchZone](2024, time.July, 15, 12, 30, 0, 0)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		year, month, day, hour, _, _, _ := t.DateTime()
+		benchInt = year + int(month) + day + hour
+	}
+}
+
+func BenchmarkComponents(b *testing.B) {
+	t := Date[benchZone](2024, time.July, 15, 12, 30, 0, 0)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		c := t.Components()
+		benchInt = c.Year + int(c.Month) + c.Day + c.Hour
+	}
+}
+
+func BenchmarkStdAccessors(b *testing.B) {
+	t := time.Date(2024, time.July, 15, 12, 30, 0, 0, benchLocation)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		benchInt = t.Year() + int(t.Month()) + t.Day() + t.Hour()
+	}
+}
+
+func BenchmarkFormat(b *testing.B) {
+	t := Date[benchZone](2024, time.July, 15, 12, 30, 0, 0)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		benchString = t.Format(time.RFC3339)
+	}
+}
+
+func BenchmarkAppendFormat(b *testing.B) {
+	t := Date[benchZone](2024, time.July, 15, 12, 30, 0, 0)
+	buf := make([]byte, 0, 64)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		benchBytes = t.AppendFormat(buf[:0], time.RFC3339)
+	}
+}
+
+func BenchmarkFormatLayout(b *testing.B) {
+	t := Date[benchZone](2024, time.July, 15, 12, 30, 0, 0)
+	l := NewLayout(time.RFC3339)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		benchString = t.Add(time.Duration(i%1000) * time.Millisecond).FormatLayout(l)
+	}
+}
+
+func BenchmarkMarshalJSON(b *testing.B) {
+	t := Date[benchZone](2024, time.July, 15, 12, 30, 0, 0)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		benchBytes, _ = t.MarshalJSON()
+	}
+}
+
+func BenchmarkEqual(b *testing.B) {
+	times := []Time[benchZone]{
+		Date[benchZone](2024, time.July, 15, 12, 30, 0, 0),
+		Date[benchZone](2024, time.July, 15, 12, 31, 0, 0),
+	}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if times[i%2].Equal(times[(i+1)%2]) {
+			benchInt++
+		}
+	}
+}
+
+func BenchmarkEqualTime(b *testing.B) {
+	times := []Time[benchZone]{
+		Date[benchZone](2024, time.July, 15, 12, 30, 0, 0),
+		Date[benchZone](2024, time.July, 15, 12, 31, 0, 0),
+	}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if EqualTime(times[i%2], times[(i+1)%2]) {
+			benchInt++
+		}
+	}
+}
+
+func BenchmarkEncodeUnixNanos(b *testing.B) {
+	ts := make([]Time[benchZone], 1024)
+	for i := range ts {
+		ts[i] = Unix[benchZone](int64(i), 0)
+	}
+	buf := make([]int64, 0, len(ts))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buf = AppendUnixNanos(buf[:0], ts)
+	}
+}
+
+func BenchmarkEncodeRFC3339(b *testing.B) {
+	ts := make([]Time[benchZone], 1024)
+	for i := range ts {
+		ts[i] = Unix[benchZone](int64(i), 0)
+	}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = EncodeRFC3339(ts)
+	}
+}
+
+func TestAllocations(t *testing.T) {
+	tm := Date[benchZone](2024, time.July, 15, 12, 30, 0, 0)
+	native := tm.nativeTimeInLocation()
+	times := []Time[benchZone]{tm, tm.Add(time.Second)}
+	layout := NewLayout(time.RFC3339)
+	buf := make([]byte, 0, 64)
+
+	// Format allocates only the string it returns, as time.Time.Format does.
+	formatAllocs := testing.AllocsPerRun(100, func() { benchString = native.Format(time.RFC3339) })
+
+	tests := []struct {
+		name string
+		f    func()
+		want float64
+	}{
+		{"nativeTimeInLocation", func() { native = tm.nativeTimeInLocation() }, 0},
+		{"accessors", func() { benchInt = tm.Year() + int(tm.Month()) + tm.Day() + tm.Hour() + tm.Minute() }, 0},
+		{"Clock", func() { benchInt, _, _ = tm.Clock() }, 0},
+		{"DateTime", func() { benchInt, _, _, _, _, _, _ = tm.DateTime() }, 0},
+		{"Components", func() { benchInt = tm.Components().Year }, 0},
+		{"Zone", func() { benchString, benchInt = tm.Zone() }, 0},
+		{"AppendFormat", func() { benchBytes = tm.AppendFormat(buf[:0], time.RFC3339) }, 0},
+		{"Format", func() { benchString = tm.Format(time.RFC3339) }, formatAllocs},
+		{"FormatLayout", func() { benchString = tm.FormatLayout(layout) }, 0},
+		{"CompareTime", func() { benchInt = CompareTime(times[benchInt&1], times[1]) }, 0},
+	}
+	for _, tt := range tests {
+		if got := testing.AllocsPerRun(100, tt.f); got != tt.want {
+			t.Errorf("%s allocations = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -5,7 +5,9 @@ import (
 	"database/sql/driver"
 	"encoding/gob"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"math"
 	"testing"
 	"time"
 )
@@ -127,6 +129,47 @@ func TestDate(t *testing.T) {
 	}
 }
 
+func TestDateValidated(t *testing.T) {
+	got, err := DateValidated[EST](2024, time.February, 29, 23, 59, 59, 999999999)
+	if err != nil {
+		t.Fatalf("DateValidated() error = %v", err)
+	}
+	if want := Date[EST](2024, time.February, 29, 23, 59, 59, 999999999); !got.Equal(want) {
+		t.Errorf("DateValidated() = %v, want %v", got, want)
+	}
+
+	tests := []struct {
+		name                         string
+		month                        time.Month
+		day, hour, minute, sec, nsec int
+		wantErr                      string
+	}{
+		{"month 13", 13, 1, 0, 0, 0, 0, "date component out of range: month 13"},
+		{"month 0", 0, 1, 0, 0, 0, 0, "date component out of range: month 0"},
+		{"day 45", time.March, 45, 0, 0, 0, 0, "date component out of range: day 45 of March 2023"},
+		{"February 29 in a common year", time.February, 29, 0, 0, 0, 0, "date component out of range: day 29 of February 2023"},
+		{"day 0", time.March, 0, 0, 0, 0, 0, "date component out of range: day 0 of March 2023"},
+		{"hour 24", time.March, 1, 24, 0, 0, 0, "date component out of range: hour 24"},
+		{"minute 60", time.March, 1, 0, 60, 0, 0, "date component out of range: minute 60"},
+		{"second -1", time.March, 1, 0, 0, -1, 0, "date component out of range: second -1"},
+		{"nanosecond 1e9", time.March, 1, 0, 0, 0, 1e9, "date component out of range: nanosecond 1000000000"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DateValidated[EST](2023, tt.month, tt.day, tt.hour, tt.minute, tt.sec, tt.nsec)
+			if !errors.Is(err, ErrOutOfRange) {
+				t.Fatalf("DateValidated() error = %v, want ErrOutOfRange", err)
+			}
+			if err.Error() != tt.wantErr {
+				t.Errorf("DateValidated() error = %q, want %q", err, tt.wantErr)
+			}
+			if !got.IsZero() {
+				t.Errorf("DateValidated() = %v, want zero Time", got)
+			}
+		})
+	}
+}
+
 func TestDateWithTimezoneOffset(t *testing.T) {
 	// Create a time in EST (UTC-5 in winter, UTC-4 in summer)
 	// Let's use a winter date to avoid DST complications
@@ -363,6 +406,43 @@ func TestMomentInterface(t *testing.T) {
 	}
 }
 
+// utcOnlyMoment is a Moment that does not report a location.
+type utcOnlyMoment struct{ t time.Time }
+
+func (m utcOnlyMoment) UTC() time.Time { return m.t.UTC() }
+
+func TestLocationOf(t *testing.T) {
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+	instant := time.Date(2024, time.January, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		m    Moment
+		want string
+	}{
+		{"Time[EST]", FromMoment[EST](instant), "America/New_York"},
+		{"time.Time", instant.In(tokyo), "Asia/Tokyo"},
+		{"Moment without location", utcOnlyMoment{instant.In(tokyo)}, "UTC"},
+	}
+	for _, tt := range tests {
+		if got := LocationOf(tt.m).String(); got != tt.want {
+			t.Errorf("LocationOf(%s) = %s, want %s", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestFormatMoment(t *testing.T) {
+	instant := time.Date(2024, time.January, 1, 12, 0, 0, 0, time.UTC)
+	if got := FormatMoment(FromMoment[EST](instant), time.RFC3339); got != "2024-01-01T07:00:00-05:00" {
+		t.Errorf("FormatMoment(Time[EST]) = %q, want %q", got, "2024-01-01T07:00:00-05:00")
+	}
+	if got := FormatMoment(instant.In(time.FixedZone("X", 3600)), time.RFC3339); got != "2024-01-01T13:00:00+01:00" {
+		t.Errorf("FormatMoment(time.Time) = %q, want %q", got, "2024-01-01T13:00:00+01:00")
+	}
+	if got := FormatMoment(utcOnlyMoment{instant}, time.RFC3339); got != "2024-01-01T12:00:00Z" {
+		t.Errorf("FormatMoment(utcOnlyMoment) = %q, want %q", got, "2024-01-01T12:00:00Z")
+	}
+}
+
 func TestAdd(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -497,6 +577,78 @@ func TestAddDate(t *testing.T) {
 	}
 }
 
+func TestAddChecked(t *testing.T) {
+	tm := Date[UTC](2024, time.January, 15, 10, 0, 0, 0)
+	if got, ok := tm.AddChecked(time.Hour); !ok || !got.Equal(tm.Add(time.Hour)) {
+		t.Errorf("AddChecked(1h) = %v, %v; want %v, true", got, ok, tm.Add(time.Hour))
+	}
+
+	// time.Time counts seconds from year 1, which is this many seconds
+	// before the Unix epoch, so latest is the latest instant it can hold.
+	const unixToInternal = 62135596800
+	latest := FromMoment[UTC](time.Unix(math.MaxInt64-unixToInternal, 0))
+
+	if got, ok := latest.AddChecked(-time.Second); !ok || !got.Equal(latest.Add(-time.Second)) {
+		t.Errorf("AddChecked(-1s) near the maximum = %v, %v; want ok", got, ok)
+	}
+	if got, ok := latest.AddChecked(time.Second); ok || !got.IsZero() {
+		t.Errorf("AddChecked(1s) past the maximum = %v, %v; want zero, false", got, ok)
+	}
+}
+
+func TestAddDateChecked(t *testing.T) {
+	tm := Date[UTC](2024, time.January, 31, 10, 0, 0, 0)
+
+	valid := []struct {
+		years, months, days int
+	}{
+		{100, 0, 0},
+		{0, 1, 0},
+		{-2024, 0, 0},
+		{0, 0, 36525},
+		{1e9, 0, 0},
+	}
+	for _, tt := range valid {
+		got, ok := tm.AddDateChecked(tt.years, tt.months, tt.days)
+		if want := tm.AddDate(tt.years, tt.months, tt.days); !ok || !got.Equal(want) {
+			t.Errorf("AddDateChecked(%d, %d, %d) = %v, %v; want %v, true", tt.years, tt.months, tt.days, got, ok, want)
+		}
+	}
+
+	overflow := []struct {
+		years, months, days int
+	}{
+		{math.MaxInt, 0, 0},
+		{math.MinInt, 0, 0},
+		{0, math.MaxInt, 0},
+		{0, 0, math.MaxInt},
+		{0, 0, math.MinInt},
+	}
+	for _, tt := range overflow {
+		if got, ok := tm.AddDateChecked(tt.years, tt.months, tt.days); ok {
+			t.Errorf("AddDateChecked(%d, %d, %d) = %v, true; want false", tt.years, tt.months, tt.days, got)
+		}
+	}
+}
+
+func TestUnixNanoInRange(t *testing.T) {
+	tests := []struct {
+		tm   Time[UTC]
+		want bool
+	}{
+		{Date[UTC](2024, time.January, 1, 0, 0, 0, 0), true},
+		{Date[UTC](2262, time.April, 11, 0, 0, 0, 0), true},
+		{Date[UTC](2262, time.April, 12, 0, 0, 0, 0), false},
+		{Date[UTC](1677, time.September, 22, 0, 0, 0, 0), true},
+		{Date[UTC](1677, time.September, 21, 0, 0, 0, 0), false},
+	}
+	for _, tt := range tests {
+		if got := tt.tm.UnixNanoInRange(); got != tt.want {
+			t.Errorf("UnixNanoInRange(%v) = %v, want %v", tt.tm, got, tt.want)
+		}
+	}
+}
+
 func TestSub(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -887,6 +1039,23 @@ func TestEqualWithTimeTime(t *testing.T) {
 	}
 }
 
+func TestEqualityOperator(t *testing.T) {
+	// EST loads a new *time.Location on every call, so equal instants must
+	// compare equal with == however they were constructed.
+	fromDate := Date[EST](2024, time.January, 15, 12, 0, 0, 0)
+	fromMoment := FromMoment[EST](Date[UTC](2024, time.January, 15, 17, 0, 0, 0))
+	fromUnix := Unix[EST](fromDate.Unix(), 0)
+
+	if fromDate != fromMoment || fromDate != fromUnix {
+		t.Errorf("== reports different values for the same instant: %v, %v, %v", fromDate, fromMoment, fromUnix)
+	}
+
+	seen := map[Time[EST]]bool{fromDate: true}
+	if !seen[fromMoment] {
+		t.Error("map lookup missed a key for the same instant")
+	}
+}
+
 func TestCompare(t *testing.T) {
 	t1 := Date[UTC](2024, time.January, 15, 10, 0, 0, 0)
 	t2 := Date[UTC](2024, time.January, 15, 12, 0, 0, 0)
@@ -968,6 +1137,42 @@ func TestCompareWithTimeTime(t *testing.T) {
 	}
 }
 
+func TestEqualTime(t *testing.T) {
+	utcTime := Date[UTC](2024, time.January, 15, 17, 0, 0, 0)
+	estTime := Date[EST](2024, time.January, 15, 12, 0, 0, 0)
+
+	if !EqualTime(utcTime, estTime) {
+		t.Error("EqualTime() = false for the same instant in UTC and EST")
+	}
+	if EqualTime(utcTime, estTime.Add(time.Nanosecond)) {
+		t.Error("EqualTime() = true for different instants")
+	}
+}
+
+func TestCompareTime(t *testing.T) {
+	utcTime := Date[UTC](2024, time.January, 15, 17, 0, 0, 0)
+
+	tests := []struct {
+		name string
+		u    Time[EST]
+		want int
+	}{
+		{"before", Date[EST](2024, time.January, 15, 13, 0, 0, 0), -1},
+		{"same instant", Date[EST](2024, time.January, 15, 12, 0, 0, 0), 0},
+		{"after", Date[EST](2024, time.January, 15, 11, 0, 0, 0), 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CompareTime(utcTime, tt.u); got != tt.want {
+				t.Errorf("CompareTime() = %d, want %d", got, tt.want)
+			}
+			if got := CompareTime(utcTime, tt.u); got != utcTime.Compare(tt.u) {
+				t.Errorf("CompareTime() = %d, Compare() = %d", got, utcTime.Compare(tt.u))
+			}
+		})
+	}
+}
+
 func TestIsZero(t *testing.T) {
 	zeroTime := Time[UTC]{}
 	nonZeroTime := Date[UTC](2024, time.January, 15, 12, 0, 0, 0)
@@ -1315,6 +1520,131 @@ func TestISOWeek(t *testing.T) {
 	}
 }
 
+func TestFromISOWeek(t *testing.T) {
+	tests := []struct {
+		name     string
+		year     int
+		week     int
+		weekday  time.Weekday
+		expected time.Time
+	}{
+		{
+			name:     "mid-year Saturday",
+			year:     2024,
+			week:     24,
+			weekday:  time.Saturday,
+			expected: time.Date(2024, time.June, 15, 4, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "Sunday ends the week",
+			year:     2024,
+			week:     24,
+			weekday:  time.Sunday,
+			expected: time.Date(2024, time.June, 16, 4, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "week 1 starts in previous year",
+			year:     2025,
+			week:     1,
+			weekday:  time.Monday,
+			expected: time.Date(2024, time.December, 30, 5, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "week 53 ends in next year",
+			year:     2020,
+			week:     53,
+			weekday:  time.Sunday,
+			expected: time.Date(2021, time.January, 3, 5, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "week 0 normalizes to previous year",
+			year:     2025,
+			week:     0,
+			weekday:  time.Monday,
+			expected: time.Date(2024, time.December, 23, 5, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "midnight during standard time",
+			year:     2024,
+			week:     2,
+			weekday:  time.Monday,
+			expected: time.Date(2024, time.January, 8, 5, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FromISOWeek[EST](tt.year, tt.week, tt.weekday)
+			if !got.Equal(tt.expected) {
+				t.Errorf("FromISOWeek() = %v, want %v", got.UTC(), tt.expected)
+			}
+		})
+	}
+}
+
+func TestFromISOWeekRoundTrip(t *testing.T) {
+	for day := 1; day <= 7*365; day++ {
+		d := Date[PST](2019, time.December, day, 0, 0, 0, 0)
+		year, week := d.ISOWeek()
+		if got := FromISOWeek[PST](year, week, d.Weekday()); !got.Equal(d) {
+			t.Fatalf("FromISOWeek(%d, %d, %v) = %v, want %v", year, week, d.Weekday(), got, d)
+		}
+	}
+}
+
+func TestDateTime(t *testing.T) {
+	tm := Date[EST](2024, time.July, 4, 21, 15, 30, 500)
+
+	year, month, day, hour, minute, sec, nsec := tm.DateTime()
+	if year != 2024 || month != time.July || day != 4 || hour != 21 || minute != 15 || sec != 30 || nsec != 500 {
+		t.Errorf("DateTime() = %d, %v, %d, %d, %d, %d, %d, want 2024, July, 4, 21, 15, 30, 500",
+			year, month, day, hour, minute, sec, nsec)
+	}
+
+	// The same moment falls on the next day in UTC.
+	year, month, day, hour, _, _, _ = FromMoment[UTC](tm).DateTime()
+	if year != 2024 || month != time.July || day != 5 || hour != 1 {
+		t.Errorf("UTC DateTime() = %d, %v, %d, %d, want 2024, July, 5, 1", year, month, day, hour)
+	}
+}
+
+func TestComponents(t *testing.T) {
+	tests := []struct {
+		name string
+		got  Components
+		want Components
+	}{
+		{
+			name: "daylight saving time",
+			got:  Date[EST](2024, time.July, 4, 21, 15, 30, 500).Components(),
+			want: Components{
+				Year: 2024, Month: time.July, Day: 4,
+				Hour: 21, Minute: 15, Second: 30, Nanosecond: 500,
+				Weekday: time.Thursday, YearDay: 186,
+				Zone: "EDT", Offset: -4 * 60 * 60,
+			},
+		},
+		{
+			name: "standard time",
+			got:  Date[PST](2024, time.December, 31, 23, 59, 59, 0).Components(),
+			want: Components{
+				Year: 2024, Month: time.December, Day: 31,
+				Hour: 23, Minute: 59, Second: 59,
+				Weekday: time.Tuesday, YearDay: 366,
+				Zone: "PST", Offset: -8 * 60 * 60,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("Components() = %+v, want %+v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
 func TestComponentsRespectTimezone(t *testing.T) {
 	// Create the same UTC moment represented in different timezones
 	// 2024-01-15 18:00 UTC = 2024-01-15 13:00 EST = 2024-01-15 10:00 PST
@@ -1777,6 +2107,70 @@ func containsSubstring(s, substr string) bool {
 	return false
 }
 
+func TestParse(t *testing.T) {
+	got, err := Parse[EST](time.DateTime, "2024-07-15 12:30:00")
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if want := Date[EST](2024, time.July, 15, 12, 30, 0, 0); !got.Equal(want) {
+		t.Errorf("Parse() = %v, want %v", got, want)
+	}
+}
+
+func TestParseError(t *testing.T) {
+	tests := []struct {
+		name       string
+		layout     string
+		value      string
+		wantOffset int
+		wantErr    string
+	}{
+		{
+			name:       "bad element",
+			layout:     time.DateTime,
+			value:      "2024-07-15 12:3x:00",
+			wantOffset: 14,
+			wantErr:    `parsing time "2024-07-15 12:3x:00" as "2006-01-02 15:04:05": cannot parse "3x:00" as "04" (in America/New_York, at offset 14)`,
+		},
+		{
+			name:       "out of range",
+			layout:     time.DateTime,
+			value:      "2024-13-15 12:30:00",
+			wantOffset: 7,
+			wantErr:    `parsing time "2024-13-15 12:30:00": month out of range (in America/New_York, at offset 7)`,
+		},
+		{
+			name:       "extra text",
+			layout:     time.DateOnly,
+			value:      "2024-07-15 trailing",
+			wantOffset: 10,
+			wantErr:    `parsing time "2024-07-15 trailing": extra text: " trailing" (in America/New_York, at offset 10)`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse[EST](tt.layout, tt.value)
+			var parseErr *ParseError
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("Parse() error = %T, want *ParseError", err)
+			}
+			if parseErr.Layout != tt.layout || parseErr.Value != tt.value || parseErr.Zone != "America/New_York" {
+				t.Errorf("ParseError = %+v, want layout %q, value %q, zone America/New_York", parseErr, tt.layout, tt.value)
+			}
+			if parseErr.Offset != tt.wantOffset {
+				t.Errorf("ParseError.Offset = %d, want %d", parseErr.Offset, tt.wantOffset)
+			}
+			if err.Error() != tt.wantErr {
+				t.Errorf("Error() = %q, want %q", err, tt.wantErr)
+			}
+			var stdErr *time.ParseError
+			if !errors.As(err, &stdErr) {
+				t.Errorf("Parse() error does not wrap *time.ParseError")
+			}
+		})
+	}
+}
+
 func TestUnix(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -2643,10 +3037,9 @@ func TestScanInvalidType(t *testing.T) {
 		name  string
 		value interface{}
 	}{
-		{"string", "2024-06-15T14:30:45Z"},
 		{"int", 1234567890},
 		{"float", 123.456},
-		{"bytes", []byte("2024-06-15")},
+		{"bool", true},
 	}
 
 	for _, tt := range tests {
@@ -2659,6 +3052,184 @@ func TestScanInvalidType(t *testing.T) {
 	}
 }
 
+func TestScanText(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		expected time.Time
+	}{
+		{
+			name:     "RFC3339",
+			value:    "2024-06-15T14:30:45Z",
+			expected: time.Date(2024, time.June, 15, 14, 30, 45, 0, time.UTC),
+		},
+		{
+			name:     "RFC3339 with offset and nanoseconds",
+			value:    "2024-06-15T10:30:45.123456789-04:00",
+			expected: time.Date(2024, time.June, 15, 14, 30, 45, 123456789, time.UTC),
+		},
+		{
+			name:     "SQLite with offset",
+			value:    "2024-06-15 10:30:45.5-04:00",
+			expected: time.Date(2024, time.June, 15, 14, 30, 45, 500000000, time.UTC),
+		},
+		{
+			name:     "MySQL DATETIME is UTC",
+			value:    "2024-06-15 14:30:45",
+			expected: time.Date(2024, time.June, 15, 14, 30, 45, 0, time.UTC),
+		},
+		{
+			name:     "MySQL DATETIME with microseconds",
+			value:    "2024-06-15 14:30:45.123456",
+			expected: time.Date(2024, time.June, 15, 14, 30, 45, 123456000, time.UTC),
+		},
+		{
+			name:     "ISO without offset",
+			value:    "2024-06-15T14:30:45",
+			expected: time.Date(2024, time.June, 15, 14, 30, 45, 0, time.UTC),
+		},
+		{
+			name:     "DATE",
+			value:    "2024-06-15",
+			expected: time.Date(2024, time.June, 15, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fromString Time[EST]
+			if err := fromString.Scan(tt.value); err != nil {
+				t.Fatalf("Scan(string) error = %v", err)
+			}
+			if !fromString.UTC().Equal(tt.expected) {
+				t.Errorf("Scan(string) = %v, want %v", fromString.UTC(), tt.expected)
+			}
+
+			var fromBytes Time[EST]
+			if err := fromBytes.Scan([]byte(tt.value)); err != nil {
+				t.Fatalf("Scan([]byte) error = %v", err)
+			}
+			if !fromBytes.UTC().Equal(tt.expected) {
+				t.Errorf("Scan([]byte) = %v, want %v", fromBytes.UTC(), tt.expected)
+			}
+		})
+	}
+}
+
+func TestScanTextInvalid(t *testing.T) {
+	testTime := Date[UTC](2024, time.June, 15, 14, 30, 45, 0)
+	original := testTime
+
+	if err := testTime.Scan("not a time"); err == nil {
+		t.Error("Scan() expected error for unparseable string, got nil")
+	}
+	if err := testTime.Scan([]byte("15/06/2024")); err == nil {
+		t.Error("Scan() expected error for unparseable bytes, got nil")
+	}
+	if !testTime.Equal(original) {
+		t.Errorf("Scan() modified value on error: got %v, want %v", testTime, original)
+	}
+}
+
+func TestScanCustomLayouts(t *testing.T) {
+	saved := ScanLayouts
+	t.Cleanup(func() { ScanLayouts = saved })
+
+	ScanLayouts = []string{"02/01/2006 15:04"}
+
+	var testTime Time[UTC]
+	if err := testTime.Scan("15/06/2024 14:30"); err != nil {
+		t.Fatalf("Scan() error = %v", err)
+	}
+	expected := time.Date(2024, time.June, 15, 14, 30, 0, 0, time.UTC)
+	if !testTime.UTC().Equal(expected) {
+		t.Errorf("Scan() = %v, want %v", testTime.UTC(), expected)
+	}
+
+	// Default layouts are no longer consulted.
+	if err := testTime.Scan("2024-06-15T14:30:00Z"); err == nil {
+		t.Error("Scan() expected error for layout not in ScanLayouts, got nil")
+	}
+}
+
+func TestScanZeroDate(t *testing.T) {
+	values := []interface{}{
+		"0000-00-00",
+		"0000-00-00 00:00:00",
+		[]byte("0000-00-00 00:00:00.000000"),
+		"0000-00-00T00:00:00",
+	}
+
+	for _, value := range values {
+		t.Run(fmt.Sprintf("%s", value), func(t *testing.T) {
+			testTime := Date[UTC](2024, time.June, 15, 14, 30, 45, 0)
+			if err := testTime.Scan(value); err != nil {
+				t.Fatalf("Scan() error = %v", err)
+			}
+			if !testTime.IsZero() {
+				t.Errorf("Scan() = %v, want zero time", testTime)
+			}
+		})
+	}
+}
+
+func TestScanZeroDateAsError(t *testing.T) {
+	t.Cleanup(func() { ScanZeroDatePolicy = ZeroDateAsZeroTime })
+	ScanZeroDatePolicy = ZeroDateAsError
+
+	var testTime Time[UTC]
+	err := testTime.Scan("0000-00-00 00:00:00")
+	if !errors.Is(err, ErrZeroDate) {
+		t.Errorf("Scan() error = %v, want ErrZeroDate", err)
+	}
+
+	// Non-zero dates are unaffected by the policy.
+	if err := testTime.Scan("2024-06-15 14:30:45"); err != nil {
+		t.Errorf("Scan() error = %v for valid date", err)
+	}
+
+	// A zero time.Time from the driver is not a zero date string.
+	if err := testTime.Scan(time.Time{}); err != nil {
+		t.Errorf("Scan(time.Time{}) error = %v", err)
+	}
+}
+
+func TestIsZeroDate(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected bool
+	}{
+		{"0000-00-00", true},
+		{"0000-00-00 00:00:00", true},
+		{"0000-00-00 00:00:00.000", true},
+		{"0000-00-00 00:00:01", false},
+		{"0000-01-01", false},
+		{"2024-06-15", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		if got := isZeroDate(tt.value); got != tt.expected {
+			t.Errorf("isZeroDate(%q) = %v, want %v", tt.value, got, tt.expected)
+		}
+	}
+}
+
+func TestScanInt64(t *testing.T) {
+	var testTime Time[PST]
+	if err := testTime.Scan(int64(1705320000)); err != nil {
+		t.Fatalf("Scan(int64) error = %v", err)
+	}
+
+	expected := time.Date(2024, time.January, 15, 12, 0, 0, 0, time.UTC)
+	if !testTime.UTC().Equal(expected) {
+		t.Errorf("Scan(int64) = %v, want %v", testTime.UTC(), expected)
+	}
+	if testTime.Hour() != 4 {
+		t.Errorf("Hour() = %d, want 4 (PST)", testTime.Hour())
+	}
+}
+
 func TestSQLRoundTrip(t *testing.T) {
 	original := Date[UTC](2024, time.June, 15, 14, 30, 45, 123456789)
 
@@ -0,0 +1,288 @@
+/*
+Package meridianbind binds request parameters into structs with typed time
+fields, honoring a per-field layout tag:
+
+	type ReportRequest struct {
+		Since   et.Time  `form:"since" layout:"2006-01-02"`
+		Until   *et.Time `form:"until" layout:"2006-01-02"`
+		Created utc.Time `form:"created_after" layout:"unix"`
+		Limit   int      `form:"limit"`
+	}
+
+Times are parsed in the timezone of the field, so "2024-12-31" above is
+midnight in New York. The layout may be any reference layout, or "unix" or
+"unixmilli" for integer timestamps. It applies to Time[TZ] and time.Time
+fields; fields without one, and Null[TZ] fields, accept the layouts of
+Time.UnmarshalParam.
+
+Query, Form, and URI implement the binding interfaces of
+github.com/gin-gonic/gin without importing it:
+
+	var req ReportRequest
+	if err := c.ShouldBindWith(&req, meridianbind.Query); err != nil {
+		...
+	}
+
+With echo, or with net/http directly, pass the parameters to Values:
+
+	err := meridianbind.Values(&req, c.QueryParams(), "query")
+
+Time[TZ] and Null[TZ] also implement the BindUnmarshaler interfaces of gin
+and echo, so their own binders accept typed times in the default layouts.
+*/
+package meridianbind
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/matthalp/go-meridian/v2"
+)
+
+// Query binds URL query parameters, using the "form" tag as gin does.
+var Query Binding = queryBinding{}
+
+// Form binds URL query parameters and url-encoded or multipart form fields,
+// using the "form" tag.
+var Form Binding = formBinding{}
+
+// URI binds path parameters, using the "uri" tag. It implements gin's
+// binding.BindingUri, for use with c.Params:
+//
+//	params := make(map[string][]string)
+//	for _, p := range c.Params {
+//		params[p.Key] = []string{p.Value}
+//	}
+//	err := meridianbind.URI.BindUri(params, &req)
+var URI URIBinding = uriBinding{}
+
+// Binding binds the parameters of a request into a struct. It matches the
+// binding.Binding interface of gin.
+type Binding interface {
+	Name() string
+	Bind(req *http.Request, dst any) error
+}
+
+// URIBinding binds path parameters into a struct. It matches the
+// binding.BindingUri interface of gin.
+type URIBinding interface {
+	Name() string
+	BindUri(params map[string][]string, dst any) error
+}
+
+type queryBinding struct{}
+
+func (queryBinding) Name() string { return "query" }
+
+func (queryBinding) Bind(req *http.Request, dst any) error {
+	return Values(dst, req.URL.Query(), "form")
+}
+
+type formBinding struct{}
+
+func (formBinding) Name() string { return "form" }
+
+// maxMemory is the memory used to buffer multipart forms, as by gin.
+const maxMemory = 32 << 20
+
+func (formBinding) Bind(req *http.Request, dst any) error {
+	if err := req.ParseMultipartForm(maxMemory); err != nil && !errors.Is(err, http.ErrNotMultipart) {
+		return err
+	}
+	return Values(dst, req.Form, "form")
+}
+
+type uriBinding struct{}
+
+func (uriBinding) Name() string { return "uri" }
+
+func (uriBinding) BindUri(params map[string][]string, dst any) error {
+	return Values(dst, params, "uri")
+}
+
+var (
+	momentType           = reflect.TypeOf((*meridian.MomentWithLocation)(nil)).Elem()
+	paramUnmarshalerType = reflect.TypeOf((*paramUnmarshaler)(nil)).Elem()
+	textUnmarshalerType  = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+	durationType         = reflect.TypeOf(time.Duration(0))
+	timeType             = reflect.TypeOf(time.Time{})
+)
+
+// paramUnmarshaler is the BindUnmarshaler interface of gin and echo.
+type paramUnmarshaler interface {
+	UnmarshalParam(param string) error
+}
+
+// Values binds values into the exported fields of the struct that dst points
+// to. Each field is bound from the parameter named by its tag, or by its
+// name if it has none; fields tagged "-" are skipped, as are fields whose
+// parameter is absent. Embedded structs are bound as if their fields were
+// part of dst.
+//
+// Fields may be typed times, with an optional layout tag, or implement
+// UnmarshalParam or encoding.TextUnmarshaler, or be strings, booleans,
+// numbers, or time.Durations, or pointers to or slices of any of these. A
+// slice receives every value of its parameter; other fields receive the first.
+func Values(dst any, values url.Values, tag string) error {
+	v := reflect.ValueOf(dst)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("cannot bind into %T: want a pointer to a struct", dst)
+	}
+	return bindStruct(v.Elem(), values, tag)
+}
+
+func bindStruct(v reflect.Value, values url.Values, tag string) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name := field.Tag.Get(tag)
+		if name == "-" {
+			continue
+		}
+		if field.Anonymous && name == "" && field.Type.Kind() == reflect.Struct && !bindable(field.Type) {
+			if err := bindStruct(v.Field(i), values, tag); err != nil {
+				return err
+			}
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		params, ok := values[name]
+		if !ok || len(params) == 0 {
+			continue
+		}
+		if err := bindField(v.Field(i), params, field.Tag.Get("layout")); err != nil {
+			return fmt.Errorf("cannot bind %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// bindable reports whether values of type t are bound from a parameter
+// rather than field by field.
+func bindable(t reflect.Type) bool {
+	ptr := reflect.PointerTo(t)
+	return t.Implements(momentType) || ptr.Implements(paramUnmarshalerType) || ptr.Implements(textUnmarshalerType)
+}
+
+func bindField(v reflect.Value, params []string, layout string) error {
+	if v.Kind() == reflect.Slice && !bindable(v.Type()) {
+		slice := reflect.MakeSlice(v.Type(), len(params), len(params))
+		for i, param := range params {
+			if err := bindValue(slice.Index(i), param, layout); err != nil {
+				return err
+			}
+		}
+		v.Set(slice)
+		return nil
+	}
+	return bindValue(v, params[0], layout)
+}
+
+func bindValue(v reflect.Value, param, layout string) error {
+	if v.Kind() == reflect.Pointer {
+		elem := reflect.New(v.Type().Elem())
+		if err := bindValue(elem.Elem(), param, layout); err != nil {
+			return err
+		}
+		v.Set(elem)
+		return nil
+	}
+
+	if layout != "" && (v.Type() == timeType || v.Type().Implements(momentType)) {
+		return bindTime(v, param, layout)
+	}
+	switch u := v.Addr().Interface().(type) {
+	case paramUnmarshaler:
+		return u.UnmarshalParam(param)
+	case encoding.TextUnmarshaler:
+		return u.UnmarshalText([]byte(param))
+	}
+
+	switch {
+	case v.Type() == durationType:
+		d, err := time.ParseDuration(param)
+		if err != nil {
+			return err
+		}
+		v.SetInt(int64(d))
+		return nil
+	case v.Kind() == reflect.String:
+		v.SetString(param)
+		return nil
+	case v.Kind() == reflect.Bool:
+		b, err := strconv.ParseBool(param)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+		return nil
+	case v.CanInt():
+		n, err := strconv.ParseInt(param, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+		return nil
+	case v.CanUint():
+		n, err := strconv.ParseUint(param, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(n)
+		return nil
+	case v.CanFloat():
+		f, err := strconv.ParseFloat(param, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+		return nil
+	}
+	return fmt.Errorf("unsupported field type %s", v.Type())
+}
+
+// bindTime parses param with layout in the location of the typed time v and
+// stores the result in v.
+func bindTime(v reflect.Value, param, layout string) error {
+	var t time.Time
+	switch layout {
+	case "unix", "unixmilli":
+		n, err := strconv.ParseInt(param, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid %s timestamp %q", layout, param)
+		}
+		if layout == "unix" {
+			t = time.Unix(n, 0)
+		} else {
+			t = time.UnixMilli(n)
+		}
+	default:
+		loc := v.Interface().(meridian.MomentWithLocation).Location()
+		parsed, err := time.ParseInLocation(layout, param, loc)
+		if err != nil {
+			return err
+		}
+		t = parsed
+	}
+
+	if v.Type() == timeType {
+		v.Set(reflect.ValueOf(t))
+		return nil
+	}
+	scanner, ok := v.Addr().Interface().(interface{ Scan(any) error })
+	if !ok {
+		return fmt.Errorf("unsupported field type %s", v.Type())
+	}
+	return scanner.Scan(t)
+}
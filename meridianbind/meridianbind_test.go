@@ -0,0 +1,140 @@
+package meridianbind
+
+import (
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/matthalp/go-meridian/v2"
+	"github.com/matthalp/go-meridian/v2/timezones/et"
+	"github.com/matthalp/go-meridian/v2/timezones/utc"
+)
+
+type page struct {
+	Limit  int    `form:"limit"`
+	Cursor string `form:"cursor"`
+}
+
+type reportRequest struct {
+	page
+	Since    et.Time                    `form:"since" layout:"2006-01-02"`
+	Until    *et.Time                   `form:"until" layout:"2006-01-02 15:04"`
+	Created  utc.Time                   `form:"created_after" layout:"unix"`
+	Updated  utc.Time                   `form:"updated_after" layout:"unixmilli"`
+	At       et.Time                    `form:"at"`
+	Deleted  meridian.Null[et.Timezone] `form:"deleted"`
+	Days     []et.Time                  `form:"day" layout:"2006-01-02"`
+	Window   time.Duration              `form:"window"`
+	Verbose  bool                       `form:"verbose"`
+	Ratio    float64                    `form:"ratio"`
+	Ignored  string                     `form:"-"`
+	Untagged string
+}
+
+func TestValues(t *testing.T) {
+	values := url.Values{
+		"limit":         {"25"},
+		"cursor":        {"abc"},
+		"since":         {"2024-03-10"},
+		"until":         {"2024-03-11 17:30"},
+		"created_after": {"1700000000"},
+		"updated_after": {"1700000000123"},
+		"at":            {"2024-03-10T12:00:00Z"},
+		"deleted":       {""},
+		"day":           {"2024-03-09", "2024-03-10"},
+		"window":        {"90m"},
+		"verbose":       {"true"},
+		"ratio":         {"0.5"},
+		"Ignored":       {"x"},
+		"-":             {"x"},
+		"Untagged":      {"by name"},
+	}
+	var req reportRequest
+	if err := Values(&req, values, "form"); err != nil {
+		t.Fatalf("Values() error = %v", err)
+	}
+
+	if req.Limit != 25 || req.Cursor != "abc" {
+		t.Errorf("embedded page = %+v, want {25 abc}", req.page)
+	}
+	if want := et.Date(2024, time.March, 10, 0, 0, 0, 0); !req.Since.Equal(want) {
+		t.Errorf("Since = %v, want %v", req.Since, want)
+	}
+	if want := et.Date(2024, time.March, 11, 17, 30, 0, 0); req.Until == nil || !req.Until.Equal(want) {
+		t.Errorf("Until = %v, want %v", req.Until, want)
+	}
+	if got := req.Created.Unix(); got != 1700000000 {
+		t.Errorf("Created.Unix() = %d, want 1700000000", got)
+	}
+	if got := req.Updated.UnixMilli(); got != 1700000000123 {
+		t.Errorf("Updated.UnixMilli() = %d, want 1700000000123", got)
+	}
+	if want := time.Date(2024, time.March, 10, 12, 0, 0, 0, time.UTC); !req.At.Equal(want) {
+		t.Errorf("At = %v, want %v", req.At, want)
+	}
+	if req.Deleted.Valid {
+		t.Errorf("Deleted = %+v, want invalid", req.Deleted)
+	}
+	if len(req.Days) != 2 || req.Days[1].Day() != 10 {
+		t.Errorf("Days = %v, want 2024-03-09 and 2024-03-10", req.Days)
+	}
+	if req.Window != 90*time.Minute || !req.Verbose || req.Ratio != 0.5 {
+		t.Errorf("Window, Verbose, Ratio = %v, %v, %v", req.Window, req.Verbose, req.Ratio)
+	}
+	if req.Ignored != "" {
+		t.Errorf("Ignored = %q, want empty", req.Ignored)
+	}
+	if req.Untagged != "by name" {
+		t.Errorf("Untagged = %q, want %q", req.Untagged, "by name")
+	}
+}
+
+func TestValuesErrors(t *testing.T) {
+	var req reportRequest
+	for _, values := range []url.Values{
+		{"since": {"03/10/2024"}},
+		{"created_after": {"soon"}},
+		{"limit": {"many"}},
+		{"at": {"noon"}},
+	} {
+		if err := Values(&req, values, "form"); err == nil {
+			t.Errorf("Values(%v) succeeded, want error", values)
+		}
+	}
+	if err := Values(req, url.Values{}, "form"); err == nil {
+		t.Error("Values(non-pointer) succeeded, want error")
+	}
+}
+
+func TestBindings(t *testing.T) {
+	var fromQuery reportRequest
+	req := httptest.NewRequest("GET", "/reports?since=2024-03-10&limit=5", nil)
+	if err := Query.Bind(req, &fromQuery); err != nil {
+		t.Fatalf("Query.Bind() error = %v", err)
+	}
+	if fromQuery.Since.Day() != 10 || fromQuery.Limit != 5 {
+		t.Errorf("Query.Bind() = %+v", fromQuery)
+	}
+
+	var fromForm reportRequest
+	req = httptest.NewRequest("POST", "/reports?limit=5", strings.NewReader("since=2024-03-12"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if err := Form.Bind(req, &fromForm); err != nil {
+		t.Fatalf("Form.Bind() error = %v", err)
+	}
+	if fromForm.Since.Day() != 12 || fromForm.Limit != 5 {
+		t.Errorf("Form.Bind() = %+v", fromForm)
+	}
+
+	var fromURI struct {
+		Day et.Time `uri:"day" layout:"2006-01-02"`
+	}
+	if err := URI.BindUri(map[string][]string{"day": {"2024-03-15"}}, &fromURI); err != nil {
+		t.Fatalf("URI.BindUri() error = %v", err)
+	}
+	if fromURI.Day.Day() != 15 {
+		t.Errorf("URI.BindUri() = %v", fromURI.Day)
+	}
+}
@@ -0,0 +1,38 @@
+/*
+Package meridianctx provides typed wrappers around context deadlines.
+
+Deadlines propagated through context.Context are plain time.Time values. The
+helpers in this package keep deadline handling in the typed world, so the
+conversion to and from time.Time happens in exactly one place:
+
+	ctx, cancel := meridianctx.WithDeadline(ctx, order.ShipBy)
+	defer cancel()
+
+	if deadline, ok := meridianctx.Deadline[et.Timezone](ctx); ok {
+		log.Printf("must finish by %s", deadline.Format(time.Kitchen))
+	}
+*/
+package meridianctx
+
+import (
+	"context"
+
+	"github.com/matthalp/go-meridian/v2"
+)
+
+// WithDeadline returns a copy of parent with the deadline adjusted to be no
+// later than d. The parameter d can be any Moment (time.Time or Time[TZ]).
+// See context.WithDeadline for details on cancellation.
+func WithDeadline(parent context.Context, d meridian.Moment) (context.Context, context.CancelFunc) {
+	return context.WithDeadline(parent, d.UTC())
+}
+
+// Deadline returns the deadline of ctx in the specified timezone. The ok
+// result is false when no deadline is set, as with context.Context.Deadline.
+func Deadline[TZ meridian.Timezone](ctx context.Context) (deadline meridian.Time[TZ], ok bool) {
+	d, ok := ctx.Deadline()
+	if !ok {
+		return meridian.Time[TZ]{}, false
+	}
+	return meridian.FromMoment[TZ](d), true
+}
@@ -0,0 +1,83 @@
+package meridianctx
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/matthalp/go-meridian/v2/timezones/et"
+	"github.com/matthalp/go-meridian/v2/timezones/utc"
+)
+
+func TestWithDeadline(t *testing.T) {
+	deadline := et.Now().Add(time.Hour)
+
+	ctx, cancel := WithDeadline(context.Background(), deadline)
+	defer cancel()
+
+	got, ok := ctx.Deadline()
+	if !ok {
+		t.Fatal("Deadline() ok = false, want true")
+	}
+	if !deadline.Equal(got) {
+		t.Errorf("Deadline() = %v, want %v", got, deadline)
+	}
+}
+
+func TestWithDeadlineInPast(t *testing.T) {
+	ctx, cancel := WithDeadline(context.Background(), utc.Now().Add(-time.Second))
+	defer cancel()
+
+	select {
+	case <-ctx.Done():
+	default:
+		t.Fatal("context with past deadline is not done")
+	}
+	if ctx.Err() != context.DeadlineExceeded {
+		t.Errorf("Err() = %v, want context.DeadlineExceeded", ctx.Err())
+	}
+}
+
+func TestWithDeadlineKeepsEarlierParent(t *testing.T) {
+	parentDeadline := utc.Now().Add(time.Minute)
+	parent, cancelParent := WithDeadline(context.Background(), parentDeadline)
+	defer cancelParent()
+
+	ctx, cancel := WithDeadline(parent, et.Now().Add(time.Hour))
+	defer cancel()
+
+	got, ok := Deadline[utc.Timezone](ctx)
+	if !ok {
+		t.Fatal("Deadline() ok = false, want true")
+	}
+	if !got.Equal(parentDeadline) {
+		t.Errorf("Deadline() = %v, want parent deadline %v", got, parentDeadline)
+	}
+}
+
+func TestDeadline(t *testing.T) {
+	deadline := time.Date(2099, time.January, 15, 17, 0, 0, 0, time.UTC)
+	ctx, cancel := context.WithDeadline(context.Background(), deadline)
+	defer cancel()
+
+	got, ok := Deadline[et.Timezone](ctx)
+	if !ok {
+		t.Fatal("Deadline() ok = false, want true")
+	}
+	if !got.Equal(deadline) {
+		t.Errorf("Deadline() = %v, want %v", got, deadline)
+	}
+	if got.Hour() != 12 {
+		t.Errorf("Hour() = %d, want 12 (EST)", got.Hour())
+	}
+}
+
+func TestDeadlineNotSet(t *testing.T) {
+	got, ok := Deadline[utc.Timezone](context.Background())
+	if ok {
+		t.Error("Deadline() ok = true for context without deadline, want false")
+	}
+	if !got.IsZero() {
+		t.Errorf("Deadline() = %v, want zero time", got)
+	}
+}
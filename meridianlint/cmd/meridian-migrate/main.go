@@ -0,0 +1,24 @@
+// Command meridian-migrate rewrites time.Time struct fields and function
+// signatures in the main module to the Time type of a meridian zone package,
+// inserting the conversions needed where values cross the changed
+// declarations:
+//
+//	go install github.com/matthalp/go-meridian/v2/meridianlint/cmd/meridian-migrate@latest
+//	meridian-migrate -zone=utc -fix -diff ./...   # preview
+//	meridian-migrate -zone=utc -fix ./...         # rewrite
+//
+// Without -fix it lists the files it would change. Run it over every package
+// of the module at once, so that uses of a migrated declaration in other
+// packages are converted too. See meridianlint.MigrateAnalyzer for the rules
+// it follows.
+package main
+
+import (
+	"golang.org/x/tools/go/analysis/singlechecker"
+
+	"github.com/matthalp/go-meridian/v2/meridianlint"
+)
+
+func main() {
+	singlechecker.Main(meridianlint.MigrateAnalyzer)
+}
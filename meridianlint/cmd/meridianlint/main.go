@@ -0,0 +1,24 @@
+// Command meridianlint runs the meridian analyzers, either as a go vet tool:
+//
+//	go install github.com/matthalp/go-meridian/v2/meridianlint/cmd/meridianlint@latest
+//	go vet -vettool=$(which meridianlint) ./...
+//
+// or standalone, which can also apply the analyzers' suggested fixes:
+//
+//	meridianlint -fix ./...
+//
+// See package meridianlint for the checks it performs.
+package main
+
+import (
+	"golang.org/x/tools/go/analysis/multichecker"
+
+	"github.com/matthalp/go-meridian/v2/meridianlint"
+)
+
+func main() {
+	multichecker.Main(
+		meridianlint.Analyzer,
+		meridianlint.ErasureAnalyzer,
+	)
+}
@@ -0,0 +1,229 @@
+package meridianlint
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strconv"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+// ErasureAnalyzer reports typed times converted to time.Time only to be
+// passed to a function of the same package.
+var ErasureAnalyzer = &analysis.Analyzer{
+	Name:     "tzerasure",
+	Doc:      "report typed times erased to time.Time at function boundaries\n\nPassing t.UTC(), t.Time(), or t.In(loc) for a meridian.Time to a function that\ntakes a time.Time discards the timezone guarantee of t's type. The analyzer\nsuggests changing the function to accept the typed time or a meridian.Moment.",
+	Run:      runErasure,
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+}
+
+// erasingMethods are the meridian.Time methods that return a time.Time.
+var erasingMethods = map[string]bool{
+	"UTC":  true,
+	"Time": true,
+	"In":   true,
+}
+
+func runErasure(pass *analysis.Pass) (interface{}, error) {
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	inspect.Preorder([]ast.Node{(*ast.CallExpr)(nil)}, func(n ast.Node) {
+		call := n.(*ast.CallExpr)
+		callee, ok := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+		if !ok || callee.Pkg() != pass.Pkg {
+			return
+		}
+		sig := callee.Type().(*types.Signature)
+
+		for i, arg := range call.Args {
+			if i >= sig.Params().Len() || (sig.Variadic() && i >= sig.Params().Len()-1) {
+				break
+			}
+			param := sig.Params().At(i)
+			if !isTimeTime(param.Type()) {
+				continue
+			}
+			erasure, ok := ast.Unparen(arg).(*ast.CallExpr)
+			if !ok {
+				continue
+			}
+			sel, ok := erasure.Fun.(*ast.SelectorExpr)
+			if !ok || !erasingMethods[sel.Sel.Name] {
+				continue
+			}
+			typed := types.Unalias(pass.TypesInfo.TypeOf(sel.X))
+			if !isMeridianTime(typed) || isGenerated(pass, call) {
+				continue
+			}
+
+			pass.Report(analysis.Diagnostic{
+				Pos:            erasure.Pos(),
+				End:            erasure.End(),
+				Message:        fmt.Sprintf("%s erases the timezone of a %s passed to %s; accept a typed time or meridian.Moment instead", sel.Sel.Name+"()", types.TypeString(typed, (*types.Package).Name), callee.Name()),
+				SuggestedFixes: erasureFixes(pass, callee, param, sel, erasure, typed),
+			})
+		}
+	})
+
+	return nil, nil
+}
+
+// erasureFixes returns fixes that change the type of param, a parameter of
+// callee, to typed or to meridian.Moment, and pass the receiver of the erasing
+// call directly. It returns no fixes if the parameter's declaration cannot be
+// changed on its own.
+func erasureFixes(pass *analysis.Pass, callee *types.Func, param *types.Var, sel *ast.SelectorExpr, erasure *ast.CallExpr, typed types.Type) []analysis.SuggestedFix {
+	file, field := paramField(pass, callee, param)
+	if field == nil || len(field.Names) != 1 {
+		return nil
+	}
+	// Drop ".UTC()" and friends, leaving the receiver.
+	unwrap := analysis.TextEdit{Pos: sel.X.End(), End: erasure.End()}
+
+	var fixes []analysis.SuggestedFix
+	for _, fix := range []struct {
+		message string
+		typ     types.Type
+	}{
+		{"Accept the typed time", typed},
+		{"Accept a meridian.Moment", meridianMoment(typed)},
+	} {
+		if fix.typ == nil {
+			continue
+		}
+		imports := newImportAdder(file)
+		text := types.TypeString(fix.typ, imports.qualifier(pass.Pkg))
+		edits := []analysis.TextEdit{
+			{Pos: field.Type.Pos(), End: field.Type.End(), NewText: []byte(text)},
+			unwrap,
+		}
+		fixes = append(fixes, analysis.SuggestedFix{
+			Message:   fix.message,
+			TextEdits: append(edits, imports.edits()...),
+		})
+	}
+	return fixes
+}
+
+// paramField returns the file and field declaring param of callee, or nil if
+// the declaration is not in the package's files.
+func paramField(pass *analysis.Pass, callee *types.Func, param *types.Var) (*ast.File, *ast.Field) {
+	for _, file := range pass.Files {
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || pass.TypesInfo.Defs[fn.Name] != callee {
+				continue
+			}
+			for _, field := range fn.Type.Params.List {
+				for _, name := range field.Names {
+					if pass.TypesInfo.Defs[name] == param {
+						return file, field
+					}
+				}
+			}
+			return file, nil
+		}
+	}
+	return nil, nil
+}
+
+// meridianMoment returns the Moment interface of the meridian package that
+// declares typed, a meridian.Time instance.
+func meridianMoment(typed types.Type) types.Type {
+	named, ok := typed.(*types.Named)
+	if !ok {
+		return nil
+	}
+	moment := named.Obj().Pkg().Scope().Lookup("Moment")
+	if moment == nil {
+		return nil
+	}
+	return moment.Type()
+}
+
+// isTimeTime reports whether t is time.Time.
+func isTimeTime(t types.Type) bool {
+	named, ok := types.Unalias(t).(*types.Named)
+	return ok && named.Obj().Pkg() != nil && named.Obj().Pkg().Path() == "time" && named.Obj().Name() == "Time"
+}
+
+// isMeridianTime reports whether t is an instance of meridian.Time.
+func isMeridianTime(t types.Type) bool {
+	named, ok := types.Unalias(t).(*types.Named)
+	return ok && named.Obj().Pkg() != nil && isMeridianPath(named.Obj().Pkg().Path()) &&
+		named.Obj().Name() == "Time" && named.TypeArgs().Len() == 1
+}
+
+// importAdder qualifies package names as they are spelled in a file, adding
+// imports for packages the file does not yet import.
+type importAdder struct {
+	file    *ast.File
+	names   map[string]string // import path to local name
+	missing []string          // paths to add, in order
+}
+
+func newImportAdder(file *ast.File) *importAdder {
+	a := &importAdder{file: file, names: make(map[string]string)}
+	for _, spec := range file.Imports {
+		path, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			continue
+		}
+		if spec.Name != nil {
+			a.names[path] = spec.Name.Name
+		} else {
+			a.names[path] = ""
+		}
+	}
+	return a
+}
+
+// qualifier returns a types.Qualifier for a file of pkg.
+func (a *importAdder) qualifier(pkg *types.Package) types.Qualifier {
+	return func(p *types.Package) string {
+		if p == pkg {
+			return ""
+		}
+		return a.name(p.Path(), p.Name())
+	}
+}
+
+// name returns the name by which the file refers to the package with the
+// given path and package name, adding an import if the file lacks one.
+func (a *importAdder) name(path, pkgName string) string {
+	name, ok := a.names[path]
+	if !ok {
+		a.names[path] = ""
+		a.missing = append(a.missing, path)
+	}
+	if name == "" {
+		return pkgName
+	}
+	return name
+}
+
+// edits returns the edits adding the missing imports after the file's last
+// import declaration, or after its package clause.
+func (a *importAdder) edits() []analysis.TextEdit {
+	if len(a.missing) == 0 {
+		return nil
+	}
+	pos := a.file.Name.End()
+	for _, decl := range a.file.Decls {
+		if gen, ok := decl.(*ast.GenDecl); ok && gen.Tok == token.IMPORT {
+			pos = gen.End()
+		}
+	}
+	var text []byte
+	for _, path := range a.missing {
+		text = append(text, "\nimport "+strconv.Quote(path)...)
+	}
+	if pos == a.file.Name.End() {
+		text = append([]byte("\n"), text...)
+	}
+	return []analysis.TextEdit{{Pos: pos, End: pos, NewText: text}}
+}
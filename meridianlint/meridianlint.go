@@ -0,0 +1,181 @@
+/*
+Package meridianlint provides go/analysis analyzers that enforce typed
+timezone handling in code that uses meridian.
+
+Meridian makes wrong timezone handling a compile error only where typed times
+are used. Analyzer reports the places where code that already imports
+meridian falls back to the time package's untyped constructors:
+
+	deadline := time.Now().Add(time.Hour) // time.Now returns an untyped time.Time
+
+should be written with a zone package instead:
+
+	deadline := utc.Now().Add(time.Hour)
+
+The analyzer suggests that rewrite as a fix, along with the import it needs.
+time.Date is only fixed when its location is time.UTC.
+
+ErasureAnalyzer reports typed times that are converted back to time.Time
+only to be passed to another function, which silently drops the guarantee:
+
+	record(deadline.UTC()) // UTC() erases the timezone of a meridian.Time[utc.Timezone]
+
+and suggests changing the function to accept the typed time or a
+meridian.Moment instead.
+
+The analyzers run under go vet via the meridianlint command:
+
+	go install github.com/matthalp/go-meridian/v2/meridianlint/cmd/meridianlint@latest
+	go vet -vettool=$(which meridianlint) ./...
+
+Run on its own, the meridianlint command applies the suggested fixes:
+
+	meridianlint -fix ./...
+
+MigrateAnalyzer is not a check but a codemod for adopting meridian: it
+rewrites time.Time struct fields and function signatures to a zone package's
+Time type. The meridian-migrate command applies it:
+
+	meridian-migrate -zone=utc -fix ./...
+*/
+package meridianlint
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+// modulePrefix is the path prefix of every meridian package, across major
+// versions.
+const modulePrefix = "github.com/matthalp/go-meridian"
+
+// Analyzer reports uses of time.Now, time.Date, and time.Parse in packages
+// that import meridian, suggesting the utc package's equivalent.
+var Analyzer = &analysis.Analyzer{
+	Name:     "rawtime",
+	Doc:      "report untyped time constructors in packages that use meridian\n\nIn a package that imports meridian, time.Now, time.Date, and time.Parse produce\ntime.Time values whose timezone is not part of their type. Use the equivalent\nfunction of a zone package, such as utc.Now, instead.",
+	Run:      runRawTime,
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+}
+
+// rawTimeFuncs maps the reported time package functions to their typed
+// equivalents.
+var rawTimeFuncs = map[string]string{
+	"Now":   "utc.Now()",
+	"Date":  "utc.Date(year, month, day, hour, min, sec, nsec)",
+	"Parse": "utc.Parse(layout, value)",
+}
+
+func runRawTime(pass *analysis.Pass) (interface{}, error) {
+	if !importsMeridian(pass.Pkg) {
+		return nil, nil
+	}
+
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	inspect.WithStack([]ast.Node{(*ast.SelectorExpr)(nil)}, func(n ast.Node, push bool, stack []ast.Node) bool {
+		if !push {
+			return true
+		}
+		sel := n.(*ast.SelectorExpr)
+		fn, ok := pass.TypesInfo.Uses[sel.Sel].(*types.Func)
+		if !ok || fn.Pkg() == nil || fn.Pkg().Path() != "time" {
+			return true
+		}
+		typed, ok := rawTimeFuncs[fn.Name()]
+		if !ok || isGenerated(pass, sel) {
+			return true
+		}
+
+		var fixes []analysis.SuggestedFix
+		if call, ok := stack[len(stack)-2].(*ast.CallExpr); ok && call.Fun == sel {
+			fixes = rawTimeFixes(pass, stack[0].(*ast.File), sel, call)
+		}
+		pass.Report(analysis.Diagnostic{
+			Pos:            sel.Pos(),
+			End:            sel.End(),
+			Message:        fmt.Sprintf("time.%s returns an untyped time.Time; use a zone package instead, such as %s", fn.Name(), typed),
+			SuggestedFixes: fixes,
+		})
+		return true
+	})
+
+	return nil, nil
+}
+
+// rawTimeFixes returns a fix calling the utc package's equivalent of call, a
+// call of the time package function sel. time.Date is only fixed when its
+// location is time.UTC, which utc.Date leaves out.
+func rawTimeFixes(pass *analysis.Pass, file *ast.File, sel *ast.SelectorExpr, call *ast.CallExpr) []analysis.SuggestedFix {
+	var edits []analysis.TextEdit
+	removed := 1 // uses of the time package the fix removes
+	if sel.Sel.Name == "Date" {
+		if len(call.Args) != 8 || !isTimeUTC(pass, call.Args[7]) {
+			return nil
+		}
+		edits = append(edits, analysis.TextEdit{Pos: call.Args[6].End(), End: call.Args[7].End()})
+		removed++
+	}
+
+	utcPath, utcName := zonePackage("utc")
+	imports := newImportAdder(file)
+	name := imports.name(utcPath, utcName) + "." + sel.Sel.Name
+	edits = append(edits, analysis.TextEdit{Pos: sel.Pos(), End: sel.End(), NewText: []byte(name)})
+	edits = append(edits, timeImportEdits(pass, file, imports, utcPath, removed)...)
+	return []analysis.SuggestedFix{{
+		Message:   "Use " + name,
+		TextEdits: append(edits, imports.edits()...),
+	}}
+}
+
+// isTimeUTC reports whether e is time.UTC.
+func isTimeUTC(pass *analysis.Pass, e ast.Expr) bool {
+	sel, ok := e.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+	if _, ok := sel.X.(*ast.Ident); !ok {
+		return false
+	}
+	v, ok := pass.TypesInfo.Uses[sel.Sel].(*types.Var)
+	return ok && v.Pkg() != nil && v.Pkg().Path() == "time" && v.Name() == "UTC"
+}
+
+// importsMeridian reports whether pkg imports the meridian module or any of
+// its packages.
+func importsMeridian(pkg *types.Package) bool {
+	for _, imp := range pkg.Imports() {
+		if isMeridianPath(imp.Path()) {
+			return true
+		}
+	}
+	return false
+}
+
+// isMeridianPath reports whether path is a package of the meridian module.
+func isMeridianPath(path string) bool {
+	return path == modulePrefix || strings.HasPrefix(path, modulePrefix+"/")
+}
+
+// isGenerated reports whether n is in a file with a "Code generated ... DO
+// NOT EDIT." comment, which users cannot change.
+func isGenerated(pass *analysis.Pass, n ast.Node) bool {
+	for _, f := range pass.Files {
+		if f.Pos() <= n.Pos() && n.Pos() < f.End() {
+			for _, group := range f.Comments {
+				for _, c := range group.List {
+					if strings.HasPrefix(c.Text, "// Code generated ") && strings.HasSuffix(c.Text, " DO NOT EDIT.") {
+						return true
+					}
+				}
+			}
+			return false
+		}
+	}
+	return false
+}
@@ -0,0 +1,23 @@
+package meridianlint_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+
+	"github.com/matthalp/go-meridian/v2/meridianlint"
+)
+
+func TestAnalyzer(t *testing.T) {
+	analysistest.RunWithSuggestedFixes(t, analysistest.TestData(), meridianlint.Analyzer, "a", "b", "rawfix")
+}
+
+func TestErasureAnalyzer(t *testing.T) {
+	analysistest.RunWithSuggestedFixes(t, analysistest.TestData(), meridianlint.ErasureAnalyzer, "erasure")
+}
+
+func TestMigrateAnalyzer(t *testing.T) {
+	dir := filepath.Join(analysistest.TestData(), "migrate")
+	analysistest.RunWithSuggestedFixes(t, dir, meridianlint.MigrateAnalyzer, "./...")
+}
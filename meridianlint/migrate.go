@@ -0,0 +1,627 @@
+package meridianlint
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"path"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+// MigrateAnalyzer rewrites time.Time struct fields and function signatures to
+// the Time type of a zone package, converting values where they cross into or
+// out of the migrated declarations. It is a codemod rather than a check: the
+// meridian-migrate command applies its fixes.
+var MigrateAnalyzer = &analysis.Analyzer{
+	Name:      "migrate",
+	Doc:       "migrate time.Time declarations to a meridian zone package\n\nStruct fields, parameters, and single results of type time.Time in the main\nmodule become the Time type of the zone package named by -zone. Values of type\ntime.Time stored into them are wrapped in the package's FromMoment, and reads\nthat still need a time.Time are converted back with UTC() (or Time() for zones\nother than utc). Functions used as values and methods that implement an\ninterface keep their signatures.",
+	Run:       runMigrate,
+	Requires:  []*analysis.Analyzer{inspect.Analyzer},
+	FactTypes: []analysis.Fact{new(migratedField), new(migratedFunc)},
+}
+
+// migrateZone is the zone package to migrate to, set by the -zone flag.
+var migrateZone = "utc"
+
+func init() {
+	MigrateAnalyzer.Flags.StringVar(&migrateZone, "zone", migrateZone,
+		"zone `package` to migrate to: a package under timezones, such as utc or et, or a full import path")
+}
+
+// migratedField is the fact that a struct field was migrated.
+type migratedField struct{}
+
+func (*migratedField) AFact()         {}
+func (*migratedField) String() string { return "migratedField" }
+
+// migratedFunc is the fact that a function's signature was migrated. Params
+// records which parameters were.
+type migratedFunc struct {
+	Params []bool
+	Result bool
+}
+
+func (*migratedFunc) AFact() {}
+
+func (f *migratedFunc) String() string {
+	return fmt.Sprintf("migratedFunc(%v, %v)", f.Params, f.Result)
+}
+
+// meridianMethods are the methods of meridian.Time, which typed values keep
+// after migration.
+var meridianMethods = map[string]bool{
+	"Add": true, "AddDate": true, "After": true, "AppendBinary": true,
+	"AppendFormat": true, "AppendText": true, "Before": true, "Clock": true,
+	"Compare": true, "Date": true, "Day": true, "Equal": true, "Format": true,
+	"GobDecode": true, "GobEncode": true, "GoString": true, "Hour": true,
+	"ISOWeek": true, "In": true, "IsDST": true, "IsZero": true, "Local": true,
+	"Location": true, "MarshalBinary": true, "MarshalJSON": true,
+	"MarshalText": true, "Minute": true, "Month": true, "Nanosecond": true,
+	"Round": true, "Second": true, "String": true, "Sub": true, "Time": true,
+	"Truncate": true, "UTC": true, "Unix": true, "UnixMicro": true,
+	"UnixMilli": true, "UnixNano": true, "UnmarshalBinary": true,
+	"UnmarshalJSON": true, "UnmarshalText": true, "Weekday": true,
+	"Year": true, "YearDay": true, "Zone": true, "ZoneBounds": true,
+}
+
+// typedMethods are the meridian.Time methods that return a meridian.Time
+// where the time.Time method returns a time.Time.
+var typedMethods = map[string]bool{
+	"Add":      true,
+	"AddDate":  true,
+	"Round":    true,
+	"Truncate": true,
+}
+
+// momentMethods are the meridian.Time methods whose time.Time parameter
+// becomes a meridian.Moment, which typed values satisfy.
+var momentMethods = map[string]bool{
+	"After":   true,
+	"Before":  true,
+	"Compare": true,
+	"Equal":   true,
+	"Sub":     true,
+}
+
+func runMigrate(pass *analysis.Pass) (interface{}, error) {
+	// Only the main module is rewritten; dependencies and the standard
+	// library keep their declarations.
+	if pass.Module == nil || pass.Module.Path == "" || pass.Module.Version != "" || isMeridianPath(pass.Pkg.Path()) {
+		return nil, nil
+	}
+
+	zonePath, zoneName := zonePackage(migrateZone)
+	m := &migration{
+		pass:     pass,
+		zonePath: zonePath,
+		zoneName: zoneName,
+		parents:  make(map[ast.Node]ast.Node),
+		fields:   make(map[*types.Var]bool),
+		params:   make(map[*types.Var]bool),
+		funcs:    make(map[*types.Func]*migratedFunc),
+		dests:    make(map[ast.Expr]bool),
+		files:    make(map[*ast.File]*fileMigration),
+	}
+
+	var stack []ast.Node
+	pass.ResultOf[inspect.Analyzer].(*inspector.Inspector).Nodes(nil, func(n ast.Node, push bool) bool {
+		if !push {
+			stack = stack[:len(stack)-1]
+			return true
+		}
+		if len(stack) > 0 {
+			m.parents[n] = stack[len(stack)-1]
+		}
+		stack = append(stack, n)
+		return true
+	})
+
+	m.declare()
+	for field := range m.fields {
+		pass.ExportObjectFact(field, new(migratedField))
+	}
+	for fn, fact := range m.funcs {
+		pass.ExportObjectFact(fn, fact)
+	}
+	m.convert()
+	m.report()
+
+	return nil, nil
+}
+
+// zonePackage returns the import path and package name of the zone package
+// named by the -zone flag.
+func zonePackage(zone string) (importPath, name string) {
+	if strings.Contains(zone, "/") {
+		return zone, path.Base(zone)
+	}
+	return modulePrefix + "/v2/timezones/" + zone, zone
+}
+
+// migration is the state of migrating one package.
+type migration struct {
+	pass     *analysis.Pass
+	zonePath string
+	zoneName string
+	parents  map[ast.Node]ast.Node
+
+	fields map[*types.Var]bool           // migrated struct fields
+	params map[*types.Var]bool           // migrated parameters and named results
+	funcs  map[*types.Func]*migratedFunc // migrated functions
+	dests  map[ast.Expr]bool             // expressions stored into migrated declarations
+	files  map[*ast.File]*fileMigration  // edits by file
+	ifaces []*types.Interface            // lazily loaded by implements
+}
+
+// fileMigration collects the edits to one file.
+type fileMigration struct {
+	file        *ast.File
+	imports     *importAdder
+	edits       []analysis.TextEdit
+	retyped     int // time.Time type expressions replaced
+	declared    int // migrated declarations
+	conversions int // conversions inserted
+}
+
+// declare migrates the time.Time struct fields and function signatures
+// declared by the package's files.
+func (m *migration) declare() {
+	values := m.funcValues()
+	for _, file := range m.pass.Files {
+		if isGenerated(m.pass, file) {
+			continue
+		}
+		ast.Inspect(file, func(n ast.Node) bool {
+			switch n := n.(type) {
+			case *ast.StructType:
+				for _, field := range n.Fields.List {
+					// Embedded fields would change the promoted methods.
+					if len(field.Names) == 0 || !m.isTimeExpr(field.Type) {
+						continue
+					}
+					for _, name := range field.Names {
+						m.fields[m.pass.TypesInfo.Defs[name].(*types.Var)] = true
+					}
+					m.retype(field.Type)
+					m.file(field).declared++
+				}
+			case *ast.FuncDecl:
+				if fn, ok := m.pass.TypesInfo.Defs[n.Name].(*types.Func); ok && n.Body != nil && !values[fn] && !m.implements(fn) {
+					m.declareFunc(fn, n.Type)
+				}
+			}
+			return true
+		})
+	}
+}
+
+// declareFunc migrates the time.Time parameters of fn and its result, if it
+// is its only one. Results alongside others, such as an error, are kept
+// because callers bind them to variables that would change type.
+func (m *migration) declareFunc(fn *types.Func, ftype *ast.FuncType) {
+	info := m.pass.TypesInfo
+	fact := &migratedFunc{Params: make([]bool, fn.Type().(*types.Signature).Params().Len())}
+	migrated := false
+
+	i := 0
+	for _, field := range ftype.Params.List {
+		n := max(len(field.Names), 1)
+		// Variadic parameters are spelled ...time.Time and so never match.
+		if m.isTimeExpr(field.Type) {
+			for j := i; j < i+n; j++ {
+				fact.Params[j] = true
+			}
+			for _, name := range field.Names {
+				if v, ok := info.Defs[name].(*types.Var); ok {
+					m.params[v] = true
+				}
+			}
+			m.retype(field.Type)
+			migrated = true
+		}
+		i += n
+	}
+
+	if results := ftype.Results; results != nil && len(results.List) == 1 && len(results.List[0].Names) <= 1 {
+		if field := results.List[0]; m.isTimeExpr(field.Type) {
+			for _, name := range field.Names {
+				if v, ok := info.Defs[name].(*types.Var); ok {
+					m.params[v] = true
+				}
+			}
+			m.retype(field.Type)
+			fact.Result = true
+			migrated = true
+		}
+	}
+
+	if migrated {
+		m.funcs[fn] = fact
+		m.file(ftype).declared++
+	}
+}
+
+// funcValues returns the package's functions that are used other than by
+// calling them, whose signatures must not change.
+func (m *migration) funcValues() map[*types.Func]bool {
+	values := make(map[*types.Func]bool)
+	for id, obj := range m.pass.TypesInfo.Uses {
+		fn, ok := obj.(*types.Func)
+		if !ok || fn.Pkg() != m.pass.Pkg {
+			continue
+		}
+		var use ast.Node = id
+		if sel, ok := m.parents[id].(*ast.SelectorExpr); ok && sel.Sel == id {
+			use = sel
+		}
+		if call, ok := m.context(use).(*ast.CallExpr); !ok || ast.Unparen(call.Fun) != use {
+			values[fn.Origin()] = true
+		}
+	}
+	return values
+}
+
+// implements reports whether fn is a method that implements a method of an
+// interface declared by the package or one of its imports.
+func (m *migration) implements(fn *types.Func) bool {
+	recv := fn.Type().(*types.Signature).Recv()
+	if recv == nil {
+		return false
+	}
+	if m.ifaces == nil {
+		for _, pkg := range append([]*types.Package{m.pass.Pkg}, m.pass.Pkg.Imports()...) {
+			for _, name := range pkg.Scope().Names() {
+				if obj, ok := pkg.Scope().Lookup(name).(*types.TypeName); ok && types.IsInterface(obj.Type()) {
+					m.ifaces = append(m.ifaces, obj.Type().Underlying().(*types.Interface))
+				}
+			}
+		}
+	}
+
+	typ := recv.Type()
+	if named, ok := types.Unalias(typ).(*types.Named); ok && named.TypeParams().Len() > 0 {
+		return true
+	}
+	if _, ok := types.Unalias(typ).(*types.Pointer); !ok {
+		typ = types.NewPointer(typ)
+	}
+	for _, iface := range m.ifaces {
+		for i := 0; i < iface.NumMethods(); i++ {
+			if iface.Method(i).Name() == fn.Name() && types.Implements(typ, iface) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// convert inserts the conversions needed at the boundaries of the migrated
+// declarations.
+func (m *migration) convert() {
+	info := m.pass.TypesInfo
+	for _, file := range m.pass.Files {
+		if isGenerated(m.pass, file) {
+			continue
+		}
+		// Find the values stored into migrated declarations first, so
+		// that reading one migrated declaration into another needs no
+		// conversion.
+		ast.Inspect(file, func(n ast.Node) bool {
+			switch n := n.(type) {
+			case *ast.CallExpr:
+				fn, ok := typeutil.Callee(info, n).(*types.Func)
+				if !ok {
+					break
+				}
+				fact := m.migratedFunc(fn)
+				if fact == nil || (len(n.Args) == 1 && len(fact.Params) > 1) {
+					break
+				}
+				for i, arg := range n.Args {
+					if i < len(fact.Params) && fact.Params[i] {
+						m.dest(arg)
+					}
+				}
+			case *ast.ReturnStmt:
+				if fact := m.migratedFunc(m.enclosingFunc(n)); fact != nil && fact.Result && len(n.Results) == 1 {
+					m.dest(n.Results[0])
+				}
+			case *ast.AssignStmt:
+				if n.Tok != token.ASSIGN || len(n.Lhs) != len(n.Rhs) {
+					break
+				}
+				for i, lhs := range n.Lhs {
+					if m.typed(lhs) {
+						m.dest(n.Rhs[i])
+					}
+				}
+			case *ast.CompositeLit:
+				st, ok := types.Unalias(info.TypeOf(n)).Underlying().(*types.Struct)
+				if !ok {
+					break
+				}
+				for i, elt := range n.Elts {
+					if kv, ok := elt.(*ast.KeyValueExpr); ok {
+						if field, ok := info.Uses[kv.Key.(*ast.Ident)].(*types.Var); ok && m.isMigratedField(field) {
+							m.dest(kv.Value)
+						}
+					} else if i < st.NumFields() && m.isMigratedField(st.Field(i)) {
+						m.dest(elt)
+					}
+				}
+			}
+			return true
+		})
+
+		// Then convert the migrated values read where a time.Time is
+		// still needed.
+		ast.Inspect(file, func(n ast.Node) bool {
+			e, ok := n.(ast.Expr)
+			if !ok || !m.needsConversion(e) {
+				return true
+			}
+			method := "Time"
+			if m.zoneName == "utc" {
+				method = "UTC"
+			}
+			fm := m.file(e)
+			fm.edits = append(fm.edits, analysis.TextEdit{Pos: e.End(), End: e.End(), NewText: []byte("." + method + "()")})
+			fm.conversions++
+			return true
+		})
+	}
+}
+
+// dest records that e is stored into a migrated declaration, wrapping it in
+// FromMoment unless it is already typed.
+func (m *migration) dest(e ast.Expr) {
+	e = ast.Unparen(e)
+	m.dests[e] = true
+	if m.typed(e) {
+		return
+	}
+
+	fm := m.file(e)
+	if lit, ok := e.(*ast.CompositeLit); ok && len(lit.Elts) == 0 && m.isTimeExpr(lit.Type) {
+		// time.Time{} becomes utc.Time{}.
+		m.retype(lit.Type)
+	} else {
+		zone := fm.imports.name(m.zonePath, m.zoneName)
+		fm.edits = append(fm.edits,
+			analysis.TextEdit{Pos: e.Pos(), End: e.Pos(), NewText: []byte(zone + ".FromMoment(")},
+			analysis.TextEdit{Pos: e.End(), End: e.End(), NewText: []byte(")")})
+	}
+	fm.conversions++
+}
+
+// typed reports whether e has the zone's Time type after migration.
+func (m *migration) typed(e ast.Expr) bool {
+	info := m.pass.TypesInfo
+	switch e := ast.Unparen(e).(type) {
+	case *ast.Ident:
+		v, ok := info.Uses[e].(*types.Var)
+		return ok && m.params[v]
+	case *ast.SelectorExpr:
+		sel := info.Selections[e]
+		return sel != nil && sel.Kind() == types.FieldVal && m.isMigratedField(sel.Obj().(*types.Var))
+	case *ast.CallExpr:
+		fn, ok := typeutil.Callee(info, e).(*types.Func)
+		if !ok {
+			return false
+		}
+		if fact := m.migratedFunc(fn); fact != nil {
+			return fact.Result
+		}
+		// t.Add(d) and friends keep the type of t.
+		sel, ok := e.Fun.(*ast.SelectorExpr)
+		return ok && typedMethods[fn.Name()] && isTimeTime(info.TypeOf(sel.X)) && m.typed(sel.X)
+	}
+	return false
+}
+
+// needsConversion reports whether e is typed after migration but used where a
+// time.Time is still needed.
+func (m *migration) needsConversion(e ast.Expr) bool {
+	if _, ok := e.(*ast.ParenExpr); ok || m.dests[e] || !m.typed(e) {
+		return false
+	}
+	child := ast.Node(e)
+	for {
+		if paren, ok := m.parents[child].(*ast.ParenExpr); ok {
+			child = paren
+			continue
+		}
+		break
+	}
+
+	switch parent := m.parents[child].(type) {
+	case *ast.SelectorExpr:
+		return !meridianMethods[parent.Sel.Name]
+	case *ast.CallExpr:
+		// The time.Time parameters of Before, Sub, and friends accept
+		// any meridian.Moment once their receiver is typed.
+		sel, ok := parent.Fun.(*ast.SelectorExpr)
+		return child == parent.Fun || !ok || !momentMethods[sel.Sel.Name] || !m.typed(sel.X)
+	case *ast.AssignStmt:
+		for _, lhs := range parent.Lhs {
+			if lhs == child {
+				return false
+			}
+		}
+	case *ast.UnaryExpr:
+		// &t cannot be converted; its users must be updated by hand.
+		return parent.Op != token.AND
+	}
+	return true
+}
+
+// isMigratedField reports whether field was migrated, here or in an
+// imported package.
+func (m *migration) isMigratedField(field *types.Var) bool {
+	field = field.Origin()
+	if field.Pkg() == m.pass.Pkg {
+		return m.fields[field]
+	}
+	return m.pass.ImportObjectFact(field, new(migratedField))
+}
+
+// migratedFunc returns the migration of fn, here or in an imported package,
+// or nil if fn was not migrated.
+func (m *migration) migratedFunc(fn *types.Func) *migratedFunc {
+	if fn == nil {
+		return nil
+	}
+	fn = fn.Origin()
+	if fn.Pkg() == m.pass.Pkg {
+		return m.funcs[fn]
+	}
+	fact := new(migratedFunc)
+	if !m.pass.ImportObjectFact(fn, fact) {
+		return nil
+	}
+	return fact
+}
+
+// enclosingFunc returns the function declared by the FuncDecl enclosing n, or
+// nil if n is in a function literal or outside any function.
+func (m *migration) enclosingFunc(n ast.Node) *types.Func {
+	for n != nil {
+		switch decl := n.(type) {
+		case *ast.FuncLit:
+			return nil
+		case *ast.FuncDecl:
+			fn, _ := m.pass.TypesInfo.Defs[decl.Name].(*types.Func)
+			return fn
+		}
+		n = m.parents[n]
+	}
+	return nil
+}
+
+// context returns the parent of n, skipping parentheses.
+func (m *migration) context(n ast.Node) ast.Node {
+	parent := m.parents[n]
+	for {
+		paren, ok := parent.(*ast.ParenExpr)
+		if !ok {
+			return parent
+		}
+		parent = m.parents[paren]
+	}
+}
+
+// isTimeExpr reports whether e is the type expression time.Time.
+func (m *migration) isTimeExpr(e ast.Expr) bool {
+	sel, ok := e.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+	id, ok := sel.X.(*ast.Ident)
+	if !ok {
+		return false
+	}
+	pkg, ok := m.pass.TypesInfo.Uses[id].(*types.PkgName)
+	return ok && pkg.Imported().Path() == "time" && sel.Sel.Name == "Time"
+}
+
+// retype replaces the type expression time.Time with the zone's Time.
+func (m *migration) retype(e ast.Expr) {
+	fm := m.file(e)
+	zone := fm.imports.name(m.zonePath, m.zoneName)
+	fm.edits = append(fm.edits, analysis.TextEdit{Pos: e.Pos(), End: e.End(), NewText: []byte(zone + ".Time")})
+	fm.retyped++
+}
+
+// file returns the migration of the file containing n.
+func (m *migration) file(n ast.Node) *fileMigration {
+	for _, file := range m.pass.Files {
+		if file.FileStart <= n.Pos() && n.Pos() < file.FileEnd {
+			fm, ok := m.files[file]
+			if !ok {
+				fm = &fileMigration{file: file, imports: newImportAdder(file)}
+				m.files[file] = fm
+			}
+			return fm
+		}
+	}
+	panic(fmt.Sprintf("no file contains %s", m.pass.Fset.Position(n.Pos())))
+}
+
+// report reports one diagnostic per changed file, whose fix applies all of
+// the file's edits.
+func (m *migration) report() {
+	for _, file := range m.pass.Files {
+		fm, ok := m.files[file]
+		if !ok || len(fm.edits) == 0 {
+			continue
+		}
+		sort.SliceStable(fm.edits, func(i, j int) bool { return fm.edits[i].Pos < fm.edits[j].Pos })
+		pos := fm.edits[0].Pos
+
+		edits := append(fm.edits, timeImportEdits(m.pass, fm.file, fm.imports, m.zonePath, fm.retyped)...)
+		edits = append(edits, fm.imports.edits()...)
+		m.pass.Report(analysis.Diagnostic{
+			Pos:     pos,
+			Message: fmt.Sprintf("migrate to %s.Time: %d declarations, %d conversions", m.zoneName, fm.declared, fm.conversions),
+			SuggestedFixes: []analysis.SuggestedFix{{
+				Message:   "Migrate to " + m.zoneName + ".Time",
+				TextEdits: edits,
+			}},
+		})
+	}
+}
+
+// timeImportEdits returns the edits removing file's import of the time
+// package if replacing removed of its uses leaves it unused. A lone import
+// declaration is rewritten to import zonePath instead, if imports still needs
+// to add it.
+func timeImportEdits(pass *analysis.Pass, file *ast.File, imports *importAdder, zonePath string, removed int) []analysis.TextEdit {
+	uses := 0
+	var timeName *types.PkgName
+	for id, obj := range pass.TypesInfo.Uses {
+		if pkg, ok := obj.(*types.PkgName); ok && pkg.Imported().Path() == "time" && file.FileStart <= id.Pos() && id.Pos() < file.FileEnd {
+			uses++
+			timeName = pkg
+		}
+	}
+	if timeName == nil || uses > removed {
+		return nil
+	}
+
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.IMPORT {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			spec := spec.(*ast.ImportSpec)
+			if pass.TypesInfo.PkgNameOf(spec) != timeName {
+				continue
+			}
+			if gen.Lparen.IsValid() {
+				tok := pass.Fset.File(spec.Pos())
+				line := tok.Line(spec.Pos())
+				end := file.FileEnd
+				if line < tok.LineCount() {
+					end = tok.LineStart(line + 1)
+				}
+				return []analysis.TextEdit{{Pos: tok.LineStart(line), End: end}}
+			}
+			if spec.Name == nil && slices.Contains(imports.missing, zonePath) {
+				imports.missing = slices.DeleteFunc(imports.missing, func(path string) bool { return path == zonePath })
+				return []analysis.TextEdit{{Pos: spec.Path.Pos(), End: spec.Path.End(), NewText: []byte(strconv.Quote(zonePath))}}
+			}
+			return []analysis.TextEdit{{Pos: gen.Pos(), End: gen.End()}}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package app
+
+import (
+	"fmt"
+	"time"
+
+	"example.com/app/store"
+)
+
+type Reminder struct {
+	Event   store.Event
+	Created time.Time // want `migrate to utc.Time: 3 declarations, 9 conversions` Created:"migratedField"
+	Notify  func(time.Time)
+}
+
+func NewReminder(name string) Reminder {
+	return Reminder{
+		Event:   store.Schedule(name, time.Now()),
+		Created: time.Now(),
+	}
+}
+
+func (r Reminder) Print() {
+	fmt.Println(r.Event.At, r.Created.Format(time.Kitchen))
+}
+
+func late(r Reminder, now time.Time) bool { // want late:`migratedFunc\(\[false true\], false\)`
+	return now.After(r.Event.Deadline())
+}
+
+func check(r Reminder, m Model) {
+	var at time.Time = r.Created
+	at = time.Now()
+	if late(r, at) {
+		r.Created = at
+	}
+	m.Updated = time.Time{}
+	r.Notify(m.Updated)
+}
+
+var _ Clock = fixedClock{}
+
+type Clock interface {
+	Now() time.Time
+}
+
+type fixedClock struct {
+	at time.Time // want at:"migratedField"
+}
+
+func (c fixedClock) Now() time.Time {
+	return c.at
+}
+
+func parse(value string) (time.Time, error) {
+	return time.Parse(time.RFC3339, value)
+}
+
+func expired(now time.Time) bool { return false }
+
+var isExpired = expired
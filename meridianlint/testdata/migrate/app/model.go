@@ -0,0 +1,8 @@
+package app
+
+import "time"
+
+type Model struct {
+	ID      int
+	Updated time.Time // want `migrate to utc.Time: 1 declarations, 0 conversions` Updated:"migratedField"
+}
@@ -0,0 +1,7 @@
+module example.com/app
+
+go 1.22
+
+require github.com/matthalp/go-meridian/v2 v2.0.0
+
+replace github.com/matthalp/go-meridian/v2 => ../src/github.com/matthalp/go-meridian/v2
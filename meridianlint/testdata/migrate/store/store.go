@@ -0,0 +1,20 @@
+package store
+
+import "time"
+
+type Event struct {
+	Name string
+	At   time.Time // want `migrate to utc.Time: 3 declarations, 1 conversions` At:"migratedField"
+}
+
+func Schedule(name string, at time.Time) Event { // want Schedule:`migratedFunc\(\[false true\], false\)`
+	return Event{Name: name, At: at}
+}
+
+func (e Event) Deadline() time.Time { // want Deadline:`migratedFunc\(\[\], true\)`
+	return e.At.Add(time.Hour)
+}
+
+func Since(e Event) time.Duration {
+	return time.Since(e.At)
+}
@@ -0,0 +1,25 @@
+package a
+
+import (
+	"time"
+
+	"github.com/matthalp/go-meridian/v2/timezones/utc"
+)
+
+func now() utc.Time {
+	return utc.Now()
+}
+
+func raw() {
+	_ = time.Now()                                  // want `time.Now returns an untyped time.Time; use a zone package instead, such as utc.Now\(\)`
+	_ = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC) // want `time.Date returns an untyped time.Time`
+	_, _ = time.Parse(time.RFC3339, "")             // want `time.Parse returns an untyped time.Time`
+
+	clock := time.Now // want `time.Now returns an untyped time.Time`
+	_ = clock
+
+	_ = time.Date(2024, 1, 1, 0, 0, 0, 0, time.Local) // want `time.Date returns an untyped time.Time`
+
+	_ = time.Unix(0, 0)
+	_ = time.Since(utc.Now().UTC())
+}
@@ -0,0 +1,8 @@
+// Package b does not use meridian, so it is not checked.
+package b
+
+import "time"
+
+func now() time.Time {
+	return time.Now()
+}
@@ -0,0 +1,16 @@
+package erasure
+
+import (
+	"time"
+
+	"github.com/matthalp/go-meridian/v2/timezones/utc"
+)
+
+func record(when time.Time) time.Duration {
+	return time.Since(when)
+}
+
+func run() {
+	now := utc.Now()
+	record(now.UTC()) // want `UTC\(\) erases the timezone of a meridian.Time\[utc.Timezone\] passed to record`
+}
@@ -0,0 +1,22 @@
+package erasure
+
+import (
+	"time"
+
+	"github.com/matthalp/go-meridian/v2/timezones/utc"
+)
+
+func between(start, end time.Time) bool {
+	return start.Before(end)
+}
+
+func log(format string, args ...time.Time) {}
+
+func others() {
+	now := utc.Now()
+
+	_ = time.Since(now.UTC())            // other packages are not reported
+	log("%v", now.UTC())                 // variadic parameters are not reported
+	record(time.Now())                   // not a typed time
+	between(now.UTC(), now.In(time.UTC)) // want `UTC\(\) erases` `In\(\) erases`
+}
@@ -0,0 +1,3 @@
+module github.com/matthalp/go-meridian/v2
+
+go 1.22
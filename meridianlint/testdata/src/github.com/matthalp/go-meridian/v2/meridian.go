@@ -0,0 +1,21 @@
+package meridian
+
+import "time"
+
+type Timezone interface {
+	Location() *time.Location
+}
+
+type Moment interface {
+	UTC() time.Time
+}
+
+type Time[TZ Timezone] struct {
+	utcTime time.Time
+}
+
+func (t Time[TZ]) UTC() time.Time { return t.utcTime }
+
+func (t Time[TZ]) Time() time.Time { return t.utcTime }
+
+func (t Time[TZ]) In(loc *time.Location) time.Time { return t.utcTime.In(loc) }
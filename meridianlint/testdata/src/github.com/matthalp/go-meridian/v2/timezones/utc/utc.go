@@ -0,0 +1,17 @@
+package utc
+
+import (
+	"time"
+
+	"github.com/matthalp/go-meridian/v2"
+)
+
+type Timezone struct{}
+
+func (Timezone) Location() *time.Location { return time.UTC }
+
+type Time = meridian.Time[Timezone]
+
+func Now() Time { return Time{} }
+
+func FromMoment(m meridian.Moment) Time { return Time{} }
@@ -0,0 +1,9 @@
+package rawfix
+
+import (
+	"time"
+
+	"github.com/matthalp/go-meridian/v2"
+)
+
+var start meridian.Moment = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC) // want `time.Date returns an untyped time.Time`
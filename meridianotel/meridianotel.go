@@ -0,0 +1,67 @@
+/*
+Package meridianotel provides OpenTelemetry attribute and span helpers for
+meridian typed times, so tracing code records times the same way everywhere:
+as RFC 3339 strings in their own timezone, alongside the zone's name.
+
+	span.SetAttributes(meridianotel.Attributes("order.ships_at", order.ShipsAt)...)
+	// order.ships_at      = "2024-12-24T17:00:00-05:00"
+	// order.ships_at.zone = "America/New_York"
+
+	meridianotel.AddEvent(span, "payment.settled", payment.SettledAt)
+*/
+package meridianotel
+
+import (
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/matthalp/go-meridian/v2"
+)
+
+// ZoneSuffix is appended to an attribute key to name the attribute holding
+// the timezone, as by Attributes.
+const ZoneSuffix = ".zone"
+
+// String returns an attribute holding m as an RFC 3339 string with
+// nanosecond precision, in the location of m. The parameter m can be any
+// Moment (time.Time or Time[TZ]).
+func String(key string, m meridian.Moment) attribute.KeyValue {
+	return attribute.String(key, meridian.FormatMoment(m, time.RFC3339Nano))
+}
+
+// Zone returns an attribute holding the name of the location of m, such as
+// "America/New_York".
+func Zone(key string, m meridian.Moment) attribute.KeyValue {
+	return attribute.String(key, meridian.LocationOf(m).String())
+}
+
+// Attributes returns the String attribute for m under key and the Zone
+// attribute under key+ZoneSuffix. The offset in the string alone cannot
+// distinguish zones that share it, such as New York and Toronto.
+func Attributes(key string, m meridian.Moment) []attribute.KeyValue {
+	return []attribute.KeyValue{String(key, m), Zone(key+ZoneSuffix, m)}
+}
+
+// UnixNano returns an attribute holding m as nanoseconds since the Unix
+// epoch, for backends that aggregate or sort numeric attributes.
+func UnixNano(key string, m meridian.Moment) attribute.KeyValue {
+	return attribute.Int64(key, m.UTC().UnixNano())
+}
+
+// WithTimestamp returns an option setting the timestamp of a span event, or
+// of the start or end of a span, to m:
+//
+//	ctx, span := tracer.Start(ctx, "replay", meridianotel.WithTimestamp(job.StartedAt))
+//	defer span.End(meridianotel.WithTimestamp(job.FinishedAt))
+func WithTimestamp(m meridian.Moment) trace.SpanEventOption {
+	return trace.WithTimestamp(m.UTC())
+}
+
+// AddEvent adds an event with the given name to span, timestamped at m
+// rather than when AddEvent is called. Use it to record something that
+// happened earlier, such as a settlement time reported by a payment provider.
+func AddEvent(span trace.Span, name string, m meridian.Moment, attrs ...attribute.KeyValue) {
+	span.AddEvent(name, WithTimestamp(m), trace.WithAttributes(attrs...))
+}
@@ -0,0 +1,66 @@
+package meridianotel
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/matthalp/go-meridian/v2/timezones/et"
+)
+
+func TestAttributes(t *testing.T) {
+	shipsAt := et.Date(2024, time.December, 24, 17, 0, 0, 5)
+	got := Attributes("order.ships_at", shipsAt)
+	want := []attribute.KeyValue{
+		attribute.String("order.ships_at", "2024-12-24T17:00:00.000000005-05:00"),
+		attribute.String("order.ships_at.zone", "America/New_York"),
+	}
+	if len(got) != len(want) {
+		t.Fatalf("Attributes() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Attributes()[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+
+	if got := Zone("at", time.Date(2024, time.July, 1, 0, 0, 0, 0, time.UTC)); got.Value.AsString() != "UTC" {
+		t.Errorf("Zone(time.Time) = %v, want UTC", got)
+	}
+	if got := UnixNano("at", shipsAt); got.Value.AsInt64() != shipsAt.UnixNano() {
+		t.Errorf("UnixNano() = %v, want %d", got, shipsAt.UnixNano())
+	}
+}
+
+func TestSpanTimestamps(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tracer := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer("test")
+
+	start := et.Date(2024, time.March, 10, 9, 0, 0, 0)
+	settled := start.Add(90 * time.Second)
+	end := start.Add(5 * time.Minute)
+
+	_, span := tracer.Start(context.Background(), "replay", WithTimestamp(start))
+	AddEvent(span, "payment.settled", settled, attribute.String("provider", "acme"))
+	span.End(WithTimestamp(end))
+
+	spans := recorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("recorded %d spans, want 1", len(spans))
+	}
+	s := spans[0]
+	if !s.StartTime().Equal(start.UTC()) || !s.EndTime().Equal(end.UTC()) {
+		t.Errorf("span = %v to %v, want %v to %v", s.StartTime(), s.EndTime(), start, end)
+	}
+	events := s.Events()
+	if len(events) != 1 || events[0].Name != "payment.settled" || !events[0].Time.Equal(settled.UTC()) {
+		t.Fatalf("events = %+v, want payment.settled at %v", events, settled)
+	}
+	if attrs := events[0].Attributes; len(attrs) != 1 || attrs[0] != attribute.String("provider", "acme") {
+		t.Errorf("event attributes = %v", attrs)
+	}
+}
@@ -0,0 +1,42 @@
+/*
+Package meridiantest provides helpers for testing code that uses meridian.
+
+OverrideLocation swaps the location of a timezone type for the duration of a
+test, so a test can simulate a future tzdata change, such as a zone shifting
+its offset or abolishing daylight saving time, and check how the
+application behaves under the new rules:
+
+	func TestInvoiceDueDateWithoutDST(t *testing.T) {
+		restore := meridiantest.OverrideLocation[et.Timezone](time.FixedZone("EST", -5*60*60))
+		defer restore()
+
+		// et.Time values now read and format their fields at a constant
+		// UTC-05:00, as if New York had abolished daylight saving time.
+	}
+*/
+package meridiantest
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/matthalp/go-meridian/v2"
+	"github.com/matthalp/go-meridian/v2/internal/tzoverride"
+)
+
+// OverrideLocation makes loc the location of the timezone type TZ until the
+// returned restore function is called. It affects every Time[TZ] method and
+// meridian function that reads the time in TZ, such as Format, Hour, Date,
+// and Parse; it does not change what TZ's own Location method returns.
+// Calling restore more than once has no further effect.
+//
+// Overrides apply to the whole process, so tests that use them must not run
+// in parallel with tests that use TZ. Overrides of the same type nest:
+// restore reinstates the location that was in effect when OverrideLocation
+// was called. It panics if loc is nil.
+func OverrideLocation[TZ meridian.Timezone](loc *time.Location) (restore func()) {
+	if loc == nil {
+		panic("meridiantest: OverrideLocation with nil location")
+	}
+	return tzoverride.Set(reflect.TypeOf((*TZ)(nil)).Elem(), loc)
+}
@@ -0,0 +1,53 @@
+package meridiantest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matthalp/go-meridian/v2"
+	"github.com/matthalp/go-meridian/v2/timezones/et"
+	"github.com/matthalp/go-meridian/v2/timezones/pt"
+)
+
+func TestOverrideLocation(t *testing.T) {
+	instant := time.Date(2024, time.July, 4, 12, 0, 0, 0, time.UTC)
+	noDST := time.FixedZone("EST", -5*60*60)
+
+	restore := OverrideLocation[et.Timezone](noDST)
+	if got := et.FromMoment(instant).Hour(); got != 7 {
+		t.Errorf("Hour() with override = %d, want 7", got)
+	}
+	if got := et.FromMoment(instant).Format("MST"); got != "EST" {
+		t.Errorf("Format(MST) with override = %q, want EST", got)
+	}
+	if got := meridian.OffsetAt[et.Timezone](instant); got != -5*60*60 {
+		t.Errorf("OffsetAt() with override = %d, want %d", got, -5*60*60)
+	}
+	if got := pt.FromMoment(instant).Hour(); got != 5 {
+		t.Errorf("pt Hour() with et override = %d, want 5", got)
+	}
+
+	restore()
+	restore()
+	if got := et.FromMoment(instant).Hour(); got != 8 {
+		t.Errorf("Hour() after restore = %d, want 8", got)
+	}
+}
+
+func TestOverrideLocationNested(t *testing.T) {
+	instant := time.Date(2024, time.January, 15, 12, 0, 0, 0, time.UTC)
+
+	outer := OverrideLocation[et.Timezone](time.FixedZone("A", 1*60*60))
+	inner := OverrideLocation[et.Timezone](time.FixedZone("B", 2*60*60))
+	if got := et.FromMoment(instant).Hour(); got != 14 {
+		t.Errorf("Hour() with inner override = %d, want 14", got)
+	}
+	inner()
+	if got := et.FromMoment(instant).Hour(); got != 13 {
+		t.Errorf("Hour() after inner restore = %d, want 13", got)
+	}
+	outer()
+	if got := et.FromMoment(instant).Hour(); got != 7 {
+		t.Errorf("Hour() after outer restore = %d, want 7", got)
+	}
+}
@@ -0,0 +1,266 @@
+/*
+Package naturallang parses everyday English time phrases, such as
+"tomorrow 3pm", "next Tuesday", or "in 2 hours", relative to a reference
+meridian typed time.
+
+This package is experimental: the phrases it accepts may grow, and the
+interpretation of ambiguous phrases may change between minor versions.
+
+Because the reference time carries its timezone in its type, phrases that
+name a day or a time of day are unambiguous: they are read on the wall clock
+of that timezone.
+
+	now := et.Now()
+	t, err := naturallang.Parse("tomorrow 3pm", now)
+	// 3 p.m. Eastern Time on the day after now
+
+Parse accepts, case-insensitively:
+
+	now
+	in 2 hours              in an hour and 30 minutes
+	3 days ago              a week ago
+	today  tomorrow  yesterday
+	tuesday  next tuesday   last friday
+	next week  last month   next year
+	3pm  3:30 pm  15:45  noon  midnight
+
+A day phrase may be followed by a time of day, optionally introduced by "at",
+as in "next Tuesday at 9:30am". A day phrase alone keeps the reference time's
+wall-clock time; a time of day alone is on the reference time's day, even if
+that time has already passed. A bare weekday, like "next" followed by one,
+means the first such day after the reference day.
+
+Amounts of seconds, minutes, and hours are elapsed time; days, weeks, months,
+and years are calendar amounts that keep the wall-clock time across daylight
+saving time transitions, as meridian.Time.AddCalendar does.
+*/
+package naturallang
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/matthalp/go-meridian/v2"
+)
+
+// ParseError describes a phrase that Parse does not understand.
+type ParseError struct {
+	Value  string // the phrase being parsed
+	Reason string // why the phrase is not understood
+}
+
+// Error implements the error interface.
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("cannot parse time phrase %q: %s", e.Value, e.Reason)
+}
+
+// Parse parses a time phrase relative to ref and returns the time it
+// describes in ref's timezone.
+func Parse[TZ meridian.Timezone](s string, ref meridian.Time[TZ]) (meridian.Time[TZ], error) {
+	t, err := parse(strings.Fields(strings.ToLower(s)), ref)
+	if err != nil {
+		return meridian.Time[TZ]{}, &ParseError{Value: s, Reason: err.Error()}
+	}
+	return t, nil
+}
+
+func parse[TZ meridian.Timezone](words []string, ref meridian.Time[TZ]) (meridian.Time[TZ], error) {
+	switch {
+	case len(words) == 0:
+		return meridian.Time[TZ]{}, fmt.Errorf("empty phrase")
+	case len(words) == 1 && words[0] == "now":
+		return ref, nil
+	case words[0] == "in":
+		return relative(words[1:], ref, 1)
+	case words[len(words)-1] == "ago":
+		return relative(words[:len(words)-1], ref, -1)
+	}
+
+	day, rest, err := parseDay(words, ref)
+	if err != nil {
+		return meridian.Time[TZ]{}, err
+	}
+	if len(rest) > 0 && rest[0] == "at" {
+		if len(rest) == 1 {
+			return meridian.Time[TZ]{}, fmt.Errorf(`missing time of day after "at"`)
+		}
+		rest = rest[1:]
+	}
+	if len(rest) == 0 {
+		return day, nil
+	}
+	hour, minute, err := parseClock(strings.Join(rest, ""))
+	if err != nil {
+		return meridian.Time[TZ]{}, err
+	}
+	year, month, d := day.Date()
+	return meridian.Date[TZ](year, month, d, hour, minute, 0, 0), nil
+}
+
+// parseDay parses a leading day phrase, if any, returning the reference time
+// moved to that day and the words that follow it. Without a day phrase it
+// returns ref and all of the words.
+func parseDay[TZ meridian.Timezone](words []string, ref meridian.Time[TZ]) (meridian.Time[TZ], []string, error) {
+	if weekday, ok := weekdays[words[0]]; ok {
+		return ref.Next(weekday), words[1:], nil
+	}
+	switch words[0] {
+	case "today":
+		return ref, words[1:], nil
+	case "tomorrow":
+		return ref.AddCalendar(meridian.CalendarDuration{Days: 1}), words[1:], nil
+	case "yesterday":
+		return ref.AddCalendar(meridian.CalendarDuration{Days: -1}), words[1:], nil
+	case "next", "last":
+		if len(words) < 2 {
+			return ref, nil, fmt.Errorf("missing day or unit after %q", words[0])
+		}
+		sign := 1
+		if words[0] == "last" {
+			sign = -1
+		}
+		if weekday, ok := weekdays[words[1]]; ok {
+			if sign < 0 {
+				return ref.Previous(weekday), words[2:], nil
+			}
+			return ref.Next(weekday), words[2:], nil
+		}
+		d, ok := calendarUnit(words[1], sign)
+		if !ok {
+			return ref, nil, fmt.Errorf("unknown day or unit %q after %q", words[1], words[0])
+		}
+		return ref.AddCalendar(d), words[2:], nil
+	}
+	return ref, words, nil
+}
+
+// relative parses a sequence of amounts, such as "2 hours and 30 minutes",
+// and adds them to ref with the given sign.
+func relative[TZ meridian.Timezone](words []string, ref meridian.Time[TZ], sign int) (meridian.Time[TZ], error) {
+	if len(words) == 0 {
+		return meridian.Time[TZ]{}, fmt.Errorf("missing amount")
+	}
+	var d meridian.CalendarDuration
+	for len(words) > 0 {
+		if len(words) < 2 {
+			return meridian.Time[TZ]{}, fmt.Errorf("missing unit after %q", words[0])
+		}
+		n, err := parseAmount(words[0])
+		if err != nil {
+			return meridian.Time[TZ]{}, err
+		}
+		if !addAmount(&d, sign*n, words[1]) {
+			return meridian.Time[TZ]{}, fmt.Errorf("unknown unit %q", words[1])
+		}
+		words = words[2:]
+		if len(words) > 1 && words[0] == "and" {
+			words = words[1:]
+		}
+	}
+	return ref.AddCalendar(d), nil
+}
+
+// parseAmount parses a count of units: a non-negative integer, "a", or "an".
+func parseAmount(word string) (int, error) {
+	if word == "a" || word == "an" {
+		return 1, nil
+	}
+	n, err := strconv.Atoi(word)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("invalid amount %q", word)
+	}
+	return n, nil
+}
+
+// addAmount adds n of the named unit to d, reporting whether the unit is
+// known.
+func addAmount(d *meridian.CalendarDuration, n int, unit string) bool {
+	switch strings.TrimSuffix(unit, "s") {
+	case "second", "sec":
+		d.Duration += time.Duration(n) * time.Second
+	case "minute", "min":
+		d.Duration += time.Duration(n) * time.Minute
+	case "hour", "hr":
+		d.Duration += time.Duration(n) * time.Hour
+	case "day":
+		d.Days += n
+	case "week":
+		d.Days += 7 * n
+	case "month":
+		d.Months += n
+	case "year":
+		d.Years += n
+	default:
+		return false
+	}
+	return true
+}
+
+// calendarUnit returns one of the calendar unit named by unit, as in
+// "next week", with the given sign.
+func calendarUnit(unit string, sign int) (meridian.CalendarDuration, bool) {
+	switch unit {
+	case "day":
+		return meridian.CalendarDuration{Days: sign}, true
+	case "week":
+		return meridian.CalendarDuration{Days: 7 * sign}, true
+	case "month":
+		return meridian.CalendarDuration{Months: sign}, true
+	case "year":
+		return meridian.CalendarDuration{Years: sign}, true
+	}
+	return meridian.CalendarDuration{}, false
+}
+
+// parseClock parses a time of day written as "noon", "midnight", "3pm",
+// "3:30am", or "15:45", with any spaces already removed.
+func parseClock(s string) (hour, minute int, err error) {
+	switch s {
+	case "noon":
+		return 12, 0, nil
+	case "midnight":
+		return 0, 0, nil
+	}
+
+	meridiem := ""
+	if strings.HasSuffix(s, "am") || strings.HasSuffix(s, "pm") {
+		s, meridiem = s[:len(s)-2], s[len(s)-2:]
+	}
+	hourText, minuteText, hasMinute := strings.Cut(s, ":")
+	if !hasMinute && meridiem == "" {
+		return 0, 0, fmt.Errorf("invalid time of day %q: use am/pm or hh:mm", s)
+	}
+	hour, err = strconv.Atoi(hourText)
+	if err != nil || len(hourText) > 2 {
+		return 0, 0, fmt.Errorf("invalid hour %q", hourText)
+	}
+	if hasMinute {
+		minute, err = strconv.Atoi(minuteText)
+		if err != nil || len(minuteText) != 2 || minute > 59 {
+			return 0, 0, fmt.Errorf("invalid minute %q", minuteText)
+		}
+	}
+
+	switch {
+	case meridiem == "" && hour <= 23:
+		return hour, minute, nil
+	case meridiem == "" || hour < 1 || hour > 12:
+		return 0, 0, fmt.Errorf("invalid hour %q", hourText)
+	case meridiem == "am":
+		return hour % 12, minute, nil
+	default:
+		return hour%12 + 12, minute, nil
+	}
+}
+
+var weekdays = map[string]time.Weekday{
+	"sunday":    time.Sunday,
+	"monday":    time.Monday,
+	"tuesday":   time.Tuesday,
+	"wednesday": time.Wednesday,
+	"thursday":  time.Thursday,
+	"friday":    time.Friday,
+	"saturday":  time.Saturday,
+}
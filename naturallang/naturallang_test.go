@@ -0,0 +1,117 @@
+package naturallang
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/matthalp/go-meridian/v2/timezones/et"
+)
+
+// ref is Wednesday, March 6, 2024, 10:15 a.m. Eastern Time, four days before
+// the start of daylight saving time.
+var ref = et.Date(2024, time.March, 6, 10, 15, 0, 0)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		phrase string
+		want   et.Time
+	}{
+		{"now", ref},
+		{"in 2 hours", et.Date(2024, time.March, 6, 12, 15, 0, 0)},
+		{"in an hour and 30 minutes", et.Date(2024, time.March, 6, 11, 45, 0, 0)},
+		{"in 90 secs", et.Date(2024, time.March, 6, 10, 16, 30, 0)},
+		{"in 1 week", et.Date(2024, time.March, 13, 10, 15, 0, 0)},
+		{"in 2 months", et.Date(2024, time.May, 6, 10, 15, 0, 0)},
+		{"3 days ago", et.Date(2024, time.March, 3, 10, 15, 0, 0)},
+		{"a year ago", et.Date(2023, time.March, 6, 10, 15, 0, 0)},
+		{"today", ref},
+		{"tomorrow", et.Date(2024, time.March, 7, 10, 15, 0, 0)},
+		{"yesterday", et.Date(2024, time.March, 5, 10, 15, 0, 0)},
+		{"tomorrow 3pm", et.Date(2024, time.March, 7, 15, 0, 0, 0)},
+		{"Tomorrow at 3:30 PM", et.Date(2024, time.March, 7, 15, 30, 0, 0)},
+		{"tuesday", et.Date(2024, time.March, 12, 10, 15, 0, 0)},
+		{"next Tuesday", et.Date(2024, time.March, 12, 10, 15, 0, 0)},
+		{"next wednesday", et.Date(2024, time.March, 13, 10, 15, 0, 0)},
+		{"last friday at noon", et.Date(2024, time.March, 1, 12, 0, 0, 0)},
+		{"next week", et.Date(2024, time.March, 13, 10, 15, 0, 0)},
+		{"last month", et.Date(2024, time.February, 6, 10, 15, 0, 0)},
+		{"next year", et.Date(2025, time.March, 6, 10, 15, 0, 0)},
+		{"3pm", et.Date(2024, time.March, 6, 15, 0, 0, 0)},
+		{"9 am", et.Date(2024, time.March, 6, 9, 0, 0, 0)},
+		{"12am", et.Date(2024, time.March, 6, 0, 0, 0, 0)},
+		{"12pm", et.Date(2024, time.March, 6, 12, 0, 0, 0)},
+		{"15:45", et.Date(2024, time.March, 6, 15, 45, 0, 0)},
+		{"at midnight", et.Date(2024, time.March, 6, 0, 0, 0, 0)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.phrase, func(t *testing.T) {
+			got, err := Parse(tt.phrase, ref)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("Parse() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseAcrossDST(t *testing.T) {
+	// Daylight saving time starts on Sunday, March 10: days keep the wall
+	// clock, hours are elapsed time.
+	tests := []struct {
+		phrase string
+		want   et.Time
+	}{
+		{"next sunday", et.Date(2024, time.March, 10, 10, 15, 0, 0)},
+		{"in 5 days", et.Date(2024, time.March, 11, 10, 15, 0, 0)},
+		{"in 120 hours", et.Date(2024, time.March, 11, 11, 15, 0, 0)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.phrase, func(t *testing.T) {
+			got, err := Parse(tt.phrase, ref)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("Parse() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	phrases := []string{
+		"",
+		"in",
+		"in 2",
+		"in two hours",
+		"in 2 fortnights",
+		"ago",
+		"next",
+		"next decade",
+		"tomorrow at",
+		"13pm",
+		"0am",
+		"3:5pm",
+		"24:00",
+		"15",
+		"someday",
+	}
+
+	for _, phrase := range phrases {
+		t.Run(phrase, func(t *testing.T) {
+			_, err := Parse(phrase, ref)
+			var parseErr *ParseError
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("Parse() error = %v, want *ParseError", err)
+			}
+			if parseErr.Value != phrase {
+				t.Errorf("ParseError.Value = %q, want %q", parseErr.Value, phrase)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package meridian
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+)
+
+// Compile-time interface assertions.
+var (
+	_ driver.Valuer    = Null[Timezone]{}
+	_ sql.Scanner      = (*Null[Timezone])(nil)
+	_ json.Marshaler   = Null[Timezone]{}
+	_ json.Unmarshaler = (*Null[Timezone])(nil)
+)
+
+// Null represents a Time[TZ] that may be NULL. It mirrors sql.NullTime and
+// implements the driver.Valuer and sql.Scanner interfaces, so it can be used
+// as a sqlc override type for nullable timestamp columns. The same override
+// works with both database/sql and pgx/v5, because pgx falls back to
+// sql.Scanner and driver.Valuer for types it does not know.
+//
+// sqlc's go_type cannot name an instantiated generic type directly, so declare
+// aliases in one of your packages and reference those instead:
+//
+//	package dbtypes
+//
+//	type (
+//		Timestamptz     = utc.Time
+//		NullTimestamptz = meridian.Null[utc.Timezone]
+//		Timestamptzs    = meridian.Array[utc.Timezone]
+//	)
+//
+// with overrides such as:
+//
+//	overrides:
+//	  - db_type: "pg_catalog.timestamptz"
+//	    go_type: "example.com/app/dbtypes.Timestamptz"
+//	  - db_type: "pg_catalog.timestamptz"
+//	    nullable: true
+//	    go_type: "example.com/app/dbtypes.NullTimestamptz"
+//	  - column: "events.reminders"
+//	    go_type: "example.com/app/dbtypes.Timestamptzs"
+type Null[TZ Timezone] struct {
+	Time  Time[TZ]
+	Valid bool // Valid is true if Time is not NULL.
+}
+
+// Scan implements the sql.Scanner interface. NULL scans into an invalid Null;
+// any other value is scanned with Time.Scan.
+func (n *Null[TZ]) Scan(value interface{}) error {
+	if value == nil {
+		n.Time, n.Valid = Time[TZ]{}, false
+		return nil
+	}
+	if err := n.Time.Scan(value); err != nil {
+		n.Valid = false
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// Value implements the driver.Valuer interface. An invalid Null is stored as
+// NULL; otherwise the time is stored as UTC.
+func (n Null[TZ]) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Time.Value()
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// An invalid Null is encoded as null.
+func (n Null[TZ]) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return n.Time.MarshalJSON()
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// The JSON value null produces an invalid Null.
+func (n *Null[TZ]) UnmarshalJSON(data []byte) error {
+	if string(bytes.TrimSpace(data)) == "null" {
+		n.Time, n.Valid = Time[TZ]{}, false
+		return nil
+	}
+	if err := n.Time.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
@@ -0,0 +1,95 @@
+package meridian
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestNullScan(t *testing.T) {
+	source := time.Date(2024, time.June, 15, 14, 30, 45, 0, time.UTC)
+
+	var n Null[EST]
+	if err := n.Scan(source); err != nil {
+		t.Fatalf("Scan() error = %v", err)
+	}
+	if !n.Valid {
+		t.Error("Scan() Valid = false, want true")
+	}
+	if !n.Time.Equal(source) {
+		t.Errorf("Scan() Time = %v, want %v", n.Time.UTC(), source)
+	}
+
+	if err := n.Scan(nil); err != nil {
+		t.Fatalf("Scan(nil) error = %v", err)
+	}
+	if n.Valid {
+		t.Error("Scan(nil) Valid = true, want false")
+	}
+	if !n.Time.IsZero() {
+		t.Errorf("Scan(nil) Time = %v, want zero", n.Time)
+	}
+}
+
+func TestNullScanInvalid(t *testing.T) {
+	n := Null[UTC]{Time: Date[UTC](2024, time.June, 15, 0, 0, 0, 0), Valid: true}
+	if err := n.Scan(true); err == nil {
+		t.Error("Scan(bool) expected error, got nil")
+	}
+	if n.Valid {
+		t.Error("Scan() Valid = true after error, want false")
+	}
+}
+
+func TestNullValue(t *testing.T) {
+	valid := Null[EST]{Time: Date[EST](2024, time.January, 15, 7, 0, 0, 0), Valid: true}
+	value, err := valid.Value()
+	if err != nil {
+		t.Fatalf("Value() error = %v", err)
+	}
+	expected := time.Date(2024, time.January, 15, 12, 0, 0, 0, time.UTC)
+	if got, ok := value.(time.Time); !ok || !got.Equal(expected) || got.Location() != time.UTC {
+		t.Errorf("Value() = %v, want %v in UTC", value, expected)
+	}
+
+	value, err = Null[EST]{}.Value()
+	if err != nil {
+		t.Fatalf("Value() error = %v", err)
+	}
+	if value != nil {
+		t.Errorf("Value() for invalid Null = %v, want nil", value)
+	}
+}
+
+func TestNullJSON(t *testing.T) {
+	type Event struct {
+		Start Null[UTC] `json:"start"`
+		End   Null[UTC] `json:"end"`
+	}
+
+	event := Event{Start: Null[UTC]{Time: Date[UTC](2024, time.June, 15, 14, 30, 0, 0), Valid: true}}
+	data, err := json.Marshal(event)
+	if err != nil {
+		t.Fatalf("Marshal error = %v", err)
+	}
+
+	expected := `{"start":"2024-06-15T14:30:00Z","end":null}`
+	if string(data) != expected {
+		t.Errorf("Marshal() = %s, want %s", data, expected)
+	}
+
+	decoded := Event{End: Null[UTC]{Time: Date[UTC](2024, time.June, 16, 0, 0, 0, 0), Valid: true}}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Unmarshal error = %v", err)
+	}
+	if !decoded.Start.Valid || !decoded.Start.Time.Equal(event.Start.Time) {
+		t.Errorf("Start = %+v, want %+v", decoded.Start, event.Start)
+	}
+	if decoded.End.Valid || !decoded.End.Time.IsZero() {
+		t.Errorf("End = %+v, want invalid", decoded.End)
+	}
+
+	if err := json.Unmarshal([]byte(`{"start":"tomorrow"}`), &decoded); err == nil {
+		t.Error("Unmarshal() expected error for invalid time, got nil")
+	}
+}
@@ -0,0 +1,120 @@
+package meridian
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// PartitionPath formats t, in its timezone, as a Hive-style partition path
+// such as the key prefixes of data lakes on S3:
+//
+//	t.PartitionPath("dt=2006-01-02/hour=15") // "dt=2024-03-10/hour=09"
+//
+// The layout is a slash-separated list of segments. In a key=value segment,
+// the key is copied literally and the value is a layout as for Format, so
+// keys never need quoting against layout tokens. A segment without "=" is a
+// layout as a whole, as in "2006/01/02". Formatted values are escaped the
+// way Hive escapes partition values, so a value such as "15:04" becomes
+// "15%3A04" and remains a single path segment.
+//
+// Because the path is formatted in TZ, writers typed to the same zone as
+// their readers partition identically whatever the host's local timezone.
+func (t Time[TZ]) PartitionPath(layout string) string {
+	segments := strings.Split(layout, "/")
+	for i, segment := range segments {
+		if key, valueLayout, ok := strings.Cut(segment, "="); ok {
+			segments[i] = key + "=" + escapePartitionValue(t.Format(valueLayout))
+		} else {
+			segments[i] = escapePartitionValue(t.Format(segment))
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// ParsePartitionPath parses the partition path of layout, as produced by
+// PartitionPath, in the specified timezone. The partition may be embedded in
+// a longer path, such as the key of an object in a partition:
+//
+//	ParsePartitionPath[utc.Timezone]("dt=2006-01-02/hour=15",
+//		"s3://lake/events/dt=2024-03-10/hour=09/part-00000.parquet")
+//
+// The first run of segments whose keys match those of layout, in order, and
+// whose values parse is used. Fields missing from the layout take their
+// zero values as in Parse, so "dt=2006-01-02" parses to midnight in TZ.
+func ParsePartitionPath[TZ Timezone](layout, path string) (Time[TZ], error) {
+	layoutSegments := strings.Split(layout, "/")
+	pathSegments := strings.Split(path, "/")
+	err := fmt.Errorf("no partition matching %q in %q", layout, path)
+	for start := 0; start+len(layoutSegments) <= len(pathSegments); start++ {
+		valueLayouts, values, ok := matchPartition(layoutSegments, pathSegments[start:start+len(layoutSegments)])
+		if !ok {
+			continue
+		}
+		var t Time[TZ]
+		if t, err = Parse[TZ](strings.Join(valueLayouts, "/"), strings.Join(values, "/")); err == nil {
+			return t, nil
+		}
+	}
+	return Time[TZ]{}, err
+}
+
+// matchPartition reports whether the path segments have the keys of the
+// layout segments and returns the value layouts with the unescaped values.
+func matchPartition(layoutSegments, pathSegments []string) (valueLayouts, values []string, ok bool) {
+	for i, segment := range layoutSegments {
+		valueLayout, value := segment, pathSegments[i]
+		if key, v, isKeyed := strings.Cut(segment, "="); isKeyed {
+			if value, ok = strings.CutPrefix(value, key+"="); !ok {
+				return nil, nil, false
+			}
+			valueLayout = v
+		}
+		value, err := unescapePartitionValue(value)
+		if err != nil {
+			return nil, nil, false
+		}
+		valueLayouts = append(valueLayouts, valueLayout)
+		values = append(values, value)
+	}
+	return valueLayouts, values, true
+}
+
+// escapePartitionValue escapes the characters Hive escapes in partition
+// values as %XX.
+func escapePartitionValue(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c < 0x20 || c == 0x7f || strings.IndexByte("\"#%'*/:=?\\{[]^", c) >= 0 {
+			fmt.Fprintf(&b, "%%%02X", c)
+		} else {
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
+
+// unescapePartitionValue reverses escapePartitionValue.
+func unescapePartitionValue(s string) (string, error) {
+	if !strings.Contains(s, "%") {
+		return s, nil
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '%' {
+			b.WriteByte(s[i])
+			continue
+		}
+		if i+2 >= len(s) {
+			return "", fmt.Errorf("invalid escape %q in partition value", s[i:])
+		}
+		c, err := strconv.ParseUint(s[i+1:i+3], 16, 8)
+		if err != nil {
+			return "", fmt.Errorf("invalid escape %q in partition value", s[i:i+3])
+		}
+		b.WriteByte(byte(c))
+		i += 2
+	}
+	return b.String(), nil
+}
@@ -0,0 +1,110 @@
+package meridian
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPartitionPath(t *testing.T) {
+	instant := Date[UTC](2024, time.March, 10, 3, 30, 0, 0)
+	tests := []struct {
+		name   string
+		time   interface{ PartitionPath(string) string }
+		layout string
+		want   string
+	}{
+		{"UTC", instant, "dt=2006-01-02/hour=15", "dt=2024-03-10/hour=03"},
+		{"evaluated in zone", FromMoment[PST](instant), "dt=2006-01-02/hour=15", "dt=2024-03-09/hour=19"},
+		{"keys are literal", instant, "year=2006/month=01/day=02/Monday=Mon", "year=2024/month=03/day=10/Monday=Sun"},
+		{"unkeyed segments", instant, "events/2006/01/02", "events/2024/03/10"},
+		{"escaped values", instant, "ts=2006-01-02 15:04", "ts=2024-03-10 03%3A30"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.time.PartitionPath(tt.layout); got != tt.want {
+				t.Errorf("PartitionPath(%q) = %q, want %q", tt.layout, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParsePartitionPath(t *testing.T) {
+	tests := []struct {
+		name   string
+		layout string
+		path   string
+		want   time.Time
+	}{
+		{
+			name:   "exact",
+			layout: "dt=2006-01-02/hour=15",
+			path:   "dt=2024-03-09/hour=19",
+			want:   time.Date(2024, time.March, 10, 3, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "embedded in object key",
+			layout: "dt=2006-01-02/hour=15",
+			path:   "s3://lake/events/region=us/dt=2024-03-09/hour=19/part-00000.parquet",
+			want:   time.Date(2024, time.March, 10, 3, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "date only is midnight in zone",
+			layout: "dt=2006-01-02",
+			path:   "table/dt=2024-07-04/",
+			want:   time.Date(2024, time.July, 4, 7, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "escaped value",
+			layout: "ts=2006-01-02 15:04",
+			path:   "ts=2024-03-09 19%3A30",
+			want:   time.Date(2024, time.March, 10, 3, 30, 0, 0, time.UTC),
+		},
+		{
+			name:   "unkeyed segments",
+			layout: "2006/01/02",
+			path:   "events/2024/03/09/file.json",
+			want:   time.Date(2024, time.March, 9, 8, 0, 0, 0, time.UTC),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParsePartitionPath[PST](tt.layout, tt.path)
+			if err != nil {
+				t.Fatalf("ParsePartitionPath() error = %v", err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("ParsePartitionPath() = %v, want %v", got.UTC(), tt.want)
+			}
+		})
+	}
+}
+
+func TestParsePartitionPathRoundTrip(t *testing.T) {
+	const layout = "dt=2006-01-02/hour=15/ts=15:04:05.000"
+	want := Date[EST](2024, time.November, 3, 1, 30, 15, 250000000)
+	got, err := ParsePartitionPath[EST](layout, want.PartitionPath(layout))
+	if err != nil || !got.Equal(want) {
+		t.Errorf("round trip = %v, %v, want %v", got, err, want)
+	}
+}
+
+func TestParsePartitionPathErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		layout string
+		path   string
+	}{
+		{"missing key", "dt=2006-01-02/hour=15", "dt=2024-03-10/minute=15"},
+		{"keys out of order", "dt=2006-01-02/hour=15", "hour=15/dt=2024-03-10"},
+		{"bad value", "dt=2006-01-02", "dt=2024-13-40"},
+		{"bad escape", "ts=15:04", "ts=03%3"},
+		{"too short", "dt=2006-01-02/hour=15", "dt=2024-03-10"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, err := ParsePartitionPath[UTC](tt.layout, tt.path); err == nil {
+				t.Errorf("ParsePartitionPath(%q, %q) = %v, want error", tt.layout, tt.path, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package meridian
+
+import (
+	"encoding"
+	"encoding/json"
+	"time"
+)
+
+// Compile-time interface assertions.
+var (
+	_ json.Marshaler           = RFC3339Seconds[Timezone]{}
+	_ json.Unmarshaler         = (*RFC3339Seconds[Timezone])(nil)
+	_ encoding.TextMarshaler   = RFC3339Seconds[Timezone]{}
+	_ encoding.TextUnmarshaler = (*RFC3339Seconds[Timezone])(nil)
+	_ json.Marshaler           = RFC3339Millis[Timezone]{}
+	_ json.Unmarshaler         = (*RFC3339Millis[Timezone])(nil)
+	_ encoding.TextMarshaler   = RFC3339Millis[Timezone]{}
+	_ encoding.TextUnmarshaler = (*RFC3339Millis[Timezone])(nil)
+)
+
+// RFC3339Milli is the RFC 3339 layout with exactly three fractional digits,
+// the common wire format of JavaScript's Date.prototype.toISOString and many
+// APIs. Unlike time.RFC3339Nano, trailing zeros are kept, so every value has
+// the same length for a given offset.
+const RFC3339Milli = "2006-01-02T15:04:05.000Z07:00"
+
+// FormatRFC3339Milli returns t formatted with the RFC3339Milli layout in the
+// timezone's location, such as "2024-12-25T09:00:00.000-05:00". Sub-millisecond
+// precision is truncated.
+func (t Time[TZ]) FormatRFC3339Milli() string {
+	return t.nativeTimeInLocation().Format(RFC3339Milli)
+}
+
+// ParseRFC3339Milli parses a value in the RFC3339Milli layout, which must have
+// exactly three fractional digits. The result represents the same instant in
+// the specified timezone.
+func ParseRFC3339Milli[TZ Timezone](value string) (Time[TZ], error) {
+	t, err := time.Parse(RFC3339Milli, value)
+	if err != nil {
+		return Time[TZ]{}, err
+	}
+	return Time[TZ]{utcTime: t.UTC()}, nil
+}
+
+// RFC3339Seconds is a Time[TZ] that is encoded in JSON and text as RFC 3339
+// without fractional seconds, for consumers that reject or mishandle them.
+// The embedded Time exposes the full Time[TZ] API, so a field of this type
+// need not be truncated by hand before every marshal.
+//
+// Sub-second precision is truncated when marshaling. Unmarshaling accepts any
+// RFC 3339 time and keeps its full precision.
+type RFC3339Seconds[TZ Timezone] struct {
+	Time[TZ]
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// The time is formatted as an RFC 3339 string with whole seconds.
+func (t RFC3339Seconds[TZ]) MarshalJSON() ([]byte, error) {
+	return t.Truncate(time.Second).MarshalJSON()
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// The time is formatted as an RFC 3339 string with whole seconds.
+func (t RFC3339Seconds[TZ]) MarshalText() ([]byte, error) {
+	return t.Truncate(time.Second).MarshalText()
+}
+
+// AppendText appends the textual representation of t, in whole seconds, to b
+// and returns the extended buffer.
+func (t RFC3339Seconds[TZ]) AppendText(b []byte) ([]byte, error) {
+	return t.Truncate(time.Second).AppendText(b)
+}
+
+// RFC3339Millis is a Time[TZ] that is encoded in JSON and text as RFC 3339
+// with at most millisecond precision, the precision of JavaScript's Date.
+// The embedded Time exposes the full Time[TZ] API.
+//
+// Sub-millisecond precision is truncated when marshaling, and trailing zeros
+// of the fraction are omitted as they are by time.Time. Unmarshaling accepts
+// any RFC 3339 time and keeps its full precision.
+type RFC3339Millis[TZ Timezone] struct {
+	Time[TZ]
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// The time is formatted as an RFC 3339 string with at most millisecond precision.
+func (t RFC3339Millis[TZ]) MarshalJSON() ([]byte, error) {
+	return t.Truncate(time.Millisecond).MarshalJSON()
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// The time is formatted as an RFC 3339 string with at most millisecond precision.
+func (t RFC3339Millis[TZ]) MarshalText() ([]byte, error) {
+	return t.Truncate(time.Millisecond).MarshalText()
+}
+
+// AppendText appends the textual representation of t, with at most millisecond
+// precision, to b and returns the extended buffer.
+func (t RFC3339Millis[TZ]) AppendText(b []byte) ([]byte, error) {
+	return t.Truncate(time.Millisecond).AppendText(b)
+}
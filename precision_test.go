@@ -0,0 +1,191 @@
+package meridian
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestRFC3339SecondsMarshal(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    Time[EST]
+		expected string
+	}{
+		{
+			name:     "whole seconds",
+			value:    Date[EST](2024, time.January, 15, 12, 0, 5, 0),
+			expected: "2024-01-15T12:00:05-05:00",
+		},
+		{
+			name:     "truncates nanoseconds",
+			value:    Date[EST](2024, time.January, 15, 12, 0, 5, 999999999),
+			expected: "2024-01-15T12:00:05-05:00",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(RFC3339Seconds[EST]{tt.value})
+			if err != nil {
+				t.Fatalf("MarshalJSON() error = %v", err)
+			}
+			if want := `"` + tt.expected + `"`; string(data) != want {
+				t.Errorf("MarshalJSON() = %s, want %s", data, want)
+			}
+
+			text, err := RFC3339Seconds[EST]{tt.value}.MarshalText()
+			if err != nil {
+				t.Fatalf("MarshalText() error = %v", err)
+			}
+			if string(text) != tt.expected {
+				t.Errorf("MarshalText() = %s, want %s", text, tt.expected)
+			}
+
+			appended, err := RFC3339Seconds[EST]{tt.value}.AppendText([]byte("at "))
+			if err != nil {
+				t.Fatalf("AppendText() error = %v", err)
+			}
+			if want := "at " + tt.expected; string(appended) != want {
+				t.Errorf("AppendText() = %s, want %s", appended, want)
+			}
+		})
+	}
+}
+
+func TestRFC3339MillisMarshal(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    Time[UTC]
+		expected string
+	}{
+		{
+			name:     "whole seconds",
+			value:    Date[UTC](2024, time.January, 15, 12, 0, 5, 0),
+			expected: "2024-01-15T12:00:05Z",
+		},
+		{
+			name:     "milliseconds",
+			value:    Date[UTC](2024, time.January, 15, 12, 0, 5, 123000000),
+			expected: "2024-01-15T12:00:05.123Z",
+		},
+		{
+			name:     "truncates sub-millisecond precision",
+			value:    Date[UTC](2024, time.January, 15, 12, 0, 5, 123999999),
+			expected: "2024-01-15T12:00:05.123Z",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(RFC3339Millis[UTC]{tt.value})
+			if err != nil {
+				t.Fatalf("MarshalJSON() error = %v", err)
+			}
+			if want := `"` + tt.expected + `"`; string(data) != want {
+				t.Errorf("MarshalJSON() = %s, want %s", data, want)
+			}
+
+			text, err := RFC3339Millis[UTC]{tt.value}.MarshalText()
+			if err != nil {
+				t.Fatalf("MarshalText() error = %v", err)
+			}
+			if string(text) != tt.expected {
+				t.Errorf("MarshalText() = %s, want %s", text, tt.expected)
+			}
+		})
+	}
+}
+
+func TestRFC3339PrecisionUnmarshalKeepsPrecision(t *testing.T) {
+	data := []byte(`"2024-01-15T12:00:05.123456789Z"`)
+	want := time.Date(2024, time.January, 15, 12, 0, 5, 123456789, time.UTC)
+
+	var seconds RFC3339Seconds[EST]
+	if err := json.Unmarshal(data, &seconds); err != nil {
+		t.Fatalf("RFC3339Seconds UnmarshalJSON() error = %v", err)
+	}
+	if !seconds.Equal(want) {
+		t.Errorf("RFC3339Seconds UnmarshalJSON() = %v, want %v", seconds.UTC(), want)
+	}
+
+	var millis RFC3339Millis[EST]
+	if err := millis.UnmarshalText(data[1 : len(data)-1]); err != nil {
+		t.Fatalf("RFC3339Millis UnmarshalText() error = %v", err)
+	}
+	if !millis.Equal(want) {
+		t.Errorf("RFC3339Millis UnmarshalText() = %v, want %v", millis.UTC(), want)
+	}
+}
+
+func TestRFC3339PrecisionInStruct(t *testing.T) {
+	type event struct {
+		At RFC3339Millis[UTC] `json:"at"`
+	}
+	in := event{At: RFC3339Millis[UTC]{Date[UTC](2024, time.January, 15, 12, 0, 5, 123456789)}}
+
+	data, err := json.Marshal(in)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if want := `{"at":"2024-01-15T12:00:05.123Z"}`; string(data) != want {
+		t.Errorf("Marshal() = %s, want %s", data, want)
+	}
+
+	var out event
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if !out.At.Equal(in.At.Truncate(time.Millisecond)) {
+		t.Errorf("round trip = %v, want %v", out.At, in.At.Truncate(time.Millisecond))
+	}
+}
+
+func TestFormatRFC3339Milli(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    Time[EST]
+		expected string
+	}{
+		{"whole seconds keep zeros", Date[EST](2024, time.December, 25, 9, 0, 0, 0), "2024-12-25T09:00:00.000-05:00"},
+		{"milliseconds", Date[EST](2024, time.December, 25, 9, 0, 0, 120000000), "2024-12-25T09:00:00.120-05:00"},
+		{"truncates sub-millisecond precision", Date[EST](2024, time.December, 25, 9, 0, 0, 999999999), "2024-12-25T09:00:00.999-05:00"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.value.FormatRFC3339Milli(); got != tt.expected {
+				t.Errorf("FormatRFC3339Milli() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+
+	if got, want := Date[UTC](2024, time.December, 25, 14, 0, 0, 0).FormatRFC3339Milli(), "2024-12-25T14:00:00.000Z"; got != want {
+		t.Errorf("FormatRFC3339Milli() in UTC = %q, want %q", got, want)
+	}
+}
+
+func TestParseRFC3339Milli(t *testing.T) {
+	got, err := ParseRFC3339Milli[EST]("2024-12-25T14:00:00.120Z")
+	if err != nil {
+		t.Fatalf("ParseRFC3339Milli() error = %v", err)
+	}
+	if want := time.Date(2024, time.December, 25, 14, 0, 0, 120000000, time.UTC); !got.Equal(want) {
+		t.Errorf("ParseRFC3339Milli() = %v, want %v", got.UTC(), want)
+	}
+	if got.Hour() != 9 {
+		t.Errorf("Hour() = %d, want 9 (EST)", got.Hour())
+	}
+
+	roundTrip, err := ParseRFC3339Milli[EST](got.FormatRFC3339Milli())
+	if err != nil || !roundTrip.Equal(got) {
+		t.Errorf("round trip = %v, %v, want %v", roundTrip, err, got)
+	}
+}
+
+func TestParseRFC3339MilliInvalid(t *testing.T) {
+	for _, value := range []string{"", "2024-12-25T14:00:00Z", "2024-12-25T14:00:00.12Z", "2024-12-25T14:00:00.1234Z", "2024-12-25 14:00:00.123Z"} {
+		if _, err := ParseRFC3339Milli[UTC](value); err == nil {
+			t.Errorf("ParseRFC3339Milli(%q) expected error, got nil", value)
+		}
+	}
+}
@@ -0,0 +1,145 @@
+// Code generated by generate-timezones. DO NOT EDIT.
+
+/*
+Package acst provides Australian Central Time timezone support for meridian.
+
+ACST represents the Australia/Adelaide IANA timezone, which observes Australian Central Time, alternating between ACST and ACDT with daylight saving time.
+
+# Daylight Saving Time
+
+Daylight saving time (ACDT, UTC+10:30) begins at 2:00 a.m. on the first
+Sunday in October and ends at 3:00 a.m. on the first Sunday in April,
+when clocks return to ACST (UTC+09:30). Both offsets include a half
+hour, so hour-aligned arithmetic in UTC does not land on the hour in
+Adelaide. The Northern Territory (Australia/Darwin) observes ACST
+year-round and is not covered by this package.
+
+# Usage
+
+Create ACST times:
+
+	now := acst.Now()
+	specific := acst.Date(2024, time.December, 25, 10, 30, 0, 0)
+	parsed, _ := acst.Parse(time.RFC3339, "2024-12-25T10:30:00Z")
+
+Convert to ACST from other timezones:
+
+	eastern := est.Now()
+	pacific := acst.FromMoment(eastern)
+
+Convert from standard time.Time:
+
+	stdTime := time.Now()
+	typedTime := acst.FromMoment(stdTime)
+
+The acst.Time type is an alias for meridian.Time[acst.Timezone], providing
+compile-time timezone safety. Functions that accept acst.Time can only receive
+times explicitly typed as Australian Central Time, preventing timezone confusion.
+*/
+package acst
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/matthalp/go-meridian/v2"
+)
+
+// The IANA timezone location is loaded on first use rather than at package
+// initialization, so importing the package costs nothing until it is used.
+// It is loaded again after meridian.Reload installs new timezone data.
+var location atomic.Pointer[loadedLocation]
+
+// loadedLocation is the result of loading the location from the timezone
+// data of one meridian.TZDataGeneration.
+type loadedLocation struct {
+	loc        *time.Location
+	err        error
+	generation uint64
+}
+
+// LoadLocation returns the IANA timezone location, loading it on first use.
+// It fails only if the system's timezone database is missing or lacks the
+// zone; call it at startup to report that as an error rather than a panic.
+func LoadLocation() (*time.Location, error) {
+	generation := meridian.TZDataGeneration()
+	if l := location.Load(); l != nil && l.generation == generation {
+		return l.loc, l.err
+	}
+	l := &loadedLocation{generation: generation}
+	l.loc, l.err = meridian.LoadLocation("Australia/Adelaide")
+	if l.err != nil {
+		l.err = fmt.Errorf("failed to load timezone Australia/Adelaide: %w", l.err)
+	}
+	location.Store(l)
+	return l.loc, l.err
+}
+
+// Timezone represents the Australian Central Time timezone.
+type Timezone struct{}
+
+// Abbreviations used by the Australia/Adelaide location, as recorded in the IANA
+// time zone database. Use them instead of hard-coding strings when displaying
+// or parsing zone abbreviations.
+const (
+	// StandardAbbrev is the abbreviation used during standard time.
+	StandardAbbrev = "ACST"
+
+	// DaylightAbbrev is the abbreviation used during daylight saving time,
+	// or the empty string if the timezone does not observe daylight saving time.
+	DaylightAbbrev = "ACDT"
+)
+
+// Location returns the IANA timezone location, loading it on first use.
+// It panics if the location cannot be loaded; see LoadLocation.
+func (Timezone) Location() *time.Location {
+	loc, err := LoadLocation()
+	if err != nil {
+		panic(err)
+	}
+	return loc
+}
+
+// Time is a convenience alias for meridian.Time[Timezone].
+type Time = meridian.Time[Timezone]
+
+// Now returns the current time in this timezone.
+func Now() Time {
+	return meridian.Now[Timezone]()
+}
+
+// Date creates a new time in this timezone with the specified date and time components.
+func Date(year int, month time.Month, day, hour, minute, sec, nsec int) Time {
+	return meridian.Date[Timezone](year, month, day, hour, minute, sec, nsec)
+}
+
+// FromMoment converts any Moment to ACST time.
+func FromMoment(m meridian.Moment) Time {
+	return meridian.FromMoment[Timezone](m)
+}
+
+// Parse parses a formatted string and returns the time value it represents in ACST.
+// The layout defines the format by showing how the reference time would be displayed.
+// The time is parsed in the Australia/Adelaide location.
+func Parse(layout, value string) (Time, error) {
+	return meridian.Parse[Timezone](layout, value)
+}
+
+// Unix returns the ACST time corresponding to the given Unix time,
+// sec seconds and nsec nanoseconds since January 1, 1970 UTC.
+func Unix(sec, nsec int64) Time {
+	return meridian.Unix[Timezone](sec, nsec)
+}
+
+// UnixMilli returns the ACST time corresponding to the given Unix time,
+// msec milliseconds since January 1, 1970 UTC.
+func UnixMilli(msec int64) Time {
+	return meridian.UnixMilli[Timezone](msec)
+}
+
+// UnixMicro returns the ACST time corresponding to the given Unix time,
+// usec microseconds since January 1, 1970 UTC.
+func UnixMicro(usec int64) Time {
+	return meridian.UnixMicro[Timezone](usec)
+}
@@ -0,0 +1,39 @@
+// Code generated by generate-timezones. DO NOT EDIT.
+
+package acst_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/matthalp/go-meridian/v2/timezones/acst"
+)
+
+func ExampleNow() {
+	now := acst.Now()
+
+	// The current time varies, but its location is always Australia/Adelaide.
+	fmt.Println(now.Location())
+	// Output: Australia/Adelaide
+}
+
+func ExampleDate() {
+	// Date components are interpreted in Australia/Adelaide.
+	t := acst.Date(2024, time.December, 25, 9, 0, 0, 0)
+
+	fmt.Println(t.Format(time.RFC3339))
+	fmt.Println(t.UTC().Format(time.RFC3339))
+	// Output:
+	// 2024-12-25T09:00:00+10:30
+	// 2024-12-24T22:30:00Z
+}
+
+func ExampleFromMoment() {
+	// Any Moment, such as a standard time.Time, can be converted to ACST.
+	// The instant is preserved; only the timezone type changes.
+	moment := time.Date(2024, time.June, 15, 12, 0, 0, 0, time.UTC)
+	t := acst.FromMoment(moment)
+
+	fmt.Println(t.Format("2006-01-02 15:04 MST"))
+	// Output: 2024-06-15 21:30 ACST
+}
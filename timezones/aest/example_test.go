@@ -0,0 +1,39 @@
+// Code generated by generate-timezones. DO NOT EDIT.
+
+package aest_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/matthalp/go-meridian/v2/timezones/aest"
+)
+
+func ExampleNow() {
+	now := aest.Now()
+
+	// The current time varies, but its location is always Australia/Sydney.
+	fmt.Println(now.Location())
+	// Output: Australia/Sydney
+}
+
+func ExampleDate() {
+	// Date components are interpreted in Australia/Sydney.
+	t := aest.Date(2024, time.December, 25, 9, 0, 0, 0)
+
+	fmt.Println(t.Format(time.RFC3339))
+	fmt.Println(t.UTC().Format(time.RFC3339))
+	// Output:
+	// 2024-12-25T09:00:00+11:00
+	// 2024-12-24T22:00:00Z
+}
+
+func ExampleFromMoment() {
+	// Any Moment, such as a standard time.Time, can be converted to AEST.
+	// The instant is preserved; only the timezone type changes.
+	moment := time.Date(2024, time.June, 15, 12, 0, 0, 0, time.UTC)
+	t := aest.FromMoment(moment)
+
+	fmt.Println(t.Format("2006-01-02 15:04 MST"))
+	// Output: 2024-06-15 22:00 AEST
+}
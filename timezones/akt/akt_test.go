@@ -0,0 +1,39 @@
+// Code generated by generate-timezones. DO NOT EDIT.
+
+package akt
+
+import (
+	"testing"
+
+	"github.com/matthalp/go-meridian/v2/internal/tztest"
+)
+
+func TestStandardSuite(t *testing.T) {
+	tztest.RunStandardSuite(t, Timezone{}, "America/Anchorage")
+}
+
+func TestAbbreviations(t *testing.T) {
+	tztest.CheckAbbreviations(t, Timezone{}, StandardAbbrev, DaylightAbbrev)
+}
+
+func TestLoadLocation(t *testing.T) {
+	loc, err := LoadLocation()
+	if err != nil {
+		t.Fatalf("LoadLocation() error = %v", err)
+	}
+	if loc != (Timezone{}).Location() {
+		t.Error("LoadLocation() and Timezone.Location() returned different locations")
+	}
+}
+
+func TestConstructors(t *testing.T) {
+	tztest.RunConstructorSuite(t, tztest.Constructors[Timezone]{
+		Now:        Now,
+		Date:       Date,
+		FromMoment: FromMoment,
+		Parse:      Parse,
+		Unix:       Unix,
+		UnixMilli:  UnixMilli,
+		UnixMicro:  UnixMicro,
+	})
+}
@@ -0,0 +1,46 @@
+// Code generated by generate-timezones. DO NOT EDIT.
+
+package art
+
+import (
+	"testing"
+	"time"
+)
+
+// Sinks keep the compiler from optimizing away benchmarked calls.
+var (
+	benchTime   Time
+	benchString string
+)
+
+func BenchmarkNow(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		benchTime = Now()
+	}
+}
+
+func BenchmarkDate(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		benchTime = Date(2024, time.July, 15, 12, 30, 0, 0)
+	}
+}
+
+func BenchmarkFormat(b *testing.B) {
+	t := Date(2024, time.July, 15, 12, 30, 0, 0)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchString = t.Format(time.RFC3339)
+	}
+}
+
+func BenchmarkFromMoment(b *testing.B) {
+	moment := time.Date(2024, time.July, 15, 12, 30, 0, 0, time.UTC)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchTime = FromMoment(moment)
+	}
+}
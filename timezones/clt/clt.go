@@ -0,0 +1,151 @@
+// Code generated by generate-timezones. DO NOT EDIT.
+
+/*
+Package clt provides Chile Time timezone support for meridian.
+
+CLT represents the America/Santiago IANA timezone, which observes Chile Time, alternating between -04 and -03 with daylight saving time.
+
+# Daylight Saving Time
+
+Chile observes daylight saving time (UTC-03:00) during the southern
+summer. It begins at midnight at the start of the first Sunday on or
+after September 2, when clocks jump to 1:00 a.m., and ends at midnight
+at the start of the first Sunday on or after April 2, when clocks return
+to 11:00 p.m. on Saturday (UTC-04:00). Because the transitions fall at
+midnight, the Sunday DST begins has no midnight and the last hour of the
+Saturday before it ends occurs twice; Date resolves them as time.Date
+does. Chile has changed these rules often, and conversions follow the
+timezone database.
+
+The timezone database abbreviates this zone as "-04" and "-03";
+StandardAbbrev and DaylightAbbrev report the database's names.
+
+# Usage
+
+Create CLT times:
+
+	now := clt.Now()
+	specific := clt.Date(2024, time.December, 25, 10, 30, 0, 0)
+	parsed, _ := clt.Parse(time.RFC3339, "2024-12-25T10:30:00Z")
+
+Convert to CLT from other timezones:
+
+	eastern := est.Now()
+	pacific := clt.FromMoment(eastern)
+
+Convert from standard time.Time:
+
+	stdTime := time.Now()
+	typedTime := clt.FromMoment(stdTime)
+
+The clt.Time type is an alias for meridian.Time[clt.Timezone], providing
+compile-time timezone safety. Functions that accept clt.Time can only receive
+times explicitly typed as Chile Time, preventing timezone confusion.
+*/
+package clt
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/matthalp/go-meridian/v2"
+)
+
+// The IANA timezone location is loaded on first use rather than at package
+// initialization, so importing the package costs nothing until it is used.
+// It is loaded again after meridian.Reload installs new timezone data.
+var location atomic.Pointer[loadedLocation]
+
+// loadedLocation is the result of loading the location from the timezone
+// data of one meridian.TZDataGeneration.
+type loadedLocation struct {
+	loc        *time.Location
+	err        error
+	generation uint64
+}
+
+// LoadLocation returns the IANA timezone location, loading it on first use.
+// It fails only if the system's timezone database is missing or lacks the
+// zone; call it at startup to report that as an error rather than a panic.
+func LoadLocation() (*time.Location, error) {
+	generation := meridian.TZDataGeneration()
+	if l := location.Load(); l != nil && l.generation == generation {
+		return l.loc, l.err
+	}
+	l := &loadedLocation{generation: generation}
+	l.loc, l.err = meridian.LoadLocation("America/Santiago")
+	if l.err != nil {
+		l.err = fmt.Errorf("failed to load timezone America/Santiago: %w", l.err)
+	}
+	location.Store(l)
+	return l.loc, l.err
+}
+
+// Timezone represents the Chile Time timezone.
+type Timezone struct{}
+
+// Abbreviations used by the America/Santiago location, as recorded in the IANA
+// time zone database. Use them instead of hard-coding strings when displaying
+// or parsing zone abbreviations.
+const (
+	// StandardAbbrev is the abbreviation used during standard time.
+	StandardAbbrev = "-04"
+
+	// DaylightAbbrev is the abbreviation used during daylight saving time,
+	// or the empty string if the timezone does not observe daylight saving time.
+	DaylightAbbrev = "-03"
+)
+
+// Location returns the IANA timezone location, loading it on first use.
+// It panics if the location cannot be loaded; see LoadLocation.
+func (Timezone) Location() *time.Location {
+	loc, err := LoadLocation()
+	if err != nil {
+		panic(err)
+	}
+	return loc
+}
+
+// Time is a convenience alias for meridian.Time[Timezone].
+type Time = meridian.Time[Timezone]
+
+// Now returns the current time in this timezone.
+func Now() Time {
+	return meridian.Now[Timezone]()
+}
+
+// Date creates a new time in this timezone with the specified date and time components.
+func Date(year int, month time.Month, day, hour, minute, sec, nsec int) Time {
+	return meridian.Date[Timezone](year, month, day, hour, minute, sec, nsec)
+}
+
+// FromMoment converts any Moment to CLT time.
+func FromMoment(m meridian.Moment) Time {
+	return meridian.FromMoment[Timezone](m)
+}
+
+// Parse parses a formatted string and returns the time value it represents in CLT.
+// The layout defines the format by showing how the reference time would be displayed.
+// The time is parsed in the America/Santiago location.
+func Parse(layout, value string) (Time, error) {
+	return meridian.Parse[Timezone](layout, value)
+}
+
+// Unix returns the CLT time corresponding to the given Unix time,
+// sec seconds and nsec nanoseconds since January 1, 1970 UTC.
+func Unix(sec, nsec int64) Time {
+	return meridian.Unix[Timezone](sec, nsec)
+}
+
+// UnixMilli returns the CLT time corresponding to the given Unix time,
+// msec milliseconds since January 1, 1970 UTC.
+func UnixMilli(msec int64) Time {
+	return meridian.UnixMilli[Timezone](msec)
+}
+
+// UnixMicro returns the CLT time corresponding to the given Unix time,
+// usec microseconds since January 1, 1970 UTC.
+func UnixMicro(usec int64) Time {
+	return meridian.UnixMicro[Timezone](usec)
+}
@@ -1,7 +1,9 @@
+// Code generated by generate-timezones. DO NOT EDIT.
+
 /*
 Package ct provides Central Time timezone support for meridian.
 
-CT represents the America/Chicago IANA timezone, which observes Central Time depending on the time of year.
+CT represents the America/Chicago IANA timezone, which observes Central Time, alternating between CST and CDT with daylight saving time.
 
 # Usage
 
@@ -29,30 +31,65 @@ package ct
 
 import (
 	"fmt"
+	"sync/atomic"
 	"time"
 
 	"github.com/matthalp/go-meridian/v2"
 )
 
-// location is the IANA timezone location, loaded once at package initialization.
-var location = mustLoadLocation("America/Chicago")
+// The IANA timezone location is loaded on first use rather than at package
+// initialization, so importing the package costs nothing until it is used.
+// It is loaded again after meridian.Reload installs new timezone data.
+var location atomic.Pointer[loadedLocation]
+
+// loadedLocation is the result of loading the location from the timezone
+// data of one meridian.TZDataGeneration.
+type loadedLocation struct {
+	loc        *time.Location
+	err        error
+	generation uint64
+}
 
-// mustLoadLocation loads a timezone location or panics if it fails.
-// This should only fail if the system's timezone database is corrupted or missing.
-func mustLoadLocation(name string) *time.Location {
-	loc, err := time.LoadLocation(name)
-	if err != nil {
-		panic(fmt.Sprintf("failed to load timezone %s: %v", name, err))
+// LoadLocation returns the IANA timezone location, loading it on first use.
+// It fails only if the system's timezone database is missing or lacks the
+// zone; call it at startup to report that as an error rather than a panic.
+func LoadLocation() (*time.Location, error) {
+	generation := meridian.TZDataGeneration()
+	if l := location.Load(); l != nil && l.generation == generation {
+		return l.loc, l.err
 	}
-	return loc
+	l := &loadedLocation{generation: generation}
+	l.loc, l.err = meridian.LoadLocation("America/Chicago")
+	if l.err != nil {
+		l.err = fmt.Errorf("failed to load timezone America/Chicago: %w", l.err)
+	}
+	location.Store(l)
+	return l.loc, l.err
 }
 
 // Timezone represents the Central Time timezone.
 type Timezone struct{}
 
-// Location returns the IANA timezone location.
+// Abbreviations used by the America/Chicago location, as recorded in the IANA
+// time zone database. Use them instead of hard-coding strings when displaying
+// or parsing zone abbreviations.
+const (
+	// StandardAbbrev is the abbreviation used during standard time.
+	StandardAbbrev = "CST"
+
+	// DaylightAbbrev is the abbreviation used during daylight saving time,
+	// or the empty string if the timezone does not observe daylight saving time.
+	DaylightAbbrev = "CDT"
+)
+
+// Location returns the IANA timezone location, loading it on first use.
+// It panics if the location cannot be loaded; see LoadLocation.
 func (Timezone) Location() *time.Location {
-	return location
+	loc, err := LoadLocation()
+	if err != nil {
+		panic(err)
+	}
+	return loc
 }
 
 // Time is a convenience alias for meridian.Time[Timezone].
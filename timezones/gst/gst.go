@@ -0,0 +1,142 @@
+// Code generated by generate-timezones. DO NOT EDIT.
+
+/*
+Package gst provides Gulf Standard Time timezone support for meridian.
+
+GST represents the Asia/Dubai IANA timezone, which observes Gulf Standard
+Time (UTC+04:00) across the United Arab Emirates and Oman year-round,
+without daylight saving time.
+
+The timezone database abbreviates this zone as "+04" rather than GST,
+which is also used for South Georgia Time; StandardAbbrev reports the
+database's name.
+
+# Usage
+
+Create GST times:
+
+	now := gst.Now()
+	specific := gst.Date(2024, time.December, 25, 10, 30, 0, 0)
+	parsed, _ := gst.Parse(time.RFC3339, "2024-12-25T10:30:00Z")
+
+Convert to GST from other timezones:
+
+	eastern := est.Now()
+	pacific := gst.FromMoment(eastern)
+
+Convert from standard time.Time:
+
+	stdTime := time.Now()
+	typedTime := gst.FromMoment(stdTime)
+
+The gst.Time type is an alias for meridian.Time[gst.Timezone], providing
+compile-time timezone safety. Functions that accept gst.Time can only receive
+times explicitly typed as Gulf Standard Time, preventing timezone confusion.
+*/
+package gst
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/matthalp/go-meridian/v2"
+)
+
+// The IANA timezone location is loaded on first use rather than at package
+// initialization, so importing the package costs nothing until it is used.
+// It is loaded again after meridian.Reload installs new timezone data.
+var location atomic.Pointer[loadedLocation]
+
+// loadedLocation is the result of loading the location from the timezone
+// data of one meridian.TZDataGeneration.
+type loadedLocation struct {
+	loc        *time.Location
+	err        error
+	generation uint64
+}
+
+// LoadLocation returns the IANA timezone location, loading it on first use.
+// It fails only if the system's timezone database is missing or lacks the
+// zone; call it at startup to report that as an error rather than a panic.
+func LoadLocation() (*time.Location, error) {
+	generation := meridian.TZDataGeneration()
+	if l := location.Load(); l != nil && l.generation == generation {
+		return l.loc, l.err
+	}
+	l := &loadedLocation{generation: generation}
+	l.loc, l.err = meridian.LoadLocation("Asia/Dubai")
+	if l.err != nil {
+		l.err = fmt.Errorf("failed to load timezone Asia/Dubai: %w", l.err)
+	}
+	location.Store(l)
+	return l.loc, l.err
+}
+
+// Timezone represents the Gulf Standard Time timezone.
+type Timezone struct{}
+
+// Abbreviations used by the Asia/Dubai location, as recorded in the IANA
+// time zone database. Use them instead of hard-coding strings when displaying
+// or parsing zone abbreviations.
+const (
+	// StandardAbbrev is the abbreviation used during standard time.
+	StandardAbbrev = "+04"
+
+	// DaylightAbbrev is the abbreviation used during daylight saving time,
+	// or the empty string if the timezone does not observe daylight saving time.
+	DaylightAbbrev = ""
+)
+
+// Location returns the IANA timezone location, loading it on first use.
+// It panics if the location cannot be loaded; see LoadLocation.
+func (Timezone) Location() *time.Location {
+	loc, err := LoadLocation()
+	if err != nil {
+		panic(err)
+	}
+	return loc
+}
+
+// Time is a convenience alias for meridian.Time[Timezone].
+type Time = meridian.Time[Timezone]
+
+// Now returns the current time in this timezone.
+func Now() Time {
+	return meridian.Now[Timezone]()
+}
+
+// Date creates a new time in this timezone with the specified date and time components.
+func Date(year int, month time.Month, day, hour, minute, sec, nsec int) Time {
+	return meridian.Date[Timezone](year, month, day, hour, minute, sec, nsec)
+}
+
+// FromMoment converts any Moment to GST time.
+func FromMoment(m meridian.Moment) Time {
+	return meridian.FromMoment[Timezone](m)
+}
+
+// Parse parses a formatted string and returns the time value it represents in GST.
+// The layout defines the format by showing how the reference time would be displayed.
+// The time is parsed in the Asia/Dubai location.
+func Parse(layout, value string) (Time, error) {
+	return meridian.Parse[Timezone](layout, value)
+}
+
+// Unix returns the GST time corresponding to the given Unix time,
+// sec seconds and nsec nanoseconds since January 1, 1970 UTC.
+func Unix(sec, nsec int64) Time {
+	return meridian.Unix[Timezone](sec, nsec)
+}
+
+// UnixMilli returns the GST time corresponding to the given Unix time,
+// msec milliseconds since January 1, 1970 UTC.
+func UnixMilli(msec int64) Time {
+	return meridian.UnixMilli[Timezone](msec)
+}
+
+// UnixMicro returns the GST time corresponding to the given Unix time,
+// usec microseconds since January 1, 1970 UTC.
+func UnixMicro(usec int64) Time {
+	return meridian.UnixMicro[Timezone](usec)
+}
@@ -0,0 +1,39 @@
+// Code generated by generate-timezones. DO NOT EDIT.
+
+package pst_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/matthalp/go-meridian/v2/timezones/pst"
+)
+
+func ExampleNow() {
+	now := pst.Now()
+
+	// The current time varies, but its location is always America/Los_Angeles.
+	fmt.Println(now.Location())
+	// Output: America/Los_Angeles
+}
+
+func ExampleDate() {
+	// Date components are interpreted in America/Los_Angeles.
+	t := pst.Date(2024, time.December, 25, 9, 0, 0, 0)
+
+	fmt.Println(t.Format(time.RFC3339))
+	fmt.Println(t.UTC().Format(time.RFC3339))
+	// Output:
+	// 2024-12-25T09:00:00-08:00
+	// 2024-12-25T17:00:00Z
+}
+
+func ExampleFromMoment() {
+	// Any Moment, such as a standard time.Time, can be converted to PST.
+	// The instant is preserved; only the timezone type changes.
+	moment := time.Date(2024, time.June, 15, 12, 0, 0, 0, time.UTC)
+	t := pst.FromMoment(moment)
+
+	fmt.Println(t.Format("2006-01-02 15:04 MST"))
+	// Output: 2024-06-15 05:00 PDT
+}
@@ -0,0 +1,869 @@
+// Code generated by generate-timezones. DO NOT EDIT.
+
+/*
+Package registry maps IANA location names and time zone abbreviations to the
+generated timezone packages, for zones that are only known at runtime:
+
+	zone, ok := registry.Lookup(user.Timezone) // e.g. "America/Chicago"
+	if !ok {
+		return fmt.Errorf("unsupported timezone %q", user.Timezone)
+	}
+	local := zone.FromMoment(time.Now())
+
+The values returned by a Zone's functions hold the zone's typed time, such as
+ct.Time, which can be recovered with a type assertion.
+*/
+package registry
+
+import (
+	"time"
+
+	"github.com/matthalp/go-meridian/v2"
+	"github.com/matthalp/go-meridian/v2/timezones/acst"
+	"github.com/matthalp/go-meridian/v2/timezones/aest"
+	"github.com/matthalp/go-meridian/v2/timezones/akt"
+	"github.com/matthalp/go-meridian/v2/timezones/art"
+	"github.com/matthalp/go-meridian/v2/timezones/at"
+	"github.com/matthalp/go-meridian/v2/timezones/awst"
+	"github.com/matthalp/go-meridian/v2/timezones/brt"
+	"github.com/matthalp/go-meridian/v2/timezones/cet"
+	"github.com/matthalp/go-meridian/v2/timezones/clt"
+	"github.com/matthalp/go-meridian/v2/timezones/cmx"
+	"github.com/matthalp/go-meridian/v2/timezones/cot"
+	"github.com/matthalp/go-meridian/v2/timezones/cst"
+	"github.com/matthalp/go-meridian/v2/timezones/ct"
+	"github.com/matthalp/go-meridian/v2/timezones/eet"
+	"github.com/matthalp/go-meridian/v2/timezones/est"
+	"github.com/matthalp/go-meridian/v2/timezones/et"
+	"github.com/matthalp/go-meridian/v2/timezones/gmt"
+	"github.com/matthalp/go-meridian/v2/timezones/gst"
+	"github.com/matthalp/go-meridian/v2/timezones/hkt"
+	"github.com/matthalp/go-meridian/v2/timezones/hst"
+	"github.com/matthalp/go-meridian/v2/timezones/ict"
+	"github.com/matthalp/go-meridian/v2/timezones/ist"
+	"github.com/matthalp/go-meridian/v2/timezones/jst"
+	"github.com/matthalp/go-meridian/v2/timezones/kst"
+	"github.com/matthalp/go-meridian/v2/timezones/msk"
+	"github.com/matthalp/go-meridian/v2/timezones/mt"
+	"github.com/matthalp/go-meridian/v2/timezones/nt"
+	"github.com/matthalp/go-meridian/v2/timezones/nzt"
+	"github.com/matthalp/go-meridian/v2/timezones/pht"
+	"github.com/matthalp/go-meridian/v2/timezones/pst"
+	"github.com/matthalp/go-meridian/v2/timezones/pt"
+	"github.com/matthalp/go-meridian/v2/timezones/sast"
+	"github.com/matthalp/go-meridian/v2/timezones/sgt"
+	"github.com/matthalp/go-meridian/v2/timezones/trt"
+	"github.com/matthalp/go-meridian/v2/timezones/utc"
+	"github.com/matthalp/go-meridian/v2/timezones/wet"
+	"github.com/matthalp/go-meridian/v2/timezones/wib"
+)
+
+// Time is the method set shared by the typed times of every zone, such as
+// et.Time and utc.Time.
+type Time interface {
+	meridian.Moment
+	Format(layout string) string
+	String() string
+	Location() *time.Location
+	Time() time.Time
+}
+
+// Zone describes a generated timezone package and dispatches into it.
+type Zone struct {
+	Name           string // package name, such as "ct"
+	Location       string // location name, such as "America/Chicago"
+	Description    string // human-readable name, such as "Central Time"
+	StandardAbbrev string // abbreviation during standard time
+	DaylightAbbrev string // abbreviation during daylight saving time, or ""
+
+	// FromMoment converts m to the zone's typed time.
+	FromMoment func(m meridian.Moment) Time
+
+	// Parse parses a formatted string into the zone's typed time, as the
+	// package's Parse function does.
+	Parse func(layout, value string) (Time, error)
+
+	// Calendar returns the meridian.Calendar in the zone with the given
+	// opening hours and weekend days, for combining with the calendars of
+	// other zones, as by meridian.CommonWorkingHours.
+	Calendar func(open, closing time.Duration, weekend []time.Weekday) meridian.WorkingHours
+}
+
+// zones lists the generated packages in definition order.
+var zones = []Zone{
+	{
+		Name:           "acst",
+		Location:       "Australia/Adelaide",
+		Description:    "Australian Central Time",
+		StandardAbbrev: acst.StandardAbbrev,
+		DaylightAbbrev: acst.DaylightAbbrev,
+		FromMoment: func(m meridian.Moment) Time {
+			return acst.FromMoment(m)
+		},
+		Parse: func(layout, value string) (Time, error) {
+			t, err := acst.Parse(layout, value)
+			if err != nil {
+				return nil, err
+			}
+			return t, nil
+		},
+		Calendar: func(open, closing time.Duration, weekend []time.Weekday) meridian.WorkingHours {
+			return meridian.Calendar[acst.Timezone]{Open: open, Close: closing, Weekend: weekend}
+		},
+	},
+	{
+		Name:           "aest",
+		Location:       "Australia/Sydney",
+		Description:    "Australian Eastern Time",
+		StandardAbbrev: aest.StandardAbbrev,
+		DaylightAbbrev: aest.DaylightAbbrev,
+		FromMoment: func(m meridian.Moment) Time {
+			return aest.FromMoment(m)
+		},
+		Parse: func(layout, value string) (Time, error) {
+			t, err := aest.Parse(layout, value)
+			if err != nil {
+				return nil, err
+			}
+			return t, nil
+		},
+		Calendar: func(open, closing time.Duration, weekend []time.Weekday) meridian.WorkingHours {
+			return meridian.Calendar[aest.Timezone]{Open: open, Close: closing, Weekend: weekend}
+		},
+	},
+	{
+		Name:           "akt",
+		Location:       "America/Anchorage",
+		Description:    "Alaska Time",
+		StandardAbbrev: akt.StandardAbbrev,
+		DaylightAbbrev: akt.DaylightAbbrev,
+		FromMoment: func(m meridian.Moment) Time {
+			return akt.FromMoment(m)
+		},
+		Parse: func(layout, value string) (Time, error) {
+			t, err := akt.Parse(layout, value)
+			if err != nil {
+				return nil, err
+			}
+			return t, nil
+		},
+		Calendar: func(open, closing time.Duration, weekend []time.Weekday) meridian.WorkingHours {
+			return meridian.Calendar[akt.Timezone]{Open: open, Close: closing, Weekend: weekend}
+		},
+	},
+	{
+		Name:           "art",
+		Location:       "America/Argentina/Buenos_Aires",
+		Description:    "Argentina Time",
+		StandardAbbrev: art.StandardAbbrev,
+		DaylightAbbrev: art.DaylightAbbrev,
+		FromMoment: func(m meridian.Moment) Time {
+			return art.FromMoment(m)
+		},
+		Parse: func(layout, value string) (Time, error) {
+			t, err := art.Parse(layout, value)
+			if err != nil {
+				return nil, err
+			}
+			return t, nil
+		},
+		Calendar: func(open, closing time.Duration, weekend []time.Weekday) meridian.WorkingHours {
+			return meridian.Calendar[art.Timezone]{Open: open, Close: closing, Weekend: weekend}
+		},
+	},
+	{
+		Name:           "at",
+		Location:       "America/Halifax",
+		Description:    "Atlantic Time",
+		StandardAbbrev: at.StandardAbbrev,
+		DaylightAbbrev: at.DaylightAbbrev,
+		FromMoment: func(m meridian.Moment) Time {
+			return at.FromMoment(m)
+		},
+		Parse: func(layout, value string) (Time, error) {
+			t, err := at.Parse(layout, value)
+			if err != nil {
+				return nil, err
+			}
+			return t, nil
+		},
+		Calendar: func(open, closing time.Duration, weekend []time.Weekday) meridian.WorkingHours {
+			return meridian.Calendar[at.Timezone]{Open: open, Close: closing, Weekend: weekend}
+		},
+	},
+	{
+		Name:           "awst",
+		Location:       "Australia/Perth",
+		Description:    "Australian Western Standard Time",
+		StandardAbbrev: awst.StandardAbbrev,
+		DaylightAbbrev: awst.DaylightAbbrev,
+		FromMoment: func(m meridian.Moment) Time {
+			return awst.FromMoment(m)
+		},
+		Parse: func(layout, value string) (Time, error) {
+			t, err := awst.Parse(layout, value)
+			if err != nil {
+				return nil, err
+			}
+			return t, nil
+		},
+		Calendar: func(open, closing time.Duration, weekend []time.Weekday) meridian.WorkingHours {
+			return meridian.Calendar[awst.Timezone]{Open: open, Close: closing, Weekend: weekend}
+		},
+	},
+	{
+		Name:           "brt",
+		Location:       "America/Sao_Paulo",
+		Description:    "Brasília Time",
+		StandardAbbrev: brt.StandardAbbrev,
+		DaylightAbbrev: brt.DaylightAbbrev,
+		FromMoment: func(m meridian.Moment) Time {
+			return brt.FromMoment(m)
+		},
+		Parse: func(layout, value string) (Time, error) {
+			t, err := brt.Parse(layout, value)
+			if err != nil {
+				return nil, err
+			}
+			return t, nil
+		},
+		Calendar: func(open, closing time.Duration, weekend []time.Weekday) meridian.WorkingHours {
+			return meridian.Calendar[brt.Timezone]{Open: open, Close: closing, Weekend: weekend}
+		},
+	},
+	{
+		Name:           "cet",
+		Location:       "Europe/Paris",
+		Description:    "Central European Time",
+		StandardAbbrev: cet.StandardAbbrev,
+		DaylightAbbrev: cet.DaylightAbbrev,
+		FromMoment: func(m meridian.Moment) Time {
+			return cet.FromMoment(m)
+		},
+		Parse: func(layout, value string) (Time, error) {
+			t, err := cet.Parse(layout, value)
+			if err != nil {
+				return nil, err
+			}
+			return t, nil
+		},
+		Calendar: func(open, closing time.Duration, weekend []time.Weekday) meridian.WorkingHours {
+			return meridian.Calendar[cet.Timezone]{Open: open, Close: closing, Weekend: weekend}
+		},
+	},
+	{
+		Name:           "clt",
+		Location:       "America/Santiago",
+		Description:    "Chile Time",
+		StandardAbbrev: clt.StandardAbbrev,
+		DaylightAbbrev: clt.DaylightAbbrev,
+		FromMoment: func(m meridian.Moment) Time {
+			return clt.FromMoment(m)
+		},
+		Parse: func(layout, value string) (Time, error) {
+			t, err := clt.Parse(layout, value)
+			if err != nil {
+				return nil, err
+			}
+			return t, nil
+		},
+		Calendar: func(open, closing time.Duration, weekend []time.Weekday) meridian.WorkingHours {
+			return meridian.Calendar[clt.Timezone]{Open: open, Close: closing, Weekend: weekend}
+		},
+	},
+	{
+		Name:           "cmx",
+		Location:       "America/Mexico_City",
+		Description:    "Central Mexico Time",
+		StandardAbbrev: cmx.StandardAbbrev,
+		DaylightAbbrev: cmx.DaylightAbbrev,
+		FromMoment: func(m meridian.Moment) Time {
+			return cmx.FromMoment(m)
+		},
+		Parse: func(layout, value string) (Time, error) {
+			t, err := cmx.Parse(layout, value)
+			if err != nil {
+				return nil, err
+			}
+			return t, nil
+		},
+		Calendar: func(open, closing time.Duration, weekend []time.Weekday) meridian.WorkingHours {
+			return meridian.Calendar[cmx.Timezone]{Open: open, Close: closing, Weekend: weekend}
+		},
+	},
+	{
+		Name:           "cot",
+		Location:       "America/Bogota",
+		Description:    "Colombia Time",
+		StandardAbbrev: cot.StandardAbbrev,
+		DaylightAbbrev: cot.DaylightAbbrev,
+		FromMoment: func(m meridian.Moment) Time {
+			return cot.FromMoment(m)
+		},
+		Parse: func(layout, value string) (Time, error) {
+			t, err := cot.Parse(layout, value)
+			if err != nil {
+				return nil, err
+			}
+			return t, nil
+		},
+		Calendar: func(open, closing time.Duration, weekend []time.Weekday) meridian.WorkingHours {
+			return meridian.Calendar[cot.Timezone]{Open: open, Close: closing, Weekend: weekend}
+		},
+	},
+	{
+		Name:           "cst",
+		Location:       "Asia/Shanghai",
+		Description:    "China Standard Time",
+		StandardAbbrev: cst.StandardAbbrev,
+		DaylightAbbrev: cst.DaylightAbbrev,
+		FromMoment: func(m meridian.Moment) Time {
+			return cst.FromMoment(m)
+		},
+		Parse: func(layout, value string) (Time, error) {
+			t, err := cst.Parse(layout, value)
+			if err != nil {
+				return nil, err
+			}
+			return t, nil
+		},
+		Calendar: func(open, closing time.Duration, weekend []time.Weekday) meridian.WorkingHours {
+			return meridian.Calendar[cst.Timezone]{Open: open, Close: closing, Weekend: weekend}
+		},
+	},
+	{
+		Name:           "ct",
+		Location:       "America/Chicago",
+		Description:    "Central Time",
+		StandardAbbrev: ct.StandardAbbrev,
+		DaylightAbbrev: ct.DaylightAbbrev,
+		FromMoment: func(m meridian.Moment) Time {
+			return ct.FromMoment(m)
+		},
+		Parse: func(layout, value string) (Time, error) {
+			t, err := ct.Parse(layout, value)
+			if err != nil {
+				return nil, err
+			}
+			return t, nil
+		},
+		Calendar: func(open, closing time.Duration, weekend []time.Weekday) meridian.WorkingHours {
+			return meridian.Calendar[ct.Timezone]{Open: open, Close: closing, Weekend: weekend}
+		},
+	},
+	{
+		Name:           "eet",
+		Location:       "Europe/Helsinki",
+		Description:    "Eastern European Time",
+		StandardAbbrev: eet.StandardAbbrev,
+		DaylightAbbrev: eet.DaylightAbbrev,
+		FromMoment: func(m meridian.Moment) Time {
+			return eet.FromMoment(m)
+		},
+		Parse: func(layout, value string) (Time, error) {
+			t, err := eet.Parse(layout, value)
+			if err != nil {
+				return nil, err
+			}
+			return t, nil
+		},
+		Calendar: func(open, closing time.Duration, weekend []time.Weekday) meridian.WorkingHours {
+			return meridian.Calendar[eet.Timezone]{Open: open, Close: closing, Weekend: weekend}
+		},
+	},
+	{
+		Name:           "est",
+		Location:       "America/New_York",
+		Description:    "Eastern Standard Time",
+		StandardAbbrev: est.StandardAbbrev,
+		DaylightAbbrev: est.DaylightAbbrev,
+		FromMoment: func(m meridian.Moment) Time {
+			return est.FromMoment(m)
+		},
+		Parse: func(layout, value string) (Time, error) {
+			t, err := est.Parse(layout, value)
+			if err != nil {
+				return nil, err
+			}
+			return t, nil
+		},
+		Calendar: func(open, closing time.Duration, weekend []time.Weekday) meridian.WorkingHours {
+			return meridian.Calendar[est.Timezone]{Open: open, Close: closing, Weekend: weekend}
+		},
+	},
+	{
+		Name:           "et",
+		Location:       "America/New_York",
+		Description:    "Eastern Time",
+		StandardAbbrev: et.StandardAbbrev,
+		DaylightAbbrev: et.DaylightAbbrev,
+		FromMoment: func(m meridian.Moment) Time {
+			return et.FromMoment(m)
+		},
+		Parse: func(layout, value string) (Time, error) {
+			t, err := et.Parse(layout, value)
+			if err != nil {
+				return nil, err
+			}
+			return t, nil
+		},
+		Calendar: func(open, closing time.Duration, weekend []time.Weekday) meridian.WorkingHours {
+			return meridian.Calendar[et.Timezone]{Open: open, Close: closing, Weekend: weekend}
+		},
+	},
+	{
+		Name:           "gmt",
+		Location:       "Europe/London",
+		Description:    "Greenwich Mean Time",
+		StandardAbbrev: gmt.StandardAbbrev,
+		DaylightAbbrev: gmt.DaylightAbbrev,
+		FromMoment: func(m meridian.Moment) Time {
+			return gmt.FromMoment(m)
+		},
+		Parse: func(layout, value string) (Time, error) {
+			t, err := gmt.Parse(layout, value)
+			if err != nil {
+				return nil, err
+			}
+			return t, nil
+		},
+		Calendar: func(open, closing time.Duration, weekend []time.Weekday) meridian.WorkingHours {
+			return meridian.Calendar[gmt.Timezone]{Open: open, Close: closing, Weekend: weekend}
+		},
+	},
+	{
+		Name:           "gst",
+		Location:       "Asia/Dubai",
+		Description:    "Gulf Standard Time",
+		StandardAbbrev: gst.StandardAbbrev,
+		DaylightAbbrev: gst.DaylightAbbrev,
+		FromMoment: func(m meridian.Moment) Time {
+			return gst.FromMoment(m)
+		},
+		Parse: func(layout, value string) (Time, error) {
+			t, err := gst.Parse(layout, value)
+			if err != nil {
+				return nil, err
+			}
+			return t, nil
+		},
+		Calendar: func(open, closing time.Duration, weekend []time.Weekday) meridian.WorkingHours {
+			return meridian.Calendar[gst.Timezone]{Open: open, Close: closing, Weekend: weekend}
+		},
+	},
+	{
+		Name:           "hkt",
+		Location:       "Asia/Hong_Kong",
+		Description:    "Hong Kong Time",
+		StandardAbbrev: hkt.StandardAbbrev,
+		DaylightAbbrev: hkt.DaylightAbbrev,
+		FromMoment: func(m meridian.Moment) Time {
+			return hkt.FromMoment(m)
+		},
+		Parse: func(layout, value string) (Time, error) {
+			t, err := hkt.Parse(layout, value)
+			if err != nil {
+				return nil, err
+			}
+			return t, nil
+		},
+		Calendar: func(open, closing time.Duration, weekend []time.Weekday) meridian.WorkingHours {
+			return meridian.Calendar[hkt.Timezone]{Open: open, Close: closing, Weekend: weekend}
+		},
+	},
+	{
+		Name:           "hst",
+		Location:       "Pacific/Honolulu",
+		Description:    "Hawaii Standard Time",
+		StandardAbbrev: hst.StandardAbbrev,
+		DaylightAbbrev: hst.DaylightAbbrev,
+		FromMoment: func(m meridian.Moment) Time {
+			return hst.FromMoment(m)
+		},
+		Parse: func(layout, value string) (Time, error) {
+			t, err := hst.Parse(layout, value)
+			if err != nil {
+				return nil, err
+			}
+			return t, nil
+		},
+		Calendar: func(open, closing time.Duration, weekend []time.Weekday) meridian.WorkingHours {
+			return meridian.Calendar[hst.Timezone]{Open: open, Close: closing, Weekend: weekend}
+		},
+	},
+	{
+		Name:           "ict",
+		Location:       "Asia/Bangkok",
+		Description:    "Indochina Time",
+		StandardAbbrev: ict.StandardAbbrev,
+		DaylightAbbrev: ict.DaylightAbbrev,
+		FromMoment: func(m meridian.Moment) Time {
+			return ict.FromMoment(m)
+		},
+		Parse: func(layout, value string) (Time, error) {
+			t, err := ict.Parse(layout, value)
+			if err != nil {
+				return nil, err
+			}
+			return t, nil
+		},
+		Calendar: func(open, closing time.Duration, weekend []time.Weekday) meridian.WorkingHours {
+			return meridian.Calendar[ict.Timezone]{Open: open, Close: closing, Weekend: weekend}
+		},
+	},
+	{
+		Name:           "ist",
+		Location:       "Asia/Kolkata",
+		Description:    "India Standard Time",
+		StandardAbbrev: ist.StandardAbbrev,
+		DaylightAbbrev: ist.DaylightAbbrev,
+		FromMoment: func(m meridian.Moment) Time {
+			return ist.FromMoment(m)
+		},
+		Parse: func(layout, value string) (Time, error) {
+			t, err := ist.Parse(layout, value)
+			if err != nil {
+				return nil, err
+			}
+			return t, nil
+		},
+		Calendar: func(open, closing time.Duration, weekend []time.Weekday) meridian.WorkingHours {
+			return meridian.Calendar[ist.Timezone]{Open: open, Close: closing, Weekend: weekend}
+		},
+	},
+	{
+		Name:           "jst",
+		Location:       "Asia/Tokyo",
+		Description:    "Japan Standard Time",
+		StandardAbbrev: jst.StandardAbbrev,
+		DaylightAbbrev: jst.DaylightAbbrev,
+		FromMoment: func(m meridian.Moment) Time {
+			return jst.FromMoment(m)
+		},
+		Parse: func(layout, value string) (Time, error) {
+			t, err := jst.Parse(layout, value)
+			if err != nil {
+				return nil, err
+			}
+			return t, nil
+		},
+		Calendar: func(open, closing time.Duration, weekend []time.Weekday) meridian.WorkingHours {
+			return meridian.Calendar[jst.Timezone]{Open: open, Close: closing, Weekend: weekend}
+		},
+	},
+	{
+		Name:           "kst",
+		Location:       "Asia/Seoul",
+		Description:    "Korea Standard Time",
+		StandardAbbrev: kst.StandardAbbrev,
+		DaylightAbbrev: kst.DaylightAbbrev,
+		FromMoment: func(m meridian.Moment) Time {
+			return kst.FromMoment(m)
+		},
+		Parse: func(layout, value string) (Time, error) {
+			t, err := kst.Parse(layout, value)
+			if err != nil {
+				return nil, err
+			}
+			return t, nil
+		},
+		Calendar: func(open, closing time.Duration, weekend []time.Weekday) meridian.WorkingHours {
+			return meridian.Calendar[kst.Timezone]{Open: open, Close: closing, Weekend: weekend}
+		},
+	},
+	{
+		Name:           "msk",
+		Location:       "Europe/Moscow",
+		Description:    "Moscow Standard Time",
+		StandardAbbrev: msk.StandardAbbrev,
+		DaylightAbbrev: msk.DaylightAbbrev,
+		FromMoment: func(m meridian.Moment) Time {
+			return msk.FromMoment(m)
+		},
+		Parse: func(layout, value string) (Time, error) {
+			t, err := msk.Parse(layout, value)
+			if err != nil {
+				return nil, err
+			}
+			return t, nil
+		},
+		Calendar: func(open, closing time.Duration, weekend []time.Weekday) meridian.WorkingHours {
+			return meridian.Calendar[msk.Timezone]{Open: open, Close: closing, Weekend: weekend}
+		},
+	},
+	{
+		Name:           "mt",
+		Location:       "America/Denver",
+		Description:    "Mountain Time",
+		StandardAbbrev: mt.StandardAbbrev,
+		DaylightAbbrev: mt.DaylightAbbrev,
+		FromMoment: func(m meridian.Moment) Time {
+			return mt.FromMoment(m)
+		},
+		Parse: func(layout, value string) (Time, error) {
+			t, err := mt.Parse(layout, value)
+			if err != nil {
+				return nil, err
+			}
+			return t, nil
+		},
+		Calendar: func(open, closing time.Duration, weekend []time.Weekday) meridian.WorkingHours {
+			return meridian.Calendar[mt.Timezone]{Open: open, Close: closing, Weekend: weekend}
+		},
+	},
+	{
+		Name:           "nt",
+		Location:       "America/St_Johns",
+		Description:    "Newfoundland Time",
+		StandardAbbrev: nt.StandardAbbrev,
+		DaylightAbbrev: nt.DaylightAbbrev,
+		FromMoment: func(m meridian.Moment) Time {
+			return nt.FromMoment(m)
+		},
+		Parse: func(layout, value string) (Time, error) {
+			t, err := nt.Parse(layout, value)
+			if err != nil {
+				return nil, err
+			}
+			return t, nil
+		},
+		Calendar: func(open, closing time.Duration, weekend []time.Weekday) meridian.WorkingHours {
+			return meridian.Calendar[nt.Timezone]{Open: open, Close: closing, Weekend: weekend}
+		},
+	},
+	{
+		Name:           "nzt",
+		Location:       "Pacific/Auckland",
+		Description:    "New Zealand Time",
+		StandardAbbrev: nzt.StandardAbbrev,
+		DaylightAbbrev: nzt.DaylightAbbrev,
+		FromMoment: func(m meridian.Moment) Time {
+			return nzt.FromMoment(m)
+		},
+		Parse: func(layout, value string) (Time, error) {
+			t, err := nzt.Parse(layout, value)
+			if err != nil {
+				return nil, err
+			}
+			return t, nil
+		},
+		Calendar: func(open, closing time.Duration, weekend []time.Weekday) meridian.WorkingHours {
+			return meridian.Calendar[nzt.Timezone]{Open: open, Close: closing, Weekend: weekend}
+		},
+	},
+	{
+		Name:           "pht",
+		Location:       "Asia/Manila",
+		Description:    "Philippine Standard Time",
+		StandardAbbrev: pht.StandardAbbrev,
+		DaylightAbbrev: pht.DaylightAbbrev,
+		FromMoment: func(m meridian.Moment) Time {
+			return pht.FromMoment(m)
+		},
+		Parse: func(layout, value string) (Time, error) {
+			t, err := pht.Parse(layout, value)
+			if err != nil {
+				return nil, err
+			}
+			return t, nil
+		},
+		Calendar: func(open, closing time.Duration, weekend []time.Weekday) meridian.WorkingHours {
+			return meridian.Calendar[pht.Timezone]{Open: open, Close: closing, Weekend: weekend}
+		},
+	},
+	{
+		Name:           "pt",
+		Location:       "America/Los_Angeles",
+		Description:    "Pacific Time",
+		StandardAbbrev: pt.StandardAbbrev,
+		DaylightAbbrev: pt.DaylightAbbrev,
+		FromMoment: func(m meridian.Moment) Time {
+			return pt.FromMoment(m)
+		},
+		Parse: func(layout, value string) (Time, error) {
+			t, err := pt.Parse(layout, value)
+			if err != nil {
+				return nil, err
+			}
+			return t, nil
+		},
+		Calendar: func(open, closing time.Duration, weekend []time.Weekday) meridian.WorkingHours {
+			return meridian.Calendar[pt.Timezone]{Open: open, Close: closing, Weekend: weekend}
+		},
+	},
+	{
+		Name:           "pst",
+		Location:       "America/Los_Angeles",
+		Description:    "Pacific Standard Time",
+		StandardAbbrev: pst.StandardAbbrev,
+		DaylightAbbrev: pst.DaylightAbbrev,
+		FromMoment: func(m meridian.Moment) Time {
+			return pst.FromMoment(m)
+		},
+		Parse: func(layout, value string) (Time, error) {
+			t, err := pst.Parse(layout, value)
+			if err != nil {
+				return nil, err
+			}
+			return t, nil
+		},
+		Calendar: func(open, closing time.Duration, weekend []time.Weekday) meridian.WorkingHours {
+			return meridian.Calendar[pst.Timezone]{Open: open, Close: closing, Weekend: weekend}
+		},
+	},
+	{
+		Name:           "sast",
+		Location:       "Africa/Johannesburg",
+		Description:    "South African Standard Time",
+		StandardAbbrev: sast.StandardAbbrev,
+		DaylightAbbrev: sast.DaylightAbbrev,
+		FromMoment: func(m meridian.Moment) Time {
+			return sast.FromMoment(m)
+		},
+		Parse: func(layout, value string) (Time, error) {
+			t, err := sast.Parse(layout, value)
+			if err != nil {
+				return nil, err
+			}
+			return t, nil
+		},
+		Calendar: func(open, closing time.Duration, weekend []time.Weekday) meridian.WorkingHours {
+			return meridian.Calendar[sast.Timezone]{Open: open, Close: closing, Weekend: weekend}
+		},
+	},
+	{
+		Name:           "sgt",
+		Location:       "Asia/Singapore",
+		Description:    "Singapore Time",
+		StandardAbbrev: sgt.StandardAbbrev,
+		DaylightAbbrev: sgt.DaylightAbbrev,
+		FromMoment: func(m meridian.Moment) Time {
+			return sgt.FromMoment(m)
+		},
+		Parse: func(layout, value string) (Time, error) {
+			t, err := sgt.Parse(layout, value)
+			if err != nil {
+				return nil, err
+			}
+			return t, nil
+		},
+		Calendar: func(open, closing time.Duration, weekend []time.Weekday) meridian.WorkingHours {
+			return meridian.Calendar[sgt.Timezone]{Open: open, Close: closing, Weekend: weekend}
+		},
+	},
+	{
+		Name:           "trt",
+		Location:       "Europe/Istanbul",
+		Description:    "Turkey Time",
+		StandardAbbrev: trt.StandardAbbrev,
+		DaylightAbbrev: trt.DaylightAbbrev,
+		FromMoment: func(m meridian.Moment) Time {
+			return trt.FromMoment(m)
+		},
+		Parse: func(layout, value string) (Time, error) {
+			t, err := trt.Parse(layout, value)
+			if err != nil {
+				return nil, err
+			}
+			return t, nil
+		},
+		Calendar: func(open, closing time.Duration, weekend []time.Weekday) meridian.WorkingHours {
+			return meridian.Calendar[trt.Timezone]{Open: open, Close: closing, Weekend: weekend}
+		},
+	},
+	{
+		Name:           "utc",
+		Location:       "UTC",
+		Description:    "Coordinated Universal Time",
+		StandardAbbrev: utc.StandardAbbrev,
+		DaylightAbbrev: utc.DaylightAbbrev,
+		FromMoment: func(m meridian.Moment) Time {
+			return utc.FromMoment(m)
+		},
+		Parse: func(layout, value string) (Time, error) {
+			t, err := utc.Parse(layout, value)
+			if err != nil {
+				return nil, err
+			}
+			return t, nil
+		},
+		Calendar: func(open, closing time.Duration, weekend []time.Weekday) meridian.WorkingHours {
+			return meridian.Calendar[utc.Timezone]{Open: open, Close: closing, Weekend: weekend}
+		},
+	},
+	{
+		Name:           "wet",
+		Location:       "Europe/Lisbon",
+		Description:    "Western European Time",
+		StandardAbbrev: wet.StandardAbbrev,
+		DaylightAbbrev: wet.DaylightAbbrev,
+		FromMoment: func(m meridian.Moment) Time {
+			return wet.FromMoment(m)
+		},
+		Parse: func(layout, value string) (Time, error) {
+			t, err := wet.Parse(layout, value)
+			if err != nil {
+				return nil, err
+			}
+			return t, nil
+		},
+		Calendar: func(open, closing time.Duration, weekend []time.Weekday) meridian.WorkingHours {
+			return meridian.Calendar[wet.Timezone]{Open: open, Close: closing, Weekend: weekend}
+		},
+	},
+	{
+		Name:           "wib",
+		Location:       "Asia/Jakarta",
+		Description:    "Western Indonesia Time",
+		StandardAbbrev: wib.StandardAbbrev,
+		DaylightAbbrev: wib.DaylightAbbrev,
+		FromMoment: func(m meridian.Moment) Time {
+			return wib.FromMoment(m)
+		},
+		Parse: func(layout, value string) (Time, error) {
+			t, err := wib.Parse(layout, value)
+			if err != nil {
+				return nil, err
+			}
+			return t, nil
+		},
+		Calendar: func(open, closing time.Duration, weekend []time.Weekday) meridian.WorkingHours {
+			return meridian.Calendar[wib.Timezone]{Open: open, Close: closing, Weekend: weekend}
+		},
+	},
+}
+
+// Zones returns every registered zone in definition order.
+func Zones() []Zone {
+	return append([]Zone(nil), zones...)
+}
+
+// Lookup returns the zone with the given package name, such as "ct", or
+// location name, such as "America/Chicago". When several packages share a
+// location, the first in definition order is returned.
+func Lookup(name string) (Zone, bool) {
+	for _, z := range zones {
+		if z.Name == name {
+			return z, true
+		}
+	}
+	for _, z := range zones {
+		if z.Location == name {
+			return z, true
+		}
+	}
+	return Zone{}, false
+}
+
+// LookupAbbrev returns the zones that use abbrev, such as "CST", during
+// standard or daylight saving time. Abbreviations are ambiguous, so several
+// zones may match; they are returned in definition order.
+func LookupAbbrev(abbrev string) []Zone {
+	var matches []Zone
+	for _, z := range zones {
+		if z.StandardAbbrev == abbrev || (z.DaylightAbbrev != "" && z.DaylightAbbrev == abbrev) {
+			matches = append(matches, z)
+		}
+	}
+	return matches
+}
@@ -0,0 +1,88 @@
+// Code generated by generate-timezones. DO NOT EDIT.
+
+package registry
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matthalp/go-meridian/v2"
+)
+
+// utcZone is UTC, for comparing the calendars of the registered zones.
+type utcZone struct{}
+
+func (utcZone) Location() *time.Location { return time.UTC }
+
+func TestLookup(t *testing.T) {
+	for _, want := range Zones() {
+		z, ok := Lookup(want.Name)
+		if !ok || z.Name != want.Name {
+			t.Errorf("Lookup(%q) = %q, %v, want %q", want.Name, z.Name, ok, want.Name)
+		}
+
+		z, ok = Lookup(want.Location)
+		if !ok || z.Location != want.Location {
+			t.Errorf("Lookup(%q) = %q, %v, want location %q", want.Location, z.Location, ok, want.Location)
+		}
+	}
+
+	if _, ok := Lookup("Mars/Olympus_Mons"); ok {
+		t.Error("Lookup(\"Mars/Olympus_Mons\") ok = true, want false")
+	}
+}
+
+func TestLookupAbbrev(t *testing.T) {
+	for _, want := range Zones() {
+		found := false
+		for _, z := range LookupAbbrev(want.StandardAbbrev) {
+			if z.Name == want.Name {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("LookupAbbrev(%q) does not include %s", want.StandardAbbrev, want.Name)
+		}
+	}
+
+	if zones := LookupAbbrev(""); len(zones) != 0 {
+		t.Errorf("LookupAbbrev(\"\") returned %d zones, want none", len(zones))
+	}
+}
+
+func TestZoneFunctions(t *testing.T) {
+	instant := time.Date(2024, time.June, 15, 12, 0, 0, 0, time.UTC)
+
+	for _, z := range Zones() {
+		converted := z.FromMoment(instant)
+		if !converted.UTC().Equal(instant) {
+			t.Errorf("%s: FromMoment() = %v, want %v", z.Name, converted.UTC(), instant)
+		}
+		if got := converted.Location().String(); got != z.Location {
+			t.Errorf("%s: FromMoment() location = %q, want %q", z.Name, got, z.Location)
+		}
+
+		parsed, err := z.Parse(time.RFC3339, "2024-06-15T12:00:00Z")
+		if err != nil {
+			t.Errorf("%s: Parse() error = %v", z.Name, err)
+		} else if !parsed.UTC().Equal(instant) {
+			t.Errorf("%s: Parse() = %v, want %v", z.Name, parsed.UTC(), instant)
+		}
+
+		if parsed, err := z.Parse(time.RFC3339, "invalid"); err == nil || parsed != nil {
+			t.Errorf("%s: Parse(invalid) = %v, %v, want nil and an error", z.Name, parsed, err)
+		}
+
+		window := meridian.Interval[utcZone]{
+			Start: meridian.FromMoment[utcZone](instant),
+			End:   meridian.FromMoment[utcZone](instant.Add(time.Hour)),
+		}
+		if open := meridian.CommonWorkingHours(window, z.Calendar(0, 24*time.Hour, []time.Weekday{})); len(open) != 1 || open[0].Duration() != time.Hour {
+			t.Errorf("%s: CommonWorkingHours() with an all-day Calendar() = %v, want %v", z.Name, open, window)
+		}
+		weekend := []time.Weekday{time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday}
+		if open := meridian.CommonWorkingHours(window, z.Calendar(0, 24*time.Hour, weekend)); len(open) != 0 {
+			t.Errorf("%s: CommonWorkingHours() with an all-weekend Calendar() = %v, want none", z.Name, open)
+		}
+	}
+}
@@ -0,0 +1,59 @@
+// Code generated by generate-timezones. DO NOT EDIT.
+
+package tzabbrev
+
+// abbreviationYear is the year whose rules the table reflects.
+const abbreviationYear = 2025
+
+// abbreviations maps each time zone abbreviation to the IANA locations that
+// use it, in alphabetical order.
+var abbreviations = map[string][]string{
+	"ACDT": {"Australia/Adelaide", "Australia/Broken_Hill"},
+	"ACST": {"Australia/Adelaide", "Australia/Broken_Hill", "Australia/Darwin"},
+	"ADT":  {"America/Glace_Bay", "America/Goose_Bay", "America/Halifax", "America/Moncton", "America/Thule", "Atlantic/Bermuda"},
+	"AEDT": {"Antarctica/Macquarie", "Australia/Hobart", "Australia/Melbourne", "Australia/Sydney"},
+	"AEST": {"Antarctica/Macquarie", "Australia/Brisbane", "Australia/Hobart", "Australia/Lindeman", "Australia/Melbourne", "Australia/Sydney"},
+	"AKDT": {"America/Anchorage", "America/Juneau", "America/Metlakatla", "America/Nome", "America/Sitka", "America/Yakutat"},
+	"AKST": {"America/Anchorage", "America/Juneau", "America/Metlakatla", "America/Nome", "America/Sitka", "America/Yakutat"},
+	"AST":  {"America/Anguilla", "America/Antigua", "America/Aruba", "America/Barbados", "America/Blanc-Sablon", "America/Curacao", "America/Dominica", "America/Glace_Bay", "America/Goose_Bay", "America/Grenada", "America/Guadeloupe", "America/Halifax", "America/Kralendijk", "America/Lower_Princes", "America/Marigot", "America/Martinique", "America/Moncton", "America/Montserrat", "America/Port_of_Spain", "America/Puerto_Rico", "America/Santo_Domingo", "America/St_Barthelemy", "America/St_Kitts", "America/St_Lucia", "America/St_Thomas", "America/St_Vincent", "America/Thule", "America/Tortola", "Atlantic/Bermuda"},
+	"AWST": {"Australia/Perth"},
+	"BST":  {"Europe/Guernsey", "Europe/Isle_of_Man", "Europe/Jersey", "Europe/London"},
+	"CAT":  {"Africa/Blantyre", "Africa/Bujumbura", "Africa/Gaborone", "Africa/Harare", "Africa/Juba", "Africa/Khartoum", "Africa/Kigali", "Africa/Lubumbashi", "Africa/Lusaka", "Africa/Maputo", "Africa/Windhoek"},
+	"CDT":  {"America/Chicago", "America/Havana", "America/Indiana/Knox", "America/Indiana/Tell_City", "America/Matamoros", "America/Menominee", "America/North_Dakota/Beulah", "America/North_Dakota/Center", "America/North_Dakota/New_Salem", "America/Ojinaga", "America/Rankin_Inlet", "America/Resolute", "America/Winnipeg"},
+	"CEST": {"Africa/Ceuta", "Arctic/Longyearbyen", "Europe/Amsterdam", "Europe/Andorra", "Europe/Belgrade", "Europe/Berlin", "Europe/Bratislava", "Europe/Brussels", "Europe/Budapest", "Europe/Busingen", "Europe/Copenhagen", "Europe/Gibraltar", "Europe/Ljubljana", "Europe/Luxembourg", "Europe/Madrid", "Europe/Malta", "Europe/Monaco", "Europe/Oslo", "Europe/Paris", "Europe/Podgorica", "Europe/Prague", "Europe/Rome", "Europe/San_Marino", "Europe/Sarajevo", "Europe/Skopje", "Europe/Stockholm", "Europe/Tirane", "Europe/Vaduz", "Europe/Vatican", "Europe/Vienna", "Europe/Warsaw", "Europe/Zagreb", "Europe/Zurich"},
+	"CET":  {"Africa/Algiers", "Africa/Ceuta", "Africa/Tunis", "Arctic/Longyearbyen", "Europe/Amsterdam", "Europe/Andorra", "Europe/Belgrade", "Europe/Berlin", "Europe/Bratislava", "Europe/Brussels", "Europe/Budapest", "Europe/Busingen", "Europe/Copenhagen", "Europe/Gibraltar", "Europe/Ljubljana", "Europe/Luxembourg", "Europe/Madrid", "Europe/Malta", "Europe/Monaco", "Europe/Oslo", "Europe/Paris", "Europe/Podgorica", "Europe/Prague", "Europe/Rome", "Europe/San_Marino", "Europe/Sarajevo", "Europe/Skopje", "Europe/Stockholm", "Europe/Tirane", "Europe/Vaduz", "Europe/Vatican", "Europe/Vienna", "Europe/Warsaw", "Europe/Zagreb", "Europe/Zurich"},
+	"CST":  {"America/Bahia_Banderas", "America/Belize", "America/Chicago", "America/Chihuahua", "America/Costa_Rica", "America/El_Salvador", "America/Guatemala", "America/Havana", "America/Indiana/Knox", "America/Indiana/Tell_City", "America/Managua", "America/Matamoros", "America/Menominee", "America/Merida", "America/Mexico_City", "America/Monterrey", "America/North_Dakota/Beulah", "America/North_Dakota/Center", "America/North_Dakota/New_Salem", "America/Ojinaga", "America/Rankin_Inlet", "America/Regina", "America/Resolute", "America/Swift_Current", "America/Tegucigalpa", "America/Winnipeg", "Asia/Macau", "Asia/Shanghai", "Asia/Taipei"},
+	"ChST": {"Pacific/Guam", "Pacific/Saipan"},
+	"EAT":  {"Africa/Addis_Ababa", "Africa/Asmara", "Africa/Dar_es_Salaam", "Africa/Djibouti", "Africa/Kampala", "Africa/Mogadishu", "Africa/Nairobi", "Indian/Antananarivo", "Indian/Comoro", "Indian/Mayotte"},
+	"EDT":  {"America/Detroit", "America/Grand_Turk", "America/Indiana/Indianapolis", "America/Indiana/Marengo", "America/Indiana/Petersburg", "America/Indiana/Vevay", "America/Indiana/Vincennes", "America/Indiana/Winamac", "America/Iqaluit", "America/Kentucky/Louisville", "America/Kentucky/Monticello", "America/Nassau", "America/New_York", "America/Port-au-Prince", "America/Toronto"},
+	"EEST": {"Africa/Cairo", "Asia/Beirut", "Asia/Famagusta", "Asia/Gaza", "Asia/Hebron", "Asia/Nicosia", "Europe/Athens", "Europe/Bucharest", "Europe/Chisinau", "Europe/Helsinki", "Europe/Kyiv", "Europe/Mariehamn", "Europe/Riga", "Europe/Sofia", "Europe/Tallinn", "Europe/Vilnius"},
+	"EET":  {"Africa/Cairo", "Africa/Tripoli", "Asia/Beirut", "Asia/Famagusta", "Asia/Gaza", "Asia/Hebron", "Asia/Nicosia", "Europe/Athens", "Europe/Bucharest", "Europe/Chisinau", "Europe/Helsinki", "Europe/Kaliningrad", "Europe/Kyiv", "Europe/Mariehamn", "Europe/Riga", "Europe/Sofia", "Europe/Tallinn", "Europe/Vilnius"},
+	"EST":  {"America/Atikokan", "America/Cancun", "America/Cayman", "America/Detroit", "America/Grand_Turk", "America/Indiana/Indianapolis", "America/Indiana/Marengo", "America/Indiana/Petersburg", "America/Indiana/Vevay", "America/Indiana/Vincennes", "America/Indiana/Winamac", "America/Iqaluit", "America/Jamaica", "America/Kentucky/Louisville", "America/Kentucky/Monticello", "America/Nassau", "America/New_York", "America/Panama", "America/Port-au-Prince", "America/Toronto"},
+	"GMT":  {"Africa/Abidjan", "Africa/Accra", "Africa/Bamako", "Africa/Banjul", "Africa/Bissau", "Africa/Conakry", "Africa/Dakar", "Africa/Freetown", "Africa/Lome", "Africa/Monrovia", "Africa/Nouakchott", "Africa/Ouagadougou", "Africa/Sao_Tome", "America/Danmarkshavn", "Atlantic/Reykjavik", "Atlantic/St_Helena", "Europe/Dublin", "Europe/Guernsey", "Europe/Isle_of_Man", "Europe/Jersey", "Europe/London"},
+	"HDT":  {"America/Adak"},
+	"HKT":  {"Asia/Hong_Kong"},
+	"HST":  {"America/Adak", "Pacific/Honolulu"},
+	"IDT":  {"Asia/Jerusalem"},
+	"IST":  {"Asia/Jerusalem", "Asia/Kolkata", "Europe/Dublin"},
+	"JST":  {"Asia/Tokyo"},
+	"KST":  {"Asia/Pyongyang", "Asia/Seoul"},
+	"MDT":  {"America/Boise", "America/Cambridge_Bay", "America/Ciudad_Juarez", "America/Denver", "America/Edmonton", "America/Inuvik"},
+	"MSK":  {"Europe/Kirov", "Europe/Moscow", "Europe/Simferopol", "Europe/Volgograd"},
+	"MST":  {"America/Boise", "America/Cambridge_Bay", "America/Ciudad_Juarez", "America/Creston", "America/Dawson", "America/Dawson_Creek", "America/Denver", "America/Edmonton", "America/Fort_Nelson", "America/Hermosillo", "America/Inuvik", "America/Mazatlan", "America/Phoenix", "America/Whitehorse"},
+	"NDT":  {"America/St_Johns"},
+	"NST":  {"America/St_Johns"},
+	"NZDT": {"Antarctica/McMurdo", "Pacific/Auckland"},
+	"NZST": {"Antarctica/McMurdo", "Pacific/Auckland"},
+	"PDT":  {"America/Los_Angeles", "America/Tijuana", "America/Vancouver"},
+	"PKT":  {"Asia/Karachi"},
+	"PST":  {"America/Los_Angeles", "America/Tijuana", "America/Vancouver", "Asia/Manila"},
+	"SAST": {"Africa/Johannesburg", "Africa/Maseru", "Africa/Mbabane"},
+	"SST":  {"Pacific/Midway", "Pacific/Pago_Pago"},
+	"UTC":  {"UTC"},
+	"WAT":  {"Africa/Bangui", "Africa/Brazzaville", "Africa/Douala", "Africa/Kinshasa", "Africa/Lagos", "Africa/Libreville", "Africa/Luanda", "Africa/Malabo", "Africa/Ndjamena", "Africa/Niamey", "Africa/Porto-Novo"},
+	"WEST": {"Atlantic/Canary", "Atlantic/Faroe", "Atlantic/Madeira", "Europe/Lisbon"},
+	"WET":  {"Atlantic/Canary", "Atlantic/Faroe", "Atlantic/Madeira", "Europe/Lisbon"},
+	"WIB":  {"Asia/Jakarta", "Asia/Pontianak"},
+	"WIT":  {"Asia/Jayapura"},
+	"WITA": {"Asia/Makassar"},
+}
@@ -0,0 +1,111 @@
+/*
+Package tzabbrev resolves time zone abbreviations, such as "CST" or "IST",
+to the IANA locations that use them. Legacy feeds and log formats often
+carry only an abbreviation, which is ambiguous: "CST" is Central Standard
+Time in America/Chicago, China Standard Time in Asia/Shanghai, and Cuba
+Standard Time in America/Havana.
+
+Locations lists every candidate. At narrows them to the locations that
+used the abbreviation at a given instant, and Offset reports the offset
+they agree on, if any:
+
+	offset, ok := tzabbrev.Offset("EST", t) // -18000, true
+	_, ok = tzabbrev.Offset("CST", t)       // false: Chicago, Havana, and Shanghai differ
+	for _, m := range tzabbrev.At("CST", t) {
+		fmt.Println(m.Location, m.Offset)
+	}
+
+The table is generated from the time zone database shipped with the Go
+toolchain by generate-timezones, and covers the zones of zone.tab under
+the rules of a recent year. Regenerate it with go generate.
+*/
+package tzabbrev
+
+//go:generate go run ../cmd/generate-timezones -abbrev-table table.go
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/matthalp/go-meridian/v2"
+)
+
+// Locations returns the IANA locations that use abbrev, in alphabetical
+// order, or nil if no zone uses it. Abbreviations are matched ignoring
+// case, so "chst" finds "ChST". The returned slice is a copy.
+func Locations(abbrev string) []string {
+	return append([]string(nil), lookup(abbrev)...)
+}
+
+// lookup returns the locations that use abbrev, ignoring case.
+func lookup(abbrev string) []string {
+	if locations, ok := abbreviations[abbrev]; ok {
+		return locations
+	}
+	for key, locations := range abbreviations {
+		if strings.EqualFold(key, abbrev) {
+			return locations
+		}
+	}
+	return nil
+}
+
+// Abbreviations returns every abbreviation in the table, spelled as tzdata
+// spells it, in sorted order.
+func Abbreviations() []string {
+	abbrevs := make([]string, 0, len(abbreviations))
+	for abbrev := range abbreviations {
+		abbrevs = append(abbrevs, abbrev)
+	}
+	sort.Strings(abbrevs)
+	return abbrevs
+}
+
+// Match is a location that used an abbreviation at some instant.
+type Match struct {
+	Location string // IANA location name, such as "America/Chicago"
+	Offset   int    // offset in seconds east of UTC at the instant
+}
+
+// At returns the candidates for abbrev whose abbreviation at the instant m
+// is abbrev, in alphabetical order of location. This drops, for example,
+// the zones of the southern hemisphere when abbrev names a northern
+// daylight saving time. The parameter m can be any Moment (time.Time or
+// Time[TZ]).
+//
+// When resolving a timestamp that carries only a wall-clock time and an
+// abbreviation, m need only be near the instant, such as the wall clock
+// read as UTC: the result differs only within hours of a transition.
+// Locations that cannot be loaded are skipped.
+func At(abbrev string, m meridian.Moment) []Match {
+	utc := m.UTC()
+	var matches []Match
+	for _, name := range lookup(abbrev) {
+		loc, err := meridian.LoadLocation(name)
+		if err != nil {
+			continue
+		}
+		zone, offset := utc.In(loc).Zone()
+		if strings.EqualFold(zone, abbrev) {
+			matches = append(matches, Match{Location: name, Offset: offset})
+		}
+	}
+	return matches
+}
+
+// Offset returns the offset, in seconds east of UTC, that abbrev denoted at
+// the instant m. It reports false if no location used abbrev at m, or if
+// the locations that did disagree on the offset, as they do for "CST" and
+// "IST".
+func Offset(abbrev string, m meridian.Moment) (offset int, ok bool) {
+	matches := At(abbrev, m)
+	if len(matches) == 0 {
+		return 0, false
+	}
+	for _, match := range matches[1:] {
+		if match.Offset != matches[0].Offset {
+			return 0, false
+		}
+	}
+	return matches[0].Offset, true
+}
@@ -0,0 +1,103 @@
+package tzabbrev
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/matthalp/go-meridian/v2/timezones/et"
+)
+
+func TestLocations(t *testing.T) {
+	cst := Locations("CST")
+	for _, want := range []string{"America/Chicago", "America/Havana", "Asia/Shanghai"} {
+		if !contains(cst, want) {
+			t.Errorf("Locations(CST) = %v, missing %s", cst, want)
+		}
+	}
+	if !sort.StringsAreSorted(cst) {
+		t.Errorf("Locations(CST) = %v, want sorted", cst)
+	}
+	if got := Locations("chst"); !contains(got, "Pacific/Guam") {
+		t.Errorf("Locations(chst) = %v, want Pacific/Guam", got)
+	}
+	if got := Locations("+03"); got != nil {
+		t.Errorf("Locations(+03) = %v, want nil", got)
+	}
+	if got := Locations("XYZT"); got != nil {
+		t.Errorf("Locations(XYZT) = %v, want nil", got)
+	}
+
+	cst[0] = "Mars/Olympus"
+	if Locations("CST")[0] == "Mars/Olympus" {
+		t.Error("modifying the result of Locations changed the table")
+	}
+}
+
+func TestAbbreviations(t *testing.T) {
+	abbrevs := Abbreviations()
+	if !sort.StringsAreSorted(abbrevs) {
+		t.Error("Abbreviations() not sorted")
+	}
+	for _, want := range []string{"EST", "IST", "UTC", "ChST"} {
+		if !contains(abbrevs, want) {
+			t.Errorf("Abbreviations() missing %s", want)
+		}
+	}
+}
+
+func TestAt(t *testing.T) {
+	winter := time.Date(2024, time.January, 15, 12, 0, 0, 0, time.UTC)
+	summer := et.Date(2024, time.July, 15, 12, 0, 0, 0)
+
+	matches := At("CST", winter)
+	offsets := make(map[string]int)
+	for _, m := range matches {
+		offsets[m.Location] = m.Offset
+	}
+	if offsets["America/Chicago"] != -6*3600 || offsets["Asia/Shanghai"] != 8*3600 || offsets["America/Havana"] != -5*3600 {
+		t.Errorf("At(CST, winter) = %v", matches)
+	}
+
+	// In July, Chicago and Havana use CDT, leaving the zones on CST all year.
+	for _, m := range At("CST", summer) {
+		if m.Location == "America/Chicago" || m.Location == "America/Havana" {
+			t.Errorf("At(CST, summer) includes %s", m.Location)
+		}
+	}
+}
+
+func TestOffset(t *testing.T) {
+	winter := time.Date(2024, time.January, 15, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		abbrev string
+		want   int
+		ok     bool
+	}{
+		{"EST", -5 * 3600, true},
+		{"mst", -7 * 3600, true},
+		{"PST", 0, false}, // America/Los_Angeles and Asia/Manila
+		{"JST", 9 * 3600, true},
+		{"CET", 3600, true},
+		{"UTC", 0, true},
+		{"CST", 0, false},
+		{"IST", 0, false},
+		{"EDT", 0, false},
+		{"XYZT", 0, false},
+	}
+	for _, tt := range tests {
+		got, ok := Offset(tt.abbrev, winter)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("Offset(%s) = %d, %v, want %d, %v", tt.abbrev, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
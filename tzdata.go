@@ -0,0 +1,179 @@
+package meridian
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// tzdataSource is time zone data installed with Reload.
+type tzdataSource struct {
+	zones   map[string][]byte // zoneinfo files keyed by location name
+	version string            // tzdata release, such as "2024a", or ""
+}
+
+var (
+	// tzdata is the data installed with Reload, or nil to use the
+	// database the time package finds.
+	tzdata atomic.Pointer[tzdataSource]
+
+	// tzdataGeneration counts calls to Reload.
+	tzdataGeneration atomic.Uint64
+)
+
+// LoadLocation returns the named location, such as "America/New_York". It
+// is time.LoadLocation, except that after Reload it reads the time zone
+// data that Reload installed. The generated timezone packages load their
+// locations with it.
+func LoadLocation(name string) (*time.Location, error) {
+	if src := tzdata.Load(); src != nil {
+		if name == "" || name == "UTC" {
+			return time.UTC, nil
+		}
+		data, ok := src.zones[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown time zone %s", name)
+		}
+		return time.LoadLocationFromTZData(name, data)
+	}
+	return time.LoadLocation(name)
+}
+
+// Reload discards the locations cached by the generated timezone packages,
+// so a long-running service can pick up new rules, such as a country
+// abolishing daylight saving time, without a restart.
+//
+// If data is nil, locations are loaded again from the database the time
+// package uses, which picks up an operating system tzdata update installed
+// since they were first loaded. Otherwise data is a zip archive in the
+// format of $GOROOT/lib/time/zoneinfo.zip, with one compiled zoneinfo file
+// per location; if it also holds a tzdata.zi or +VERSION file, its release
+// becomes the TZDataVersion. Locations are then loaded only from data.
+//
+// Times are stored as UTC instants, so existing values are unaffected: they
+// are shown under the new rules the next time they are formatted or their
+// fields are read.
+func Reload(data []byte) error {
+	if data == nil {
+		tzdata.Store(nil)
+		tzdataGeneration.Add(1)
+		return nil
+	}
+
+	src, err := readTZData(data)
+	if err != nil {
+		return err
+	}
+	tzdata.Store(src)
+	tzdataGeneration.Add(1)
+	return nil
+}
+
+// TZDataGeneration returns a counter that Reload increments. Code that
+// caches locations loaded with LoadLocation, as the generated timezone
+// packages do, compares it with the value seen when loading to know when
+// to load again.
+func TZDataGeneration() uint64 {
+	return tzdataGeneration.Load()
+}
+
+// TZDataVersion returns the release of the time zone data in use, such as
+// "2024a", for reporting in diagnostics. After Reload it is the release
+// recorded in the installed data. Otherwise it is read from the tzdata.zi or
+// +VERSION file of the directory named by the ZONEINFO environment variable
+// or of the system zoneinfo directory. It returns the empty string if the
+// release cannot be determined, as for the zoneinfo.zip in the Go toolchain
+// or the time/tzdata package, which do not record it.
+func TZDataVersion() string {
+	if src := tzdata.Load(); src != nil {
+		return src.version
+	}
+	dirs := append([]string{os.Getenv("ZONEINFO")}, systemZoneinfoDirs...)
+	for _, dir := range dirs {
+		if dir == "" {
+			continue
+		}
+		for _, file := range []string{"tzdata.zi", "+VERSION"} {
+			f, err := os.Open(filepath.Join(dir, file))
+			if err != nil {
+				continue
+			}
+			version := parseTZDataVersion(f)
+			f.Close()
+			if version != "" {
+				return version
+			}
+		}
+	}
+	return ""
+}
+
+// systemZoneinfoDirs are the directories the time package searches for
+// zoneinfo files on Unix systems.
+var systemZoneinfoDirs = []string{
+	"/usr/share/zoneinfo/",
+	"/usr/share/lib/zoneinfo/",
+	"/usr/lib/locale/TZ/",
+	"/etc/zoneinfo/",
+}
+
+// readTZData reads a zoneinfo.zip archive.
+func readTZData(data []byte) (*tzdataSource, error) {
+	r, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("invalid time zone data: %w", err)
+	}
+
+	src := &tzdataSource{zones: make(map[string][]byte, len(r.File))}
+	for _, f := range r.File {
+		if strings.HasSuffix(f.Name, "/") {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return nil, fmt.Errorf("invalid time zone data: %s: %w", f.Name, err)
+		}
+		content, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			return nil, fmt.Errorf("invalid time zone data: %s: %w", f.Name, err)
+		}
+		switch f.Name {
+		case "tzdata.zi", "+VERSION":
+			if version := parseTZDataVersion(bytes.NewReader(content)); version != "" {
+				src.version = version
+			}
+		default:
+			src.zones[f.Name] = content
+		}
+	}
+	return src, nil
+}
+
+// parseTZDataVersion returns the release named on the first line of a
+// tzdata.zi file ("# version 2024a") or a +VERSION file ("2024a").
+func parseTZDataVersion(r io.Reader) string {
+	line, err := bufio.NewReader(r).ReadString('\n')
+	if err != nil && line == "" {
+		return ""
+	}
+	line = strings.TrimSpace(line)
+	if rest, ok := strings.CutPrefix(line, "#"); ok {
+		version, ok := strings.CutPrefix(strings.TrimSpace(rest), "version ")
+		if !ok {
+			return ""
+		}
+		return strings.TrimSpace(version)
+	}
+	if strings.ContainsAny(line, " \t") {
+		return ""
+	}
+	return line
+}
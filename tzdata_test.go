@@ -0,0 +1,76 @@
+package meridian_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/matthalp/go-meridian/v2"
+	"github.com/matthalp/go-meridian/v2/internal/tztest"
+	"github.com/matthalp/go-meridian/v2/timezones/et"
+)
+
+func TestReload(t *testing.T) {
+	t.Cleanup(func() {
+		if err := meridian.Reload(nil); err != nil {
+			t.Errorf("Reload(nil) error = %v", err)
+		}
+	})
+
+	instant := time.Date(2024, time.July, 4, 12, 0, 0, 0, time.UTC)
+	if got := et.FromMoment(instant).Hour(); got != 8 {
+		t.Fatalf("Hour() before Reload = %d, want 8", got)
+	}
+
+	generation := meridian.TZDataGeneration()
+	if err := meridian.Reload(tztest.TokyoAsNewYork(t)); err != nil {
+		t.Fatalf("Reload() error = %v", err)
+	}
+	if got := meridian.TZDataGeneration(); got != generation+1 {
+		t.Errorf("TZDataGeneration() = %d, want %d", got, generation+1)
+	}
+	if got := meridian.TZDataVersion(); got != "2099z" {
+		t.Errorf("TZDataVersion() = %q, want %q", got, "2099z")
+	}
+	if got := et.FromMoment(instant).Hour(); got != 21 {
+		t.Errorf("Hour() after Reload = %d, want 21", got)
+	}
+	if loc, err := et.LoadLocation(); err != nil || loc.String() != "America/New_York" {
+		t.Errorf("LoadLocation() = %v, %v, want America/New_York, nil", loc, err)
+	}
+	if _, err := meridian.LoadLocation("Europe/Paris"); err == nil {
+		t.Error("LoadLocation(Europe/Paris) succeeded, want error for a zone missing from the reloaded data")
+	}
+	if loc, err := meridian.LoadLocation("UTC"); err != nil || loc != time.UTC {
+		t.Errorf("LoadLocation(UTC) = %v, %v, want UTC, nil", loc, err)
+	}
+
+	if err := meridian.Reload(nil); err != nil {
+		t.Fatalf("Reload(nil) error = %v", err)
+	}
+	if got := et.FromMoment(instant).Hour(); got != 8 {
+		t.Errorf("Hour() after Reload(nil) = %d, want 8", got)
+	}
+}
+
+func TestReloadInvalid(t *testing.T) {
+	generation := meridian.TZDataGeneration()
+	if err := meridian.Reload([]byte("not a zip archive")); err == nil {
+		t.Error("Reload() succeeded, want error")
+	}
+	if got := meridian.TZDataGeneration(); got != generation {
+		t.Errorf("TZDataGeneration() = %d after failed Reload, want %d", got, generation)
+	}
+}
+
+func TestTZDataVersion(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "tzdata.zi"), []byte("# version 2024b\n# ...\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("ZONEINFO", dir)
+	if got := meridian.TZDataVersion(); got != "2024b" {
+		t.Errorf("TZDataVersion() = %q, want %q", got, "2024b")
+	}
+}
@@ -0,0 +1,224 @@
+/*
+Package tzinfo exposes the descriptive tables of the IANA time zone database:
+the countries, coordinates, and comments of each zone from zone1970.tab and
+zone.tab, and the country names from iso3166.tab. Timezone pickers use this
+data to group zones by country and place them on a map:
+
+	for _, z := range tzinfo.ByCountry("US") {
+		fmt.Println(z.Name, z.Comment) // America/New_York Eastern (most areas)
+	}
+
+The tables are embedded copies of the files distributed with tzdata. To
+update them, copy zone.tab, zone1970.tab, and iso3166.tab from a tzdata
+release (or /usr/share/zoneinfo) into this directory.
+*/
+package tzinfo
+
+import (
+	_ "embed"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+var (
+	//go:embed zone1970.tab
+	zone1970Tab string
+
+	//go:embed zone.tab
+	zoneTab string
+
+	//go:embed iso3166.tab
+	iso3166Tab string
+)
+
+// Zone describes an IANA timezone.
+type Zone struct {
+	// Name is the IANA name, such as "America/New_York".
+	Name string
+
+	// Countries are the ISO 3166 alpha-2 codes of the countries that overlap
+	// the zone. The country of the zone's principal location is first.
+	Countries []string
+
+	// Latitude and Longitude locate the zone's principal location in
+	// degrees; north and east are positive.
+	Latitude, Longitude float64
+
+	// Comment distinguishes the zone from others in the same country, such
+	// as "Eastern (most areas)". It is empty for countries with one zone.
+	Comment string
+}
+
+type tables struct {
+	zones     []Zone          // zone1970.tab, in file order
+	byName    map[string]Zone // zone1970.tab, then zone.tab for other names
+	countries map[string]string
+}
+
+var (
+	loadOnce sync.Once
+	loaded   tables
+)
+
+// load parses the embedded tables on first use.
+func load() *tables {
+	loadOnce.Do(func() {
+		loaded.zones = parseZones(zone1970Tab)
+		loaded.byName = make(map[string]Zone, len(loaded.zones))
+		for _, z := range loaded.zones {
+			loaded.byName[z.Name] = z
+		}
+		for _, z := range parseZones(zoneTab) {
+			if _, ok := loaded.byName[z.Name]; !ok {
+				loaded.byName[z.Name] = z
+			}
+		}
+		loaded.countries = make(map[string]string)
+		for _, fields := range rows(iso3166Tab) {
+			if len(fields) >= 2 {
+				loaded.countries[fields[0]] = fields[1]
+			}
+		}
+	})
+	return &loaded
+}
+
+// Zones returns every zone of zone1970.tab, the zones whose clocks have
+// agreed since 1970, sorted by country code of the principal location.
+// The returned slice is a copy.
+func Zones() []Zone {
+	zones := load().zones
+	out := make([]Zone, len(zones))
+	for i, z := range zones {
+		out[i] = z.clone()
+	}
+	return out
+}
+
+// Lookup returns the description of the named zone. Names listed only in
+// zone.tab, such as "Europe/Vatican", which since 1970 has kept the same
+// clocks as "Europe/Rome", are found as well and list their one country.
+func Lookup(name string) (Zone, bool) {
+	z, ok := load().byName[name]
+	return z.clone(), ok
+}
+
+// ByCountry returns the zones that overlap the country with the given
+// ISO 3166 alpha-2 code, such as "US", in the order tzdata lists them, which
+// puts the most populous zones first. The code is case-insensitive. It
+// returns nil for unknown codes.
+func ByCountry(code string) []Zone {
+	code = strings.ToUpper(code)
+	var out []Zone
+	for _, z := range load().zones {
+		for _, c := range z.Countries {
+			if c == code {
+				out = append(out, z.clone())
+				break
+			}
+		}
+	}
+	return out
+}
+
+// CountryName returns the English name of the country with the given
+// ISO 3166 alpha-2 code, such as "Britain (UK)" for "GB", as tzdata spells it.
+func CountryName(code string) (string, bool) {
+	name, ok := load().countries[strings.ToUpper(code)]
+	return name, ok
+}
+
+// clone returns z with its own copy of Countries, so callers cannot modify
+// the shared tables.
+func (z Zone) clone() Zone {
+	z.Countries = append([]string(nil), z.Countries...)
+	return z
+}
+
+// parseZones parses the rows of zone.tab or zone1970.tab, skipping any row
+// that is malformed.
+func parseZones(tab string) []Zone {
+	var zones []Zone
+	for _, fields := range rows(tab) {
+		if len(fields) < 3 {
+			continue
+		}
+		lat, lon, ok := parseISO6709(fields[1])
+		if !ok {
+			continue
+		}
+		z := Zone{
+			Name:      fields[2],
+			Countries: strings.Split(fields[0], ","),
+			Latitude:  lat,
+			Longitude: lon,
+		}
+		if len(fields) > 3 {
+			z.Comment = fields[3]
+		}
+		zones = append(zones, z)
+	}
+	return zones
+}
+
+// rows returns the tab-separated fields of each line of tab that is not a
+// comment.
+func rows(tab string) [][]string {
+	var out [][]string
+	for _, line := range strings.Split(tab, "\n") {
+		if line == "" || line[0] == '#' {
+			continue
+		}
+		out = append(out, strings.Split(line, "\t"))
+	}
+	return out
+}
+
+// parseISO6709 parses coordinates in the sign-degrees-minutes[-seconds]
+// form of ISO 6709 used by tzdata, ±DDMM±DDDMM or ±DDMMSS±DDDMMSS.
+func parseISO6709(s string) (lat, lon float64, ok bool) {
+	if len(s) < 2 {
+		return 0, 0, false
+	}
+	i := strings.IndexAny(s[1:], "+-") + 1
+	if i == 0 {
+		return 0, 0, false
+	}
+	lat, ok = parseDegrees(s[:i], 2)
+	if !ok {
+		return 0, 0, false
+	}
+	lon, ok = parseDegrees(s[i:], 3)
+	return lat, lon, ok
+}
+
+// parseDegrees parses a signed angle whose degrees have the given number of
+// digits, followed by minutes and optionally seconds.
+func parseDegrees(s string, degreeDigits int) (float64, bool) {
+	if len(s) < 2 {
+		return 0, false
+	}
+	digits := s[1:]
+	if (s[0] != '+' && s[0] != '-') || (len(digits) != degreeDigits+2 && len(digits) != degreeDigits+4) {
+		return 0, false
+	}
+	var parts [3]float64
+	for i, p := 0, 0; i < len(digits); p++ {
+		width := 2
+		if p == 0 {
+			width = degreeDigits
+		}
+		n, err := strconv.Atoi(digits[i : i+width])
+		if err != nil {
+			return 0, false
+		}
+		parts[p] = float64(n)
+		i += width
+	}
+	deg := parts[0] + parts[1]/60 + parts[2]/3600
+	if s[0] == '-' {
+		deg = -deg
+	}
+	return deg, true
+}
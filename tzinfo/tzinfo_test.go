@@ -0,0 +1,104 @@
+package tzinfo
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestLookup(t *testing.T) {
+	z, ok := Lookup("America/New_York")
+	if !ok {
+		t.Fatal("Lookup(America/New_York) not found")
+	}
+	if len(z.Countries) != 1 || z.Countries[0] != "US" {
+		t.Errorf("Countries = %v, want [US]", z.Countries)
+	}
+	if z.Comment != "Eastern (most areas)" {
+		t.Errorf("Comment = %q", z.Comment)
+	}
+	// +404251-0740023 is 40°42'51"N 74°00'23"W.
+	if math.Abs(z.Latitude-40.714167) > 1e-5 || math.Abs(z.Longitude+74.006389) > 1e-5 {
+		t.Errorf("coordinates = %f, %f", z.Latitude, z.Longitude)
+	}
+
+	rome, ok := Lookup("Europe/Rome")
+	if !ok || len(rome.Countries) < 3 || rome.Countries[0] != "IT" {
+		t.Errorf("Lookup(Europe/Rome) = %+v, %v, want countries starting with IT", rome, ok)
+	}
+	vatican, ok := Lookup("Europe/Vatican")
+	if !ok || len(vatican.Countries) != 1 || vatican.Countries[0] != "VA" {
+		t.Errorf("Lookup(Europe/Vatican) = %+v, %v, want country VA from zone.tab", vatican, ok)
+	}
+
+	if _, ok := Lookup("Mars/Olympus_Mons"); ok {
+		t.Error("Lookup(Mars/Olympus_Mons) found")
+	}
+}
+
+func TestLookupCopiesCountries(t *testing.T) {
+	z, _ := Lookup("Europe/Rome")
+	z.Countries[0] = "XX"
+	if again, _ := Lookup("Europe/Rome"); again.Countries[0] != "IT" {
+		t.Errorf("modifying a result changed the table: %v", again.Countries)
+	}
+}
+
+func TestByCountry(t *testing.T) {
+	us := ByCountry("us")
+	if len(us) < 10 || us[0].Name != "America/New_York" {
+		t.Fatalf("ByCountry(us) = %d zones starting with %v", len(us), us)
+	}
+	sm := ByCountry("SM")
+	if len(sm) != 1 || sm[0].Name != "Europe/Rome" {
+		t.Errorf("ByCountry(SM) = %+v, want Europe/Rome", sm)
+	}
+	if got := ByCountry("ZZ"); got != nil {
+		t.Errorf("ByCountry(ZZ) = %v, want nil", got)
+	}
+}
+
+func TestCountryName(t *testing.T) {
+	if name, ok := CountryName("jp"); !ok || name != "Japan" {
+		t.Errorf("CountryName(jp) = %q, %v, want Japan", name, ok)
+	}
+	if _, ok := CountryName("ZZ"); ok {
+		t.Error("CountryName(ZZ) found")
+	}
+}
+
+func TestZonesLoad(t *testing.T) {
+	zones := Zones()
+	if len(zones) < 300 {
+		t.Fatalf("Zones() returned %d zones", len(zones))
+	}
+	for _, z := range zones {
+		if _, ok := CountryName(z.Countries[0]); !ok {
+			t.Errorf("%s: unknown country %s", z.Name, z.Countries[0])
+		}
+		if _, err := time.LoadLocation(z.Name); err != nil {
+			t.Errorf("%s: %v", z.Name, err)
+		}
+	}
+}
+
+func TestParseISO6709(t *testing.T) {
+	tests := []struct {
+		in       string
+		lat, lon float64
+		ok       bool
+	}{
+		{"+4230+00131", 42.5, 1 + 31.0/60, true},
+		{"-3352+15113", -(33 + 52.0/60), 151 + 13.0/60, true},
+		{"+404251-0740023", 40 + 42.0/60 + 51.0/3600, -(74 + 23.0/3600), true},
+		{"", 0, 0, false},
+		{"+4230", 0, 0, false},
+		{"+423+00131", 0, 0, false},
+	}
+	for _, tt := range tests {
+		lat, lon, ok := parseISO6709(tt.in)
+		if ok != tt.ok || math.Abs(lat-tt.lat) > 1e-9 || math.Abs(lon-tt.lon) > 1e-9 {
+			t.Errorf("parseISO6709(%q) = %v, %v, %v, want %v, %v, %v", tt.in, lat, lon, ok, tt.lat, tt.lon, tt.ok)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package meridian
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// Compile-time interface assertions.
+var (
+	_ json.Marshaler   = UnixSeconds[Timezone]{}
+	_ json.Unmarshaler = (*UnixSeconds[Timezone])(nil)
+	_ json.Marshaler   = UnixMillis[Timezone]{}
+	_ json.Unmarshaler = (*UnixMillis[Timezone])(nil)
+)
+
+// UnixSeconds is a Time[TZ] that is encoded in JSON as a number of seconds
+// elapsed since January 1, 1970 UTC. The embedded Time exposes the full
+// Time[TZ] API, so a UnixSeconds field can be used like any other typed time
+// while exchanging epoch timestamps with clients that expect them.
+//
+// Sub-second precision is truncated when marshaling.
+type UnixSeconds[TZ Timezone] struct {
+	Time[TZ]
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// The time is encoded as an integer number of Unix seconds.
+func (t UnixSeconds[TZ]) MarshalJSON() ([]byte, error) {
+	return strconv.AppendInt(nil, t.Unix(), 10), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// The value must be an integer number of Unix seconds. As with time.Time,
+// the JSON value null is accepted and leaves t unchanged.
+func (t *UnixSeconds[TZ]) UnmarshalJSON(data []byte) error {
+	sec, ok, err := parseJSONEpoch(data, "UnixSeconds")
+	if err != nil || !ok {
+		return err
+	}
+	t.Time = Unix[TZ](sec, 0)
+	return nil
+}
+
+// UnixMillis is a Time[TZ] that is encoded in JSON as a number of milliseconds
+// elapsed since January 1, 1970 UTC, the representation used by JavaScript's
+// Date.now() and most mobile platforms. The embedded Time exposes the full
+// Time[TZ] API.
+//
+// Sub-millisecond precision is truncated when marshaling.
+type UnixMillis[TZ Timezone] struct {
+	Time[TZ]
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// The time is encoded as an integer number of Unix milliseconds.
+func (t UnixMillis[TZ]) MarshalJSON() ([]byte, error) {
+	return strconv.AppendInt(nil, t.UnixMilli(), 10), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// The value must be an integer number of Unix milliseconds. As with time.Time,
+// the JSON value null is accepted and leaves t unchanged.
+func (t *UnixMillis[TZ]) UnmarshalJSON(data []byte) error {
+	msec, ok, err := parseJSONEpoch(data, "UnixMillis")
+	if err != nil || !ok {
+		return err
+	}
+	t.Time = UnixMilli[TZ](msec)
+	return nil
+}
+
+// parseJSONEpoch parses an integer JSON number. It reports ok=false without an
+// error when data is the JSON null literal.
+func parseJSONEpoch(data []byte, typeName string) (n int64, ok bool, err error) {
+	data = bytes.TrimSpace(data)
+	if string(data) == "null" {
+		return 0, false, nil
+	}
+	n, err = strconv.ParseInt(string(data), 10, 64)
+	if err != nil {
+		return 0, false, fmt.Errorf("cannot unmarshal %s into meridian.%s: expected an integer", data, typeName)
+	}
+	return n, true, nil
+}
@@ -0,0 +1,162 @@
+package meridian
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestUnixSecondsMarshalJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    UnixSeconds[UTC]
+		expected string
+	}{
+		{
+			name:     "epoch",
+			value:    UnixSeconds[UTC]{Unix[UTC](0, 0)},
+			expected: "0",
+		},
+		{
+			name:     "known timestamp",
+			value:    UnixSeconds[UTC]{Date[UTC](2024, time.January, 15, 12, 0, 0, 0)},
+			expected: "1705320000",
+		},
+		{
+			name:     "truncates sub-second precision",
+			value:    UnixSeconds[UTC]{Date[UTC](2024, time.January, 15, 12, 0, 0, 999999999)},
+			expected: "1705320000",
+		},
+		{
+			name:     "before epoch",
+			value:    UnixSeconds[UTC]{Unix[UTC](-86400, 0)},
+			expected: "-86400",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.value)
+			if err != nil {
+				t.Fatalf("MarshalJSON() error = %v", err)
+			}
+			if string(data) != tt.expected {
+				t.Errorf("MarshalJSON() = %s, want %s", data, tt.expected)
+			}
+		})
+	}
+}
+
+func TestUnixSecondsUnmarshalJSON(t *testing.T) {
+	var decoded UnixSeconds[EST]
+	if err := json.Unmarshal([]byte("1705320000"), &decoded); err != nil {
+		t.Fatalf("UnmarshalJSON() error = %v", err)
+	}
+
+	expected := time.Date(2024, time.January, 15, 12, 0, 0, 0, time.UTC)
+	if !decoded.Equal(expected) {
+		t.Errorf("UnmarshalJSON() = %v, want %v", decoded.UTC(), expected)
+	}
+
+	// The embedded Time exposes the timezone-aware API.
+	if decoded.Hour() != 7 {
+		t.Errorf("Hour() = %d, want 7 (EST)", decoded.Hour())
+	}
+}
+
+func TestUnixMillisMarshalJSON(t *testing.T) {
+	// Sub-millisecond precision is truncated; the timezone does not affect the encoding.
+	typed := UnixMillis[PST]{FromMoment[PST](time.Date(2024, time.January, 15, 12, 0, 0, 123456789, time.UTC))}
+	data, err := json.Marshal(typed)
+	if err != nil {
+		t.Fatalf("MarshalJSON() error = %v", err)
+	}
+	if string(data) != "1705320000123" {
+		t.Errorf("MarshalJSON() = %s, want 1705320000123", data)
+	}
+}
+
+func TestUnixMillisUnmarshalJSON(t *testing.T) {
+	var decoded UnixMillis[UTC]
+	if err := json.Unmarshal([]byte("1705320000123"), &decoded); err != nil {
+		t.Fatalf("UnmarshalJSON() error = %v", err)
+	}
+
+	expected := time.UnixMilli(1705320000123).UTC()
+	if !decoded.Equal(expected) {
+		t.Errorf("UnmarshalJSON() = %v, want %v", decoded.UTC(), expected)
+	}
+}
+
+func TestUnixJSONNull(t *testing.T) {
+	original := Date[UTC](2024, time.June, 15, 14, 30, 0, 0)
+
+	seconds := UnixSeconds[UTC]{original}
+	if err := json.Unmarshal([]byte("null"), &seconds); err != nil {
+		t.Fatalf("UnixSeconds.UnmarshalJSON(null) error = %v", err)
+	}
+	if !seconds.Equal(original) {
+		t.Errorf("UnixSeconds.UnmarshalJSON(null) modified value: got %v, want %v", seconds, original)
+	}
+
+	millis := UnixMillis[UTC]{original}
+	if err := json.Unmarshal([]byte("null"), &millis); err != nil {
+		t.Fatalf("UnixMillis.UnmarshalJSON(null) error = %v", err)
+	}
+	if !millis.Equal(original) {
+		t.Errorf("UnixMillis.UnmarshalJSON(null) modified value: got %v, want %v", millis, original)
+	}
+}
+
+func TestUnixJSONInvalid(t *testing.T) {
+	inputs := []string{`"1705320000"`, `1705320000.5`, `true`, `"2024-01-15T12:00:00Z"`}
+
+	for _, input := range inputs {
+		t.Run(input, func(t *testing.T) {
+			var seconds UnixSeconds[UTC]
+			if err := json.Unmarshal([]byte(input), &seconds); err == nil {
+				t.Errorf("UnixSeconds.UnmarshalJSON(%s) expected error, got nil", input)
+			}
+
+			var millis UnixMillis[UTC]
+			if err := json.Unmarshal([]byte(input), &millis); err == nil {
+				t.Errorf("UnixMillis.UnmarshalJSON(%s) expected error, got nil", input)
+			}
+		})
+	}
+}
+
+func TestUnixJSONInStruct(t *testing.T) {
+	type Event struct {
+		Name      string           `json:"name"`
+		CreatedAt UnixMillis[UTC]  `json:"created_at"`
+		ExpiresAt UnixSeconds[UTC] `json:"expires_at"`
+	}
+
+	event := Event{
+		Name:      "Meeting",
+		CreatedAt: UnixMillis[UTC]{Date[UTC](2024, time.June, 15, 14, 30, 0, 250000000)},
+		ExpiresAt: UnixSeconds[UTC]{Date[UTC](2024, time.June, 16, 14, 30, 0, 0)},
+	}
+
+	data, err := json.Marshal(event)
+	if err != nil {
+		t.Fatalf("Marshal error = %v", err)
+	}
+
+	expected := `{"name":"Meeting","created_at":1718461800250,"expires_at":1718548200}`
+	if string(data) != expected {
+		t.Errorf("Marshal() = %s, want %s", data, expected)
+	}
+
+	var decoded Event
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Unmarshal error = %v", err)
+	}
+	if !decoded.CreatedAt.Equal(event.CreatedAt) {
+		t.Errorf("CreatedAt = %v, want %v", decoded.CreatedAt, event.CreatedAt)
+	}
+	if !decoded.ExpiresAt.Equal(event.ExpiresAt) {
+		t.Errorf("ExpiresAt = %v, want %v", decoded.ExpiresAt, event.ExpiresAt)
+	}
+}
@@ -0,0 +1,124 @@
+/*
+Package validatorrules registers github.com/go-playground/validator rules for
+meridian typed times, so request structs can declare constraints on
+Time[TZ] fields directly instead of converting them to time.Time first:
+
+	type Booking struct {
+		CheckIn  et.Time `validate:"future,business_day"`
+		CheckOut et.Time `validate:"after_field=CheckIn"`
+	}
+
+	v := validator.New()
+	if err := validatorrules.Register(v); err != nil {
+		return err
+	}
+	err := v.Struct(booking)
+
+The rules apply to any field holding a meridian.Moment, so time.Time fields
+and pointers to either work too; a nil pointer fails every rule unless the
+field is also tagged omitempty or omitnil. The rules are:
+
+	future          the time is after now
+	past            the time is before now
+	before_field=F  the time is before that of field F of the same struct
+	after_field=F   the time is after that of field F of the same struct
+	business_day    the time falls on Monday through Friday in its own timezone
+
+Fields of different timezones compare as instants, so an et.Time can be
+checked against a utc.Time.
+
+Validate.Var does not apply tags to struct values, so validate a single
+typed time through its time.Time, which keeps the timezone:
+
+	err := v.Var(t.Time(), "future,business_day")
+*/
+package validatorrules
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+
+	"github.com/matthalp/go-meridian/v2"
+)
+
+// Register adds the rules to v, measuring future and past against the
+// system clock.
+func Register(v *validator.Validate) error {
+	return RegisterWithClock(v, time.Now)
+}
+
+// RegisterWithClock is like Register, but measures future and past against
+// the time returned by now, so tests can fix the current time.
+func RegisterWithClock(v *validator.Validate, now func() time.Time) error {
+	rules := map[string]validator.Func{
+		"future": func(fl validator.FieldLevel) bool {
+			t, ok := momentOf(fl.Field())
+			return ok && t.After(now())
+		},
+		"past": func(fl validator.FieldLevel) bool {
+			t, ok := momentOf(fl.Field())
+			return ok && t.Before(now())
+		},
+		"before_field": func(fl validator.FieldLevel) bool {
+			t, other, ok := fieldPair(fl)
+			return ok && t.Before(other)
+		},
+		"after_field": func(fl validator.FieldLevel) bool {
+			t, other, ok := fieldPair(fl)
+			return ok && t.After(other)
+		},
+		"business_day": func(fl validator.FieldLevel) bool {
+			t, ok := momentOf(fl.Field())
+			if !ok {
+				return false
+			}
+			switch t.Weekday() {
+			case time.Saturday, time.Sunday:
+				return false
+			}
+			return true
+		},
+	}
+	for _, tag := range []string{"future", "past", "before_field", "after_field", "business_day"} {
+		if err := v.RegisterValidation(tag, rules[tag]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fieldPair returns the time of the field being validated and of the field
+// named by the rule's parameter.
+func fieldPair(fl validator.FieldLevel) (t, other time.Time, ok bool) {
+	t, ok = momentOf(fl.Field())
+	if !ok {
+		return time.Time{}, time.Time{}, false
+	}
+	field, _, _, found := fl.GetStructFieldOK2()
+	if !found {
+		return time.Time{}, time.Time{}, false
+	}
+	other, ok = momentOf(field)
+	return t, other, ok
+}
+
+// momentOf returns the time held by v, in its own location, following
+// pointers. It reports false if v does not hold a meridian.Moment.
+func momentOf(v reflect.Value) (time.Time, bool) {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return time.Time{}, false
+		}
+		v = v.Elem()
+	}
+	if !v.IsValid() || !v.CanInterface() {
+		return time.Time{}, false
+	}
+	m, ok := v.Interface().(meridian.Moment)
+	if !ok {
+		return time.Time{}, false
+	}
+	return m.UTC().In(meridian.LocationOf(m)), true
+}
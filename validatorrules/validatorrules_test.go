@@ -0,0 +1,138 @@
+package validatorrules
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+
+	"github.com/matthalp/go-meridian/v2/timezones/et"
+	"github.com/matthalp/go-meridian/v2/timezones/jst"
+	"github.com/matthalp/go-meridian/v2/timezones/utc"
+)
+
+// now is the fixed current time of the tests, a Wednesday.
+var now = time.Date(2024, time.March, 13, 12, 0, 0, 0, time.UTC)
+
+func newValidate(t *testing.T) *validator.Validate {
+	t.Helper()
+	v := validator.New()
+	if err := RegisterWithClock(v, func() time.Time { return now }); err != nil {
+		t.Fatalf("RegisterWithClock() error = %v", err)
+	}
+	return v
+}
+
+// failedTags returns the tags of the rules that failed, keyed by field name.
+func failedTags(t *testing.T, err error) map[string]string {
+	t.Helper()
+	failed := make(map[string]string)
+	if err == nil {
+		return failed
+	}
+	var errs validator.ValidationErrors
+	if !errors.As(err, &errs) {
+		t.Fatalf("Struct() error = %v, want validator.ValidationErrors", err)
+	}
+	for _, e := range errs {
+		failed[e.Field()] = e.Tag()
+	}
+	return failed
+}
+
+func TestFuturePast(t *testing.T) {
+	type request struct {
+		Future  et.Time   `validate:"future"`
+		Past    utc.Time  `validate:"past"`
+		Std     time.Time `validate:"future"`
+		Pointer *jst.Time `validate:"past"`
+		Missing *et.Time  `validate:"omitempty,future"`
+		Nil     *utc.Time `validate:"future"`
+	}
+	v := newValidate(t)
+
+	earlier := jst.FromMoment(now.Add(-time.Second))
+	ok := request{
+		Future:  et.FromMoment(now.Add(time.Hour)),
+		Past:    utc.FromMoment(now.Add(-time.Hour)),
+		Std:     now.Add(time.Minute),
+		Pointer: &earlier,
+	}
+	if got := failedTags(t, v.Struct(ok)); len(got) != 1 || got["Nil"] != "future" {
+		t.Errorf("failed rules = %v, want only Nil:future", got)
+	}
+
+	later := jst.FromMoment(now.Add(time.Second))
+	bad := request{
+		Future:  et.FromMoment(now),
+		Past:    utc.FromMoment(now.Add(time.Hour)),
+		Std:     now.Add(-time.Minute),
+		Pointer: &later,
+	}
+	want := map[string]string{"Future": "future", "Past": "past", "Std": "future", "Pointer": "past", "Nil": "future"}
+	got := failedTags(t, v.Struct(bad))
+	if len(got) != len(want) {
+		t.Errorf("failed rules = %v, want %v", got, want)
+	}
+	for field, tag := range want {
+		if got[field] != tag {
+			t.Errorf("field %s failed %q, want %q", field, got[field], tag)
+		}
+	}
+}
+
+func TestFieldComparison(t *testing.T) {
+	type booking struct {
+		CheckIn  et.Time  `validate:"before_field=CheckOut"`
+		CheckOut utc.Time `validate:"after_field=CheckIn"`
+	}
+	v := newValidate(t)
+
+	checkIn := et.Date(2024, time.March, 15, 15, 0, 0, 0)
+	if err := v.Struct(booking{CheckIn: checkIn, CheckOut: utc.FromMoment(checkIn.Add(time.Hour))}); err != nil {
+		t.Errorf("Struct() error = %v", err)
+	}
+
+	// The same instant is neither before nor after itself.
+	got := failedTags(t, v.Struct(booking{CheckIn: checkIn, CheckOut: utc.FromMoment(checkIn)}))
+	if got["CheckIn"] != "before_field" || got["CheckOut"] != "after_field" {
+		t.Errorf("failed rules = %v, want CheckIn:before_field and CheckOut:after_field", got)
+	}
+}
+
+func TestFieldComparisonUnknownField(t *testing.T) {
+	type request struct {
+		At et.Time `validate:"before_field=Missing"`
+	}
+	if got := failedTags(t, newValidate(t).Struct(request{At: et.FromMoment(now)})); got["At"] != "before_field" {
+		t.Errorf("failed rules = %v, want At:before_field", got)
+	}
+}
+
+func TestBusinessDay(t *testing.T) {
+	type request struct {
+		Day jst.Time `validate:"business_day"`
+	}
+	v := newValidate(t)
+
+	// Friday 20:00 UTC is already Saturday in Tokyo.
+	friday := time.Date(2024, time.March, 15, 20, 0, 0, 0, time.UTC)
+	if got := failedTags(t, v.Struct(request{Day: jst.FromMoment(friday)})); got["Day"] != "business_day" {
+		t.Errorf("failed rules = %v, want Day:business_day", got)
+	}
+	if err := v.Struct(request{Day: jst.FromMoment(now)}); err != nil {
+		t.Errorf("Struct() error = %v", err)
+	}
+}
+
+func TestVar(t *testing.T) {
+	v := newValidate(t)
+	later := et.FromMoment(now.Add(time.Hour))
+	if err := v.Var(later.Time(), "future,business_day"); err != nil {
+		t.Errorf("Var() error = %v", err)
+	}
+	if err := v.Var(later.Time(), "past"); err == nil {
+		t.Error("Var() succeeded, want past to fail")
+	}
+}
@@ -0,0 +1,12 @@
+module github.com/matthalp/go-meridian/v2/zapfield
+
+go 1.20
+
+require (
+	github.com/matthalp/go-meridian/v2 v2.0.0
+	go.uber.org/zap v1.27.0
+)
+
+require go.uber.org/multierr v1.10.0 // indirect
+
+replace github.com/matthalp/go-meridian/v2 => ../
@@ -0,0 +1,54 @@
+/*
+Package zapfield provides go.uber.org/zap field constructors for meridian
+typed times.
+
+The constructors encode times through zap's native time field type, so they
+avoid the fmt.Stringer path (and its allocation) that zap.Stringer or zap.Any
+would take. The time is logged in its timezone's location, using whatever
+time encoder the logger is configured with:
+
+	logger.Info("order placed",
+		zapfield.Time("placed_at", order.PlacedAt),
+		zapfield.Time("ships_at", order.ShipsAt),
+	)
+*/
+package zapfield
+
+import (
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/matthalp/go-meridian/v2"
+)
+
+// Time constructs a field with the given key and typed time. The time keeps its
+// timezone's location, so encoders that include an offset render it in TZ.
+// Constructing the field does not allocate.
+func Time[TZ meridian.Timezone](key string, t meridian.Time[TZ]) zap.Field {
+	return zap.Time(key, t.Time())
+}
+
+// Times constructs a field that carries a slice of typed times.
+func Times[TZ meridian.Timezone](key string, ts []meridian.Time[TZ]) zap.Field {
+	return zap.Array(key, times[TZ](ts))
+}
+
+// Null constructs a field for a nullable typed time. An invalid value is
+// logged as null.
+func Null[TZ meridian.Timezone](key string, n meridian.Null[TZ]) zap.Field {
+	if !n.Valid {
+		return zap.Reflect(key, nil)
+	}
+	return Time(key, n.Time)
+}
+
+// times adapts a slice of typed times to zapcore.ArrayMarshaler.
+type times[TZ meridian.Timezone] []meridian.Time[TZ]
+
+// MarshalLogArray implements the zapcore.ArrayMarshaler interface.
+func (ts times[TZ]) MarshalLogArray(arr zapcore.ArrayEncoder) error {
+	for i := range ts {
+		arr.AppendTime(ts[i].Time())
+	}
+	return nil
+}
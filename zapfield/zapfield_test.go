@@ -0,0 +1,98 @@
+package zapfield
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/matthalp/go-meridian/v2"
+	"github.com/matthalp/go-meridian/v2/timezones/et"
+	"github.com/matthalp/go-meridian/v2/timezones/utc"
+)
+
+// newLogger returns a logger writing JSON with RFC 3339 times to buf.
+func newLogger(buf *bytes.Buffer) *zap.Logger {
+	cfg := zap.NewProductionEncoderConfig()
+	cfg.TimeKey = ""
+	cfg.EncodeTime = zapcore.RFC3339NanoTimeEncoder
+	core := zapcore.NewCore(zapcore.NewJSONEncoder(cfg), zapcore.AddSync(buf), zapcore.InfoLevel)
+	return zap.New(core)
+}
+
+func TestTime(t *testing.T) {
+	var buf bytes.Buffer
+	logger := newLogger(&buf)
+
+	deadline := et.Date(2024, time.December, 25, 9, 0, 0, 0)
+	logger.Info("scheduled",
+		Time("deadline", deadline),
+		Time("created", utc.Date(2024, time.June, 15, 14, 30, 0, 0)),
+	)
+
+	out := buf.String()
+	if !strings.Contains(out, `"deadline":"2024-12-25T09:00:00-05:00"`) {
+		t.Errorf("output = %s, want deadline in ET", out)
+	}
+	if !strings.Contains(out, `"created":"2024-06-15T14:30:00Z"`) {
+		t.Errorf("output = %s, want created in UTC", out)
+	}
+}
+
+func TestTimeOutsideUnixNanoRange(t *testing.T) {
+	var buf bytes.Buffer
+	logger := newLogger(&buf)
+
+	logger.Info("far future", Time("when", et.Date(3000, time.January, 1, 0, 0, 0, 0)))
+
+	if out := buf.String(); !strings.Contains(out, `"when":"3000-01-01T00:00:00-05:00"`) {
+		t.Errorf("output = %s, want far future time in ET", out)
+	}
+}
+
+func TestTimeAllocations(t *testing.T) {
+	deadline := et.Date(2024, time.December, 25, 9, 0, 0, 0)
+
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = Time("deadline", deadline)
+	})
+	if allocs != 0 {
+		t.Errorf("Time() allocations = %v, want 0", allocs)
+	}
+}
+
+func TestTimes(t *testing.T) {
+	var buf bytes.Buffer
+	logger := newLogger(&buf)
+
+	logger.Info("reminders", Times("at", []et.Time{
+		et.Date(2024, time.December, 24, 9, 0, 0, 0),
+		et.Date(2024, time.December, 25, 9, 0, 0, 0),
+	}))
+
+	expected := `"at":["2024-12-24T09:00:00-05:00","2024-12-25T09:00:00-05:00"]`
+	if out := buf.String(); !strings.Contains(out, expected) {
+		t.Errorf("output = %s, want %s", out, expected)
+	}
+}
+
+func TestNull(t *testing.T) {
+	var buf bytes.Buffer
+	logger := newLogger(&buf)
+
+	logger.Info("order",
+		Null("shipped", meridian.Null[utc.Timezone]{}),
+		Null("placed", meridian.Null[utc.Timezone]{Time: utc.Date(2024, time.June, 15, 14, 30, 0, 0), Valid: true}),
+	)
+
+	out := buf.String()
+	if !strings.Contains(out, `"shipped":null`) {
+		t.Errorf("output = %s, want shipped null", out)
+	}
+	if !strings.Contains(out, `"placed":"2024-06-15T14:30:00Z"`) {
+		t.Errorf("output = %s, want placed time", out)
+	}
+}
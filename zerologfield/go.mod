@@ -0,0 +1,16 @@
+module github.com/matthalp/go-meridian/v2/zerologfield
+
+go 1.20
+
+require (
+	github.com/matthalp/go-meridian/v2 v2.0.0
+	github.com/rs/zerolog v1.33.0
+)
+
+require (
+	github.com/mattn/go-colorable v0.1.13 // indirect
+	github.com/mattn/go-isatty v0.0.19 // indirect
+	golang.org/x/sys v0.12.0 // indirect
+)
+
+replace github.com/matthalp/go-meridian/v2 => ../
@@ -0,0 +1,79 @@
+/*
+Package zerologfield provides github.com/rs/zerolog helpers for meridian typed
+times.
+
+Time and Times return functions for use with zerolog.Event.Func, so typed
+times are logged through zerolog's native time encoding (honoring
+zerolog.TimeFieldFormat) in their timezone's location:
+
+	log.Info().
+		Func(zerologfield.Time("deadline", order.Deadline)).
+		Msg("order placed")
+
+Object wraps a typed time as a zerolog.LogObjectMarshaler that logs both the
+formatted time and its IANA zone name under consistent keys:
+
+	log.Info().Object("deadline", zerologfield.Object(order.Deadline)).Msg("")
+	// {"deadline":{"time":"2024-12-25T09:00:00-05:00","zone":"America/New_York"}}
+*/
+package zerologfield
+
+import (
+	"github.com/rs/zerolog"
+
+	"github.com/matthalp/go-meridian/v2"
+)
+
+// Keys used by Object.
+const (
+	TimeKey = "time"
+	ZoneKey = "zone"
+)
+
+// Time returns a function that adds the typed time t to an event under key.
+// Use it with zerolog.Event.Func.
+func Time[TZ meridian.Timezone](key string, t meridian.Time[TZ]) func(*zerolog.Event) {
+	return func(e *zerolog.Event) {
+		e.Time(key, t.Time())
+	}
+}
+
+// Times returns a function that adds the typed times ts to an event under key
+// as an array. Use it with zerolog.Event.Func.
+func Times[TZ meridian.Timezone](key string, ts []meridian.Time[TZ]) func(*zerolog.Event) {
+	return func(e *zerolog.Event) {
+		arr := zerolog.Arr()
+		for i := range ts {
+			arr.Time(ts[i].Time())
+		}
+		e.Array(key, arr)
+	}
+}
+
+// Null returns a function that adds the nullable typed time n to an event
+// under key. An invalid value is logged as null. Use it with zerolog.Event.Func.
+func Null[TZ meridian.Timezone](key string, n meridian.Null[TZ]) func(*zerolog.Event) {
+	return func(e *zerolog.Event) {
+		if !n.Valid {
+			e.Interface(key, nil)
+			return
+		}
+		e.Time(key, n.Time.Time())
+	}
+}
+
+// Object returns a zerolog.LogObjectMarshaler that logs t as an object with
+// the formatted time under TimeKey and the IANA zone name under ZoneKey.
+func Object[TZ meridian.Timezone](t meridian.Time[TZ]) zerolog.LogObjectMarshaler {
+	return object[TZ]{t: t}
+}
+
+// object implements zerolog.LogObjectMarshaler for a typed time.
+type object[TZ meridian.Timezone] struct {
+	t meridian.Time[TZ]
+}
+
+// MarshalZerologObject implements the zerolog.LogObjectMarshaler interface.
+func (o object[TZ]) MarshalZerologObject(e *zerolog.Event) {
+	e.Time(TimeKey, o.t.Time()).Str(ZoneKey, o.t.Location().String())
+}
@@ -0,0 +1,77 @@
+package zerologfield
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/matthalp/go-meridian/v2"
+	"github.com/matthalp/go-meridian/v2/timezones/et"
+	"github.com/matthalp/go-meridian/v2/timezones/utc"
+)
+
+func TestTime(t *testing.T) {
+	var buf bytes.Buffer
+	logger := zerolog.New(&buf)
+
+	logger.Info().
+		Func(Time("deadline", et.Date(2024, time.December, 25, 9, 0, 0, 0))).
+		Func(Time("created", utc.Date(2024, time.June, 15, 14, 30, 0, 0))).
+		Msg("scheduled")
+
+	out := buf.String()
+	if !strings.Contains(out, `"deadline":"2024-12-25T09:00:00-05:00"`) {
+		t.Errorf("output = %s, want deadline in ET", out)
+	}
+	if !strings.Contains(out, `"created":"2024-06-15T14:30:00Z"`) {
+		t.Errorf("output = %s, want created in UTC", out)
+	}
+}
+
+func TestTimes(t *testing.T) {
+	var buf bytes.Buffer
+	logger := zerolog.New(&buf)
+
+	logger.Info().Func(Times("at", []et.Time{
+		et.Date(2024, time.December, 24, 9, 0, 0, 0),
+		et.Date(2024, time.December, 25, 9, 0, 0, 0),
+	})).Msg("reminders")
+
+	expected := `"at":["2024-12-24T09:00:00-05:00","2024-12-25T09:00:00-05:00"]`
+	if out := buf.String(); !strings.Contains(out, expected) {
+		t.Errorf("output = %s, want %s", out, expected)
+	}
+}
+
+func TestNull(t *testing.T) {
+	var buf bytes.Buffer
+	logger := zerolog.New(&buf)
+
+	logger.Info().
+		Func(Null("shipped", meridian.Null[utc.Timezone]{})).
+		Func(Null("placed", meridian.Null[utc.Timezone]{Time: utc.Date(2024, time.June, 15, 14, 30, 0, 0), Valid: true})).
+		Msg("order")
+
+	out := buf.String()
+	if !strings.Contains(out, `"shipped":null`) {
+		t.Errorf("output = %s, want shipped null", out)
+	}
+	if !strings.Contains(out, `"placed":"2024-06-15T14:30:00Z"`) {
+		t.Errorf("output = %s, want placed time", out)
+	}
+}
+
+func TestObject(t *testing.T) {
+	var buf bytes.Buffer
+	logger := zerolog.New(&buf)
+
+	logger.Info().Object("deadline", Object(et.Date(2024, time.December, 25, 9, 0, 0, 0))).Msg("")
+
+	expected := `"deadline":{"time":"2024-12-25T09:00:00-05:00","zone":"America/New_York"}`
+	if out := buf.String(); !strings.Contains(out, expected) {
+		t.Errorf("output = %s, want %s", out, expected)
+	}
+}
@@ -0,0 +1,90 @@
+package meridian
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Compile-time interface assertions.
+var (
+	_ encoding.BinaryMarshaler   = Zoned[Timezone]{}
+	_ encoding.BinaryUnmarshaler = (*Zoned[Timezone])(nil)
+)
+
+// ErrZoneMismatch is returned when decoding a Zoned value that was encoded
+// for a different timezone than the one it is decoded into.
+var ErrZoneMismatch = errors.New("zone mismatch")
+
+// zonedVersion is the first byte of the Zoned binary encoding.
+const zonedVersion byte = 1
+
+// Zoned is a Time[TZ] whose binary and gob encodings record the name of the
+// timezone's location, such as "America/New_York", alongside the instant.
+// Decoding checks the name against the receiving type, so data written as
+// et.Time and read back as pt.Time fails with ErrZoneMismatch instead of
+// silently changing meaning, as Time's own MarshalBinary would. Use it for
+// durable storage where the type of a stored value may drift over time.
+// The embedded Time exposes the full Time[TZ] API.
+type Zoned[TZ Timezone] struct {
+	Time[TZ]
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface. The
+// encoding is a version byte, the length and bytes of the location name, and
+// the instant as encoded by time.Time.MarshalBinary.
+func (z Zoned[TZ]) MarshalBinary() ([]byte, error) {
+	return z.AppendBinary(nil)
+}
+
+// AppendBinary appends the binary representation of z to b and returns the
+// extended buffer.
+func (z Zoned[TZ]) AppendBinary(b []byte) ([]byte, error) {
+	name := getLocation[TZ]().String()
+	if len(name) > 255 {
+		return nil, fmt.Errorf("cannot marshal meridian.Zoned: location name %q is longer than 255 bytes", name)
+	}
+	enc, err := z.utcTime.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, zonedVersion, byte(len(name)))
+	b = append(b, name...)
+	return append(b, enc...), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface. It
+// returns an error wrapping ErrZoneMismatch if data was encoded for a location
+// other than the timezone's.
+func (z *Zoned[TZ]) UnmarshalBinary(data []byte) error {
+	if len(data) < 2 || data[0] != zonedVersion {
+		return errors.New("cannot unmarshal meridian.Zoned: unsupported encoding")
+	}
+	n := int(data[1])
+	if len(data) < 2+n {
+		return errors.New("cannot unmarshal meridian.Zoned: data too short")
+	}
+	name := string(data[2 : 2+n])
+	if want := getLocation[TZ]().String(); name != want {
+		return fmt.Errorf("cannot unmarshal %s time into meridian.Zoned for %s: %w", name, want, ErrZoneMismatch)
+	}
+	var t time.Time
+	if err := t.UnmarshalBinary(data[2+n:]); err != nil {
+		return err
+	}
+	z.utcTime = t.UTC()
+	return nil
+}
+
+// GobEncode implements the gob.GobEncoder interface, using the same encoding
+// as MarshalBinary.
+func (z Zoned[TZ]) GobEncode() ([]byte, error) {
+	return z.MarshalBinary()
+}
+
+// GobDecode implements the gob.GobDecoder interface, using the same encoding
+// as UnmarshalBinary.
+func (z *Zoned[TZ]) GobDecode(data []byte) error {
+	return z.UnmarshalBinary(data)
+}
@@ -0,0 +1,95 @@
+package meridian
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestZonedRoundTrip(t *testing.T) {
+	want := Zoned[EST]{Date[EST](2024, time.July, 15, 12, 30, 0, 123456789)}
+
+	data, err := want.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary() error = %v", err)
+	}
+	if !bytes.Contains(data, []byte("America/New_York")) {
+		t.Errorf("MarshalBinary() = %q, want it to record America/New_York", data)
+	}
+
+	var got Zoned[EST]
+	if err := got.UnmarshalBinary(data); err != nil {
+		t.Fatalf("UnmarshalBinary() error = %v", err)
+	}
+	if got != want {
+		t.Errorf("UnmarshalBinary() = %v, want %v", got, want)
+	}
+}
+
+func TestZonedZoneMismatch(t *testing.T) {
+	data, err := Zoned[EST]{Date[EST](2024, time.July, 15, 12, 30, 0, 0)}.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary() error = %v", err)
+	}
+
+	var got Zoned[UTC]
+	err = got.UnmarshalBinary(data)
+	if !errors.Is(err, ErrZoneMismatch) {
+		t.Fatalf("UnmarshalBinary() error = %v, want ErrZoneMismatch", err)
+	}
+	if !got.IsZero() {
+		t.Errorf("UnmarshalBinary() modified the value on error: %v", got)
+	}
+}
+
+func TestZonedUnmarshalInvalid(t *testing.T) {
+	valid, err := Zoned[UTC]{Date[UTC](2024, time.July, 15, 12, 30, 0, 0)}.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary() error = %v", err)
+	}
+
+	tests := map[string][]byte{
+		"empty":             nil,
+		"unknown version":   append([]byte{99}, valid[1:]...),
+		"truncated name":    valid[:4],
+		"truncated instant": valid[:len(valid)-1],
+	}
+	for name, data := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got Zoned[UTC]
+			if err := got.UnmarshalBinary(data); err == nil {
+				t.Error("UnmarshalBinary() expected error, got nil")
+			}
+		})
+	}
+}
+
+func TestZonedGob(t *testing.T) {
+	type record struct {
+		At Zoned[EST]
+	}
+	in := record{At: Zoned[EST]{Date[EST](2024, time.July, 15, 12, 30, 0, 0)}}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(in); err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+	encoded := buf.Bytes()
+
+	var out record
+	if err := gob.NewDecoder(bytes.NewReader(encoded)).Decode(&out); err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+	if out != in {
+		t.Errorf("Decode() = %v, want %v", out, in)
+	}
+
+	var mismatched struct {
+		At Zoned[UTC]
+	}
+	if err := gob.NewDecoder(bytes.NewReader(encoded)).Decode(&mismatched); !errors.Is(err, ErrZoneMismatch) {
+		t.Errorf("Decode() into another zone error = %v, want ErrZoneMismatch", err)
+	}
+}
@@ -0,0 +1,21 @@
+package meridian
+
+// IANAName returns the name of the location of the timezone type TZ, such as
+// "America/New_York", without needing a value of Time[TZ]. Code that
+// generates schemas, migrations, or documentation from struct field types can
+// use it to record the zone of a column.
+//
+// The name is whatever the location reports: for generated packages it is
+// the IANA name, for fixed-offset packages it is a name such as "UTC+05:30",
+// and for a custom Timezone it may be any string.
+func IANAName[TZ Timezone]() string {
+	return getLocation[TZ]().String()
+}
+
+// OffsetAt returns the offset of the timezone type TZ from UTC, in seconds
+// east of UTC, at the instant m. The parameter m can be any Moment
+// (time.Time or Time[TZ]); it need not be in TZ.
+func OffsetAt[TZ Timezone](m Moment) int {
+	_, offset := m.UTC().In(getLocation[TZ]()).Zone()
+	return offset
+}
@@ -0,0 +1,35 @@
+package meridian
+
+import (
+	"testing"
+	"time"
+)
+
+func TestIANAName(t *testing.T) {
+	if got := IANAName[EST](); got != "America/New_York" {
+		t.Errorf("IANAName[EST]() = %q, want %q", got, "America/New_York")
+	}
+	if got := IANAName[UTC](); got != "UTC" {
+		t.Errorf("IANAName[UTC]() = %q, want %q", got, "UTC")
+	}
+}
+
+func TestOffsetAt(t *testing.T) {
+	winter := time.Date(2024, time.January, 15, 12, 0, 0, 0, time.UTC)
+	summer := Date[PST](2024, time.July, 15, 12, 0, 0, 0)
+	tests := []struct {
+		name string
+		got  int
+		want int
+	}{
+		{"EST in winter", OffsetAt[EST](winter), -5 * 3600},
+		{"EST in summer", OffsetAt[EST](summer), -4 * 3600},
+		{"PST in summer", OffsetAt[PST](summer), -7 * 3600},
+		{"UTC", OffsetAt[UTC](summer), 0},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("OffsetAt(%s) = %d, want %d", tt.name, tt.got, tt.want)
+		}
+	}
+}